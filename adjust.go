@@ -0,0 +1,141 @@
+package jpholiday
+
+import "time"
+
+// Roll is a convention for moving a date that is not a business day to one
+// that is.
+type Roll int
+
+// Roll conventions.
+const (
+	// RollPreceding moves to the previous business day. It is the zero value,
+	// as Japanese payroll pays early when payday is not a business day.
+	RollPreceding Roll = iota
+
+	// RollFollowing moves to the next business day.
+	RollFollowing
+
+	// RollNearest moves to the nearer of the previous and next business
+	// days, preferring the previous one when they are equally near.
+	RollNearest
+
+	// RollModifiedFollowing moves to the next business day unless that is
+	// in the next month, in which case it moves to the previous business
+	// day. It is the usual convention for interest and coupon dates.
+	RollModifiedFollowing
+
+	// RollModifiedPreceding moves to the previous business day unless that
+	// is in the previous month, in which case it moves to the next business
+	// day.
+	RollModifiedPreceding
+)
+
+// Adjust returns the given date (interpreted in JST) if it is a business
+// day, and otherwise the business day chosen by roll. ok is false if there
+// is no business day within maxSearchDays days in the required direction,
+// or, for the modified conventions, none in the same month.
+func (c *Calendar) Adjust(t time.Time, roll Roll) (adjusted time.Time, ok bool) {
+	d, ok := c.load().adjust(dateFromTime(t), roll)
+	if !ok {
+		return time.Time{}, false
+	}
+	return d.toTime(), true
+}
+
+// AdjustedPayday returns the payday for the given day of a month, moved by
+// roll when it is not a business day. A day past the end of the month, such
+// as 31 in June, means the last day of the month (末日払い). ok is false if
+// month is not January to December, if day is less than 1, or if no
+// business day is found as for [Calendar.Adjust].
+func (c *Calendar) AdjustedPayday(year int, month time.Month, day int, roll Roll) (payday time.Time, ok bool) {
+	if month < time.January || month > time.December || day < 1 {
+		return time.Time{}, false
+	}
+	last := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	d, ok := c.load().adjust(newDate(year, month, min(day, last)), roll)
+	if !ok {
+		return time.Time{}, false
+	}
+	return d.toTime(), true
+}
+
+// ScheduleMonthly returns a payment schedule of the given number of monthly
+// dates, for loan installments, coupons, or subscription billing: the
+// dayOfMonth of each month starting with the month of start (interpreted in
+// JST), each moved by roll when it is not a business day. As with
+// [Calendar.AdjustedPayday], a day past the end of a month means its last
+// day, and the zero [Roll] pays early. Use [RollModifiedFollowing] to keep
+// each payment in its month.
+//
+// It returns nil if months or dayOfMonth is less than 1 or if a date cannot
+// be adjusted.
+func (c *Calendar) ScheduleMonthly(start time.Time, months, dayOfMonth int, roll Roll) []time.Time {
+	if months < 1 || dayOfMonth < 1 {
+		return nil
+	}
+	s := c.load()
+	y, m, _ := dateFromTime(start).ymd()
+	schedule := make([]time.Time, months)
+	for i := range schedule {
+		last := time.Date(y, m+time.Month(i)+1, 0, 0, 0, 0, 0, time.UTC)
+		d, ok := s.adjust(newDate(last.Year(), last.Month(), min(dayOfMonth, last.Day())), roll)
+		if !ok {
+			return nil
+		}
+		schedule[i] = d.toTime()
+	}
+	return schedule
+}
+
+// adjust applies roll to d.
+func (s *snapshot) adjust(d date, roll Roll) (date, bool) {
+	switch roll {
+	case RollFollowing:
+		return s.searchBusinessDay(d, 1)
+	case RollNearest:
+		prev, okPrev := s.searchBusinessDay(d, -1)
+		next, okNext := s.searchBusinessDay(d, 1)
+		if okPrev && (!okNext || d.days()-prev.days() <= next.days()-d.days()) {
+			return prev, true
+		}
+		return next, okNext
+	case RollModifiedFollowing:
+		return s.adjustWithinMonth(d, 1)
+	case RollModifiedPreceding:
+		return s.adjustWithinMonth(d, -1)
+	default:
+		return s.searchBusinessDay(d, -1)
+	}
+}
+
+// adjustWithinMonth searches for a business day from d in the direction of
+// step, and in the other direction if the first result is outside d's month.
+func (s *snapshot) adjustWithinMonth(d date, step int) (date, bool) {
+	if r, ok := s.searchBusinessDay(d, step); ok && sameMonth(r, d) {
+		return r, true
+	}
+	if r, ok := s.searchBusinessDay(d, -step); ok && sameMonth(r, d) {
+		return r, true
+	}
+	return 0, false
+}
+
+// sameMonth reports whether a and b are in the same month of the same year.
+func sameMonth(a, b date) bool {
+	ay, am, _ := a.ymd()
+	by, bm, _ := b.ymd()
+	return ay == by && am == bm
+}
+
+// ScheduleMonthly returns a holiday-adjusted monthly schedule on the default calendar.
+func ScheduleMonthly(start time.Time, months, dayOfMonth int, roll Roll) []time.Time {
+	return defaultCal.ScheduleMonthly(start, months, dayOfMonth, roll)
+}
+
+// Adjust applies roll to the given date on the default calendar.
+func Adjust(t time.Time, roll Roll) (time.Time, bool) { return defaultCal.Adjust(t, roll) }
+
+// AdjustedPayday returns the payday for the given day of a month on the default calendar.
+func AdjustedPayday(year int, month time.Month, day int, roll Roll) (time.Time, bool) {
+	return defaultCal.AdjustedPayday(year, month, day, roll)
+}
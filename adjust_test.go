@@ -0,0 +1,140 @@
+package jpholiday_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/rabitt1ove/jp-holidays"
+)
+
+func TestAdjustedPayday(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		year  int
+		month time.Month
+		day   int
+		roll  Roll
+		want  time.Time
+	}{
+		{"business day", 2026, time.June, 25, RollPreceding, d(2026, time.June, 25)},
+		{"Saturday, preceding", 2026, time.July, 25, RollPreceding, d(2026, time.July, 24)},
+		{"Saturday, following", 2026, time.July, 25, RollFollowing, d(2026, time.July, 27)},
+		{"Saturday, nearest", 2026, time.July, 25, RollNearest, d(2026, time.July, 24)},
+		{"Sunday, nearest", 2026, time.October, 25, RollNearest, d(2026, time.October, 26)},
+		{"Golden Week, preceding", 2026, time.May, 5, RollPreceding, d(2026, time.May, 1)},
+		{"Golden Week, nearest", 2026, time.May, 5, RollNearest, d(2026, time.May, 7)},
+		{"month end", 2026, time.February, 31, RollPreceding, d(2026, time.February, 27)},
+		{"zero roll pays early", 2026, time.January, 25, Roll(0), d(2026, time.January, 23)},
+	}
+	for _, tt := range tests {
+		got, ok := AdjustedPayday(tt.year, tt.month, tt.day, tt.roll)
+		if !ok || !got.Equal(tt.want) {
+			t.Errorf("%s: AdjustedPayday = %s, %v, want %s", tt.name, got.Format(time.DateOnly), ok, tt.want.Format(time.DateOnly))
+		}
+	}
+}
+
+func TestAdjustedPayday_Invalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		month time.Month
+		day   int
+	}{
+		{"day 0", time.June, 0},
+		{"negative day", time.June, -5},
+		{"month 0", 0, 25},
+		{"month 13", 13, 25},
+	}
+	for _, tt := range tests {
+		if got, ok := AdjustedPayday(2026, tt.month, tt.day, RollPreceding); ok || !got.IsZero() {
+			t.Errorf("%s: AdjustedPayday = %s, %v, want zero time and false", tt.name, got, ok)
+		}
+	}
+}
+
+func TestAdjust_NoBusinessDays(t *testing.T) {
+	t.Parallel()
+
+	cal := New()
+	cal.SetWeekend(time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday)
+	for _, roll := range []Roll{RollPreceding, RollFollowing, RollNearest} {
+		if got, ok := cal.Adjust(d(2026, time.June, 10), roll); ok || !got.IsZero() {
+			t.Errorf("Adjust(%d) = %s, %v, want zero time and false", roll, got, ok)
+		}
+	}
+}
+
+func TestAdjust_Modified(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		date time.Time
+		roll Roll
+		want time.Time
+	}{
+		{"business day", d(2026, time.June, 10), RollModifiedFollowing, d(2026, time.June, 10)},
+		{"following within month", d(2026, time.June, 13), RollModifiedFollowing, d(2026, time.June, 15)},
+		{"following crosses month", d(2026, time.May, 30), RollModifiedFollowing, d(2026, time.May, 29)},
+		{"following crosses year", d(2026, time.January, 31), RollModifiedFollowing, d(2026, time.January, 30)},
+		{"preceding within month", d(2026, time.June, 14), RollModifiedPreceding, d(2026, time.June, 12)},
+		{"preceding crosses month", d(2026, time.August, 1), RollModifiedPreceding, d(2026, time.August, 3)},
+		{"preceding from the 1st", d(2026, time.March, 1), RollModifiedPreceding, d(2026, time.March, 2)},
+	}
+	for _, tt := range tests {
+		got, ok := Adjust(tt.date, tt.roll)
+		if !ok || !got.Equal(tt.want) {
+			t.Errorf("%s: Adjust(%s) = %s, %v, want %s", tt.name, tt.date.Format(time.DateOnly), got.Format(time.DateOnly), ok, tt.want.Format(time.DateOnly))
+		}
+	}
+}
+
+func TestScheduleMonthly(t *testing.T) {
+	t.Parallel()
+
+	got := ScheduleMonthly(d(2026, time.April, 20), 6, 31, RollPreceding)
+	want := []time.Time{
+		d(2026, time.April, 30),
+		d(2026, time.May, 29), // 05-31 is a Sunday
+		d(2026, time.June, 30),
+		d(2026, time.July, 31),
+		d(2026, time.August, 31),
+		d(2026, time.September, 30),
+	}
+	if len(got) != len(want) {
+		t.Fatalf("ScheduleMonthly = %v, want %v", got, want)
+	}
+	for i := range want {
+		if !got[i].Equal(want[i]) {
+			t.Errorf("ScheduleMonthly[%d] = %s, want %s", i, got[i].Format(time.DateOnly), want[i].Format(time.DateOnly))
+		}
+	}
+
+	// 2026-05-03 falls in Golden Week; 2027-01-03 is a Sunday.
+	got = ScheduleMonthly(d(2026, time.May, 1), 9, 3, RollFollowing)
+	if !got[0].Equal(d(2026, time.May, 7)) || !got[8].Equal(d(2027, time.January, 4)) {
+		t.Errorf("ScheduleMonthly following = %v", got)
+	}
+}
+
+func TestScheduleMonthly_Invalid(t *testing.T) {
+	t.Parallel()
+
+	if got := ScheduleMonthly(d(2026, time.April, 1), 0, 25, RollPreceding); got != nil {
+		t.Errorf("ScheduleMonthly(months = 0) = %v, want nil", got)
+	}
+	for _, day := range []int{0, -1} {
+		if got := ScheduleMonthly(d(2026, time.April, 1), 3, day, RollPreceding); got != nil {
+			t.Errorf("ScheduleMonthly(dayOfMonth = %d) = %v, want nil", day, got)
+		}
+	}
+	cal := New()
+	cal.SetWeekend(time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday)
+	if got := cal.ScheduleMonthly(d(2026, time.April, 1), 3, 25, RollPreceding); got != nil {
+		t.Errorf("ScheduleMonthly without business days = %v, want nil", got)
+	}
+}
@@ -0,0 +1,190 @@
+package jpholiday
+
+import (
+	"math"
+	"time"
+)
+
+// The functions in this file compute the positions of the Sun and Moon needed
+// for the traditional lunisolar calendar (旧暦), following Jean Meeus,
+// "Astronomical Algorithms" (2nd ed.). Times are Julian Ephemeris Days (JDE,
+// Terrestrial Time) unless noted; the results are accurate to a few minutes
+// over 1900–2100, which places new moons and solar terms on the right JST
+// date except when one falls within minutes of midnight.
+
+// astroFirstYear and astroLastYear bound the years supported by the
+// calculations, which rely on a polynomial fit of ΔT.
+const (
+	astroFirstYear = 1900
+	astroLastYear  = 2100
+)
+
+// unixEpochJD is the Julian Day of 1970-01-01 00:00 UTC.
+const unixEpochJD = 2440587.5
+
+// synodicMonth is the mean length of a lunation, in days.
+const synodicMonth = 29.530588861
+
+func sinDeg(x float64) float64 { return math.Sin(x * math.Pi / 180) }
+
+// normDeg reduces an angle to [0, 360).
+func normDeg(x float64) float64 {
+	x = math.Mod(x, 360)
+	if x < 0 {
+		x += 360
+	}
+	return x
+}
+
+// deltaT returns ΔT = TT − UT in days for a decimal year, using the
+// polynomial expressions of Espenak and Meeus.
+func deltaT(y float64) float64 {
+	var s float64
+	switch {
+	case y < 1920:
+		t := y - 1900
+		s = -2.79 + 1.494119*t - 0.0598939*t*t + 0.0061966*t*t*t - 0.000197*t*t*t*t
+	case y < 1941:
+		t := y - 1920
+		s = 21.20 + 0.84493*t - 0.076100*t*t + 0.0020936*t*t*t
+	case y < 1961:
+		t := y - 1950
+		s = 29.07 + 0.407*t - t*t/233 + t*t*t/2547
+	case y < 1986:
+		t := y - 1975
+		s = 45.45 + 1.067*t - t*t/260 - t*t*t/718
+	case y < 2005:
+		t := y - 2000
+		s = 63.86 + 0.3345*t - 0.060374*t*t + 0.0017275*t*t*t + 0.000651814*t*t*t*t + 0.00002373599*t*t*t*t*t
+	case y < 2050:
+		t := y - 2000
+		s = 62.92 + 0.32217*t + 0.005589*t*t
+	default:
+		u := (y - 1820) / 100
+		s = -20 + 32*u*u - 0.5628*(2150-y)
+	}
+	return s / 86400
+}
+
+// jdeToUT converts a JDE to a Julian Day in UT.
+func jdeToUT(jde float64) float64 {
+	return jde - deltaT(2000+(jde-2451545)/365.25)
+}
+
+// jstDateOfJD returns the JST calendar date of a Julian Day in UT.
+func jstDateOfJD(jd float64) date {
+	return dateFromDays(int(math.Floor(jd - unixEpochJD + 9.0/24)))
+}
+
+// jdeOfJSTMidnight returns the JDE at the start of d in JST.
+func jdeOfJSTMidnight(d date) float64 {
+	jd := float64(d.days()) + unixEpochJD - 9.0/24
+	return jd + deltaT(2000+(jd-2451545)/365.25)
+}
+
+// sunLongitude returns the apparent geocentric ecliptic longitude of the
+// Sun in degrees at the given JDE (Meeus, chapter 25, lower accuracy).
+func sunLongitude(jde float64) float64 {
+	t := (jde - 2451545) / 36525
+	l0 := 280.46646 + 36000.76983*t + 0.0003032*t*t
+	m := 357.52911 + 35999.05029*t - 0.0001537*t*t
+	c := (1.914602-0.004817*t-0.000014*t*t)*sinDeg(m) +
+		(0.019993-0.000101*t)*sinDeg(2*m) +
+		0.000289*sinDeg(3*m)
+	omega := 125.04 - 1934.136*t
+	return normDeg(l0 + c - 0.00569 - 0.00478*sinDeg(omega))
+}
+
+// solarTerm returns the JDE at which the Sun's apparent longitude reaches lon
+// degrees during the given Gregorian year (Meeus, chapter 27).
+func solarTerm(year int, lon float64) float64 {
+	// The Sun is near 280° on January 1 and moves about 360/365.2422° a day.
+	jde := jdeOfJSTMidnight(newDate(year, time.January, 1)) + normDeg(lon-280)*365.2422/360
+	for range 10 {
+		delta := 58 * sinDeg(lon-sunLongitude(jde))
+		jde += delta
+		if math.Abs(delta) < 1e-7 {
+			break
+		}
+	}
+	return jde
+}
+
+// solarTermDate returns the JST date on which the Sun's apparent longitude
+// reaches lon degrees during the given year.
+func solarTermDate(year int, lon float64) date {
+	return jstDateOfJD(jdeToUT(solarTerm(year, lon)))
+}
+
+// newMoon returns the JDE of the k-th new moon after that of 2000 January 6
+// (Meeus, chapter 49).
+func newMoon(k int) float64 {
+	kf := float64(k)
+	t := kf / 1236.85
+	t2, t3, t4 := t*t, t*t*t, t*t*t*t
+	jde := 2451550.09766 + synodicMonth*kf + 0.00015437*t2 - 0.000000150*t3 + 0.00000000073*t4
+	e := 1 - 0.002516*t - 0.0000074*t2
+	m := 2.5534 + 29.10535670*kf - 0.0000014*t2 - 0.00000011*t3
+	mp := 201.5643 + 385.81693528*kf + 0.0107582*t2 + 0.00001238*t3 - 0.000000058*t4
+	f := 160.7108 + 390.67050284*kf - 0.0016118*t2 - 0.00000227*t3 + 0.000000011*t4
+	omega := 124.7746 - 1.56375588*kf + 0.0020672*t2 + 0.00000215*t3
+
+	jde += -0.40720*sinDeg(mp) +
+		0.17241*e*sinDeg(m) +
+		0.01608*sinDeg(2*mp) +
+		0.01039*sinDeg(2*f) +
+		0.00739*e*sinDeg(mp-m) -
+		0.00514*e*sinDeg(mp+m) +
+		0.00208*e*e*sinDeg(2*m) -
+		0.00111*sinDeg(mp-2*f) -
+		0.00057*sinDeg(mp+2*f) +
+		0.00056*e*sinDeg(2*mp+m) -
+		0.00042*sinDeg(3*mp) +
+		0.00042*e*sinDeg(m+2*f) +
+		0.00038*e*sinDeg(m-2*f) -
+		0.00024*e*sinDeg(2*mp-m) -
+		0.00017*sinDeg(omega) -
+		0.00007*sinDeg(mp+2*m) +
+		0.00004*sinDeg(2*mp-2*f) +
+		0.00004*sinDeg(3*m) +
+		0.00003*sinDeg(mp+m-2*f) +
+		0.00003*sinDeg(2*mp+2*f) -
+		0.00003*sinDeg(mp+m+2*f) +
+		0.00003*sinDeg(mp-m+2*f) -
+		0.00002*sinDeg(mp-m-2*f) -
+		0.00002*sinDeg(3*mp+m) +
+		0.00002*sinDeg(4*mp)
+
+	// Planetary arguments.
+	jde += 0.000325*sinDeg(299.77+0.107408*kf-0.009173*t2) +
+		0.000165*sinDeg(251.88+0.016321*kf) +
+		0.000164*sinDeg(251.83+26.651886*kf) +
+		0.000126*sinDeg(349.42+36.412478*kf) +
+		0.000110*sinDeg(84.66+18.206239*kf) +
+		0.000062*sinDeg(141.74+53.303771*kf) +
+		0.000060*sinDeg(207.14+2.453732*kf) +
+		0.000056*sinDeg(154.84+7.306860*kf) +
+		0.000047*sinDeg(34.52+27.261239*kf) +
+		0.000042*sinDeg(207.19+0.121824*kf) +
+		0.000040*sinDeg(291.34+1.844379*kf) +
+		0.000037*sinDeg(161.72+24.198154*kf) +
+		0.000035*sinDeg(239.56+25.513099*kf) +
+		0.000023*sinDeg(331.55+3.592518*kf)
+	return jde
+}
+
+// newMoonDate returns the JST date of the k-th new moon.
+func newMoonDate(k int) date { return jstDateOfJD(jdeToUT(newMoon(k))) }
+
+// lunationOf returns the index k of the lunar month containing d: the new
+// moon k falls on or before d and new moon k+1 after it, by JST date.
+func lunationOf(d date) int {
+	k := int(math.Floor((jdeOfJSTMidnight(d) - 2451550.09766) / synodicMonth))
+	for !newMoonDate(k + 1).after(d) {
+		k++
+	}
+	for newMoonDate(k).after(d) {
+		k--
+	}
+	return k
+}
@@ -0,0 +1,65 @@
+package jpholiday
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestNewMoon_Meeus(t *testing.T) {
+	// Meeus, example 49.a: the new moon of 1977 February.
+	if got := newMoon(-283); math.Abs(got-2443192.65118) > 1e-5 {
+		t.Errorf("newMoon(-283) = %.5f, want 2443192.65118", got)
+	}
+}
+
+func TestSunLongitude_Meeus(t *testing.T) {
+	// Meeus, example 25.a: 1992 October 13.0 TD.
+	if got := sunLongitude(2448908.5); math.Abs(got-199.90895) > 1e-4 {
+		t.Errorf("sunLongitude = %.5f, want 199.90895", got)
+	}
+}
+
+// TestSolarTermDate_Equinoxes checks the computed equinoxes against the
+// dates of 春分の日 and 秋分の日 in the built-in dataset.
+func TestSolarTermDate_Equinoxes(t *testing.T) {
+	for d, name := range builtin().names {
+		var lon float64
+		switch name {
+		case "春分の日":
+			lon = 0
+		case "秋分の日":
+			lon = 180
+		default:
+			continue
+		}
+		if got := solarTermDate(d.year(), lon); got != d {
+			t.Errorf("solarTermDate(%d, %v) = %d, want %d", d.year(), lon, got, d)
+		}
+	}
+}
+
+func TestLunarDate(t *testing.T) {
+	tests := []struct {
+		date       date
+		month, day int
+		leap       bool
+	}{
+		{newDate(2024, time.February, 10), 1, 1, false},
+		{newDate(2024, time.February, 9), 12, 30, false},
+		{newDate(2017, time.June, 24), 5, 1, true},
+		{newDate(2017, time.July, 23), 6, 1, false},
+		{newDate(2023, time.March, 22), 2, 1, true},
+		{newDate(2025, time.July, 25), 6, 1, true},
+		{newDate(2033, time.November, 22), 11, 1, false},
+		{newDate(2033, time.December, 22), 11, 1, true},
+		{newDate(2034, time.January, 20), 12, 1, false},
+	}
+	for _, tt := range tests {
+		month, day, leap := lunarDate(tt.date)
+		if month != tt.month || day != tt.day || leap != tt.leap {
+			t.Errorf("lunarDate(%d) = %d/%d leap=%v, want %d/%d leap=%v",
+				tt.date, month, day, leap, tt.month, tt.day, tt.leap)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package jpholiday
+
+import (
+	"encoding/xml"
+	"io"
+	"time"
+)
+
+// AtomOptions configures [Calendar.ExportAtom].
+type AtomOptions struct {
+	// From and To limit the export to holidays in [From, To] inclusive.
+	// A zero value leaves that end of the range open.
+	From, To time.Time
+
+	// Title is the feed title. The zero value means "日本の祝日".
+	Title string
+
+	// Link, if set, is the URL the feed is served from. It is written as
+	// the feed's self link and used as its ID.
+	Link string
+}
+
+// atomNamespace is the XML namespace of Atom (RFC 4287).
+const atomNamespace = "http://www.w3.org/2005/Atom"
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"feed"`
+	NS      string      `xml:"xmlns,attr"`
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Author  atomAuthor  `xml:"author"`
+	Link    *atomLink   `xml:"link,omitempty"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomLink struct {
+	Rel  string `xml:"rel,attr"`
+	Href string `xml:"href,attr"`
+}
+
+type atomEntry struct {
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Content atomContent `xml:"content"`
+}
+
+type atomContent struct {
+	Type string `xml:"type,attr"`
+	Text string `xml:",chardata"`
+}
+
+// ExportAtom writes the effective holiday set (built-in + custom, minus
+// removed) as an Atom (RFC 4287) feed with one entry per holiday, for
+// portals that consume feeds but not iCalendar or JSON. Like ExportICS, the
+// output is deterministic: each entry's ID is derived from its date and its
+// updated time is the start of the holiday in JST, and the feed's updated
+// time is that of its last entry (or From, for an empty feed).
+func (c *Calendar) ExportAtom(w io.Writer, opts AtomOptions) error {
+	holidays := c.exportRange(opts.From, opts.To)
+
+	feed := atomFeed{
+		NS:      atomNamespace,
+		ID:      "urn:jp-holidays",
+		Title:   opts.Title,
+		Author:  atomAuthor{Name: "jp-holidays"},
+		Entries: make([]atomEntry, len(holidays)),
+	}
+	if feed.Title == "" {
+		feed.Title = "日本の祝日"
+	}
+	if opts.Link != "" {
+		feed.ID = opts.Link
+		feed.Link = &atomLink{Rel: "self", Href: opts.Link}
+	}
+	updated := time.Unix(0, 0).UTC()
+	if !opts.From.IsZero() {
+		updated = dateFromTime(opts.From).toTime()
+	}
+	for i, h := range holidays {
+		day := h.Date.Format(time.DateOnly)
+		updated = h.Date
+		feed.Entries[i] = atomEntry{
+			ID:      "urn:jp-holidays:" + day,
+			Title:   day + " " + h.Name,
+			Updated: atomTime(h.Date),
+			Content: atomContent{Type: "text", Text: h.Name + " (" + day + ")"},
+		}
+	}
+	feed.Updated = atomTime(updated)
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(feed); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// atomTime formats the start of a holiday date in JST as an RFC 3339
+// timestamp.
+func atomTime(d time.Time) string {
+	return time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, jstZone).Format(time.RFC3339)
+}
+
+// ExportAtom writes the default calendar's holidays as an Atom feed.
+func ExportAtom(w io.Writer, opts AtomOptions) error { return defaultCal.ExportAtom(w, opts) }
@@ -0,0 +1,70 @@
+package jpholiday_test
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	. "github.com/rabitt1ove/jp-holidays"
+)
+
+func TestExportAtom(t *testing.T) {
+	t.Parallel()
+
+	cal := New()
+	cal.AddCustomHoliday(d(2026, 6, 15), "R&D <記念日>")
+
+	var b strings.Builder
+	err := cal.ExportAtom(&b, AtomOptions{From: d(2026, 5, 6), To: d(2026, 6, 30), Link: "https://example.com/feed.atom"})
+	if err != nil {
+		t.Fatalf("ExportAtom error: %v", err)
+	}
+
+	want := `<?xml version="1.0" encoding="UTF-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom">
+  <id>https://example.com/feed.atom</id>
+  <title>日本の祝日</title>
+  <updated>2026-06-15T00:00:00+09:00</updated>
+  <author>
+    <name>jp-holidays</name>
+  </author>
+  <link rel="self" href="https://example.com/feed.atom"></link>
+  <entry>
+    <id>urn:jp-holidays:2026-05-06</id>
+    <title>2026-05-06 休日</title>
+    <updated>2026-05-06T00:00:00+09:00</updated>
+    <content type="text">休日 (2026-05-06)</content>
+  </entry>
+  <entry>
+    <id>urn:jp-holidays:2026-06-15</id>
+    <title>2026-06-15 R&amp;D &lt;記念日&gt;</title>
+    <updated>2026-06-15T00:00:00+09:00</updated>
+    <content type="text">R&amp;D &lt;記念日&gt; (2026-06-15)</content>
+  </entry>
+</feed>
+`
+	if got := b.String(); got != want {
+		t.Errorf("ExportAtom =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestExportAtom_Empty(t *testing.T) {
+	t.Parallel()
+
+	var b strings.Builder
+	if err := ExportAtom(&b, AtomOptions{From: d(2026, 6, 1), To: d(2026, 6, 30), Title: "Holidays"}); err != nil {
+		t.Fatalf("ExportAtom error: %v", err)
+	}
+	var feed struct {
+		ID      string   `xml:"id"`
+		Title   string   `xml:"title"`
+		Updated string   `xml:"updated"`
+		Entries []string `xml:"entry>id"`
+	}
+	if err := xml.Unmarshal([]byte(b.String()), &feed); err != nil {
+		t.Fatalf("unmarshal: %v\n%s", err, b.String())
+	}
+	if feed.ID != "urn:jp-holidays" || feed.Title != "Holidays" || feed.Updated != "2026-06-01T00:00:00+09:00" || len(feed.Entries) != 0 {
+		t.Errorf("feed = %+v", feed)
+	}
+}
@@ -0,0 +1,88 @@
+package jpholiday
+
+import "time"
+
+// NewBankCalendar creates a Calendar of Japanese bank business days
+// (銀行営業日): besides national holidays, banks are closed on Saturdays,
+// Sundays, and December 31 through January 3. It is equivalent to applying
+// a [Config] with [PresetBank] to a new calendar. The Tokyo Stock Exchange
+// observes the same closures, so it also serves as the trading calendar for
+// [Calendar.SettlementDate]. The closures cover the years of the dataset,
+// including years added later by [Calendar.ApplyPatch] or
+// [Calendar.RefreshFromOfficialSource].
+func NewBankCalendar() *Calendar {
+	c := New()
+	c.update(func(s *snapshot) { s.addYearly(bankClosures) })
+	return c
+}
+
+// bankClosures adds the bank closures that are not national holidays,
+// December 31, January 2, and January 3, for the years first through last.
+func bankClosures(s *snapshot, first, last int) {
+	for year := first; year <= last; year++ {
+		s.closed[newDate(year, time.January, 2)] = true
+		s.closed[newDate(year, time.January, 3)] = true
+		s.closed[newDate(year, time.December, 31)] = true
+	}
+}
+
+// BillPaymentDate returns the date on which a bill of exchange or
+// promissory note (手形) falling due on the given date is presented for
+// payment: the due date itself if it is a business day, otherwise the next
+// business day (手形法第72条). Use it with [NewBankCalendar] for bank
+// business days. ok is false if there is no business day within
+// maxSearchDays days.
+func (c *Calendar) BillPaymentDate(due time.Time) (payment time.Time, ok bool) {
+	return c.NextBusinessDay(due)
+}
+
+// SettlementDate returns the settlement date of a trade made on the given
+// date under the T+n convention: the n-th business day after the trade date
+// T (T+1, T+2, ...). If the trade date is not a business day, the next
+// business day is taken as T; n <= 0 returns T. Japanese equities settle T+2;
+// use [NewBankCalendar] for exchange and bank business days. ok is false if
+// the calendar runs out of business days (see [Calendar.NextBusinessDay]).
+func (c *Calendar) SettlementDate(trade time.Time, n int) (settlement time.Time, ok bool) {
+	s := c.load()
+	d, ok := s.searchBusinessDay(dateFromTime(trade), 1)
+	for n = max(n, 0); ok && n > 0; n-- {
+		d, ok = s.searchBusinessDay(d.addDays(1), 1)
+	}
+	if !ok {
+		return time.Time{}, false
+	}
+	return d.toTime(), true
+}
+
+// IsGotobi reports whether the given date (interpreted in JST) is a gotōbi
+// (五十日): a settlement day on the 5th, 10th, 15th, 20th, or 25th or the last
+// day of the month. A gotōbi that is not a business day moves to the
+// previous business day, so the result is always false on non-business days.
+func (c *Calendar) IsGotobi(t time.Time) bool {
+	s := c.load()
+	d := dateFromTime(t)
+	if !s.businessDay(d) {
+		return false
+	}
+	// d is a gotōbi if a nominal gotōbi falls between d and the next
+	// business day, since that gotōbi moves back to d.
+	for i := 0; i < maxSearchDays; i++ {
+		if isNominalGotobi(d) {
+			return true
+		}
+		if d = d.addDays(1); s.businessDay(d) {
+			return false
+		}
+	}
+	return false
+}
+
+// isNominalGotobi reports whether d falls on the 5th, 10th, 15th, 20th, or
+// 25th or the last day of its month.
+func isNominalGotobi(d date) bool {
+	y, m, day := d.ymd()
+	return day%5 == 0 && day <= 25 || day == time.Date(y, m+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// IsGotobi reports whether the given date is a gotōbi on the default calendar.
+func IsGotobi(t time.Time) bool { return defaultCal.IsGotobi(t) }
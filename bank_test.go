@@ -0,0 +1,135 @@
+package jpholiday_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/rabitt1ove/jp-holidays"
+)
+
+func TestNewBankCalendar(t *testing.T) {
+	t.Parallel()
+
+	cal := NewBankCalendar()
+	tests := []struct {
+		date time.Time
+		want bool
+	}{
+		{d(2026, time.December, 30), true},
+		{d(2026, time.December, 31), false},
+		{d(2027, time.January, 1), false},
+		{d(2027, time.January, 4), true},
+		{d(2026, time.June, 13), false}, // Saturday
+		{d(2026, time.May, 6), false},   // 休日
+	}
+	for _, tt := range tests {
+		if got := cal.IsBusinessDay(tt.date); got != tt.want {
+			t.Errorf("IsBusinessDay(%s) = %v, want %v", tt.date.Format(time.DateOnly), got, tt.want)
+		}
+	}
+}
+
+func TestBankPreset_RestoresWeekend(t *testing.T) {
+	t.Parallel()
+
+	cal := New()
+	cal.SetWeekend(time.Sunday)
+	if err := cal.LoadConfig(strings.NewReader("preset: bank\n")); err != nil {
+		t.Fatalf("LoadConfig error: %v", err)
+	}
+	if got := cal.Weekend(); len(got) != 2 || got[0] != time.Sunday || got[1] != time.Saturday {
+		t.Errorf("Weekend() = %v, want [Sunday Saturday]", got)
+	}
+
+	cal = New()
+	if err := cal.LoadConfig(strings.NewReader("preset: bank\nweekend: [Sun]\n")); err != nil {
+		t.Fatalf("LoadConfig error: %v", err)
+	}
+	if got := cal.Weekend(); len(got) != 1 {
+		t.Errorf("an explicit weekend should win over the preset, got %v", got)
+	}
+}
+
+func TestBillPaymentDate(t *testing.T) {
+	t.Parallel()
+
+	cal := NewBankCalendar()
+	tests := []struct {
+		due, want time.Time
+	}{
+		{d(2026, time.June, 10), d(2026, time.June, 10)},
+		{d(2026, time.June, 13), d(2026, time.June, 15)},       // Saturday
+		{d(2026, time.May, 3), d(2026, time.May, 7)},           // Golden Week
+		{d(2026, time.December, 31), d(2027, time.January, 4)}, // year-end closure
+	}
+	for _, tt := range tests {
+		got, ok := cal.BillPaymentDate(tt.due)
+		if !ok || !got.Equal(tt.want) {
+			t.Errorf("BillPaymentDate(%s) = %s, %v, want %s", tt.due.Format(time.DateOnly), got.Format(time.DateOnly), ok, tt.want.Format(time.DateOnly))
+		}
+	}
+}
+
+func TestSettlementDate(t *testing.T) {
+	t.Parallel()
+
+	cal := NewBankCalendar()
+	tests := []struct {
+		trade time.Time
+		n     int
+		want  time.Time
+	}{
+		{d(2026, time.June, 10), 0, d(2026, time.June, 10)},
+		{d(2026, time.June, 10), 1, d(2026, time.June, 11)},
+		{d(2026, time.June, 11), 2, d(2026, time.June, 15)}, // over the weekend
+		{d(2026, time.May, 1), 1, d(2026, time.May, 7)},     // over Golden Week
+		{d(2026, time.December, 29), 2, d(2027, time.January, 4)},
+		{d(2026, time.June, 13), 0, d(2026, time.June, 15)}, // Saturday rolls to Monday
+		{d(2026, time.June, 13), 2, d(2026, time.June, 17)},
+		{d(2026, time.June, 10), -1, d(2026, time.June, 10)},
+	}
+	for _, tt := range tests {
+		got, ok := cal.SettlementDate(tt.trade, tt.n)
+		if !ok || !got.Equal(tt.want) {
+			t.Errorf("SettlementDate(%s, %d) = %s, %v, want %s",
+				tt.trade.Format(time.DateOnly), tt.n, got.Format(time.DateOnly), ok, tt.want.Format(time.DateOnly))
+		}
+	}
+}
+
+func TestSettlementDate_NoBusinessDays(t *testing.T) {
+	t.Parallel()
+
+	cal := New()
+	cal.SetWeekend(time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday)
+	if got, ok := cal.SettlementDate(d(2026, time.June, 10), 2); ok || !got.IsZero() {
+		t.Errorf("SettlementDate = %s, %v, want zero time and false", got, ok)
+	}
+}
+
+func TestIsGotobi(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		date time.Time
+		want bool
+	}{
+		{d(2026, time.June, 5), true},
+		{d(2026, time.June, 10), true},
+		{d(2026, time.June, 11), false},
+		{d(2026, time.June, 30), true}, // end of month
+		{d(2026, time.July, 31), true},
+		{d(2026, time.July, 30), false},
+		{d(2026, time.July, 24), true},  // the 25th is a Saturday
+		{d(2026, time.July, 25), false}, // Saturday
+		{d(2026, time.May, 1), true},    // the 5th falls in Golden Week
+		{d(2026, time.May, 20), true},
+		{d(2026, time.February, 27), true}, // the 28th is a Saturday
+	}
+	for _, tt := range tests {
+		if got := IsGotobi(tt.date); got != tt.want {
+			t.Errorf("IsGotobi(%s) = %v, want %v", tt.date.Format(time.DateOnly), got, tt.want)
+		}
+	}
+}
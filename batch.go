@@ -0,0 +1,36 @@
+package jpholiday
+
+import "time"
+
+// AreHolidays reports, for each time in ts, whether its date is a holiday,
+// like calling [Calendar.IsHoliday] on each. All lookups read the same
+// snapshot of the calendar, so the results are consistent even if it is
+// changed concurrently, and the only allocation is the result slice. It is
+// intended for batch jobs that classify large numbers of timestamps.
+func (c *Calendar) AreHolidays(ts []time.Time) []bool {
+	s := c.load()
+	result := make([]bool, len(ts))
+	for i, t := range ts {
+		_, result[i] = s.lookup(dateFromTime(t))
+	}
+	return result
+}
+
+// LookupMany returns the holiday name for each time in ts, or an empty
+// string where the date is not a holiday, like calling
+// [Calendar.HolidayName] on each. Like [Calendar.AreHolidays], it reads a
+// single snapshot of the calendar.
+func (c *Calendar) LookupMany(ts []time.Time) []string {
+	s := c.load()
+	result := make([]string, len(ts))
+	for i, t := range ts {
+		result[i], _ = s.lookup(dateFromTime(t))
+	}
+	return result
+}
+
+// AreHolidays reports whether each date in ts is a holiday in the default calendar.
+func AreHolidays(ts []time.Time) []bool { return defaultCal.AreHolidays(ts) }
+
+// LookupMany returns the holiday name for each date in ts in the default calendar.
+func LookupMany(ts []time.Time) []string { return defaultCal.LookupMany(ts) }
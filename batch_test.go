@@ -0,0 +1,48 @@
+package jpholiday_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	. "github.com/rabitt1ove/jp-holidays"
+)
+
+func TestAreHolidaysAndLookupMany(t *testing.T) {
+	t.Parallel()
+
+	cal := New()
+	cal.AddCustomHoliday(d(2026, time.June, 15), "創立記念日")
+	ts := []time.Time{
+		d(2026, time.January, 1),
+		d(2026, time.January, 2),
+		time.Date(2026, time.May, 2, 15, 0, 0, 0, time.UTC), // 2026-05-03 00:00 JST
+		d(2026, time.June, 15),
+	}
+
+	if got, want := cal.AreHolidays(ts), []bool{true, false, true, true}; !slices.Equal(got, want) {
+		t.Errorf("AreHolidays = %v, want %v", got, want)
+	}
+	if got, want := cal.LookupMany(ts), []string{"元日", "", "憲法記念日", "創立記念日"}; !slices.Equal(got, want) {
+		t.Errorf("LookupMany = %q, want %q", got, want)
+	}
+	for i, tt := range ts {
+		if got := AreHolidays(ts)[i]; got != IsHoliday(tt) {
+			t.Errorf("AreHolidays()[%d] = %v, IsHoliday = %v", i, got, IsHoliday(tt))
+		}
+		if got := LookupMany(ts)[i]; got != HolidayName(tt) {
+			t.Errorf("LookupMany()[%d] = %q, HolidayName = %q", i, got, HolidayName(tt))
+		}
+	}
+}
+
+func TestAreHolidays_Empty(t *testing.T) {
+	t.Parallel()
+
+	if got := AreHolidays(nil); len(got) != 0 {
+		t.Errorf("AreHolidays(nil) = %v", got)
+	}
+	if got := LookupMany(nil); len(got) != 0 {
+		t.Errorf("LookupMany(nil) = %v", got)
+	}
+}
@@ -0,0 +1,29 @@
+//go:build jpholiday_csv
+
+package jpholiday
+
+import (
+	_ "embed"
+	"fmt"
+	"strings"
+)
+
+// builtinCSV is the Cabinet Office holiday CSV as downloaded by
+// cmd/genholidays, byte for byte, so that the shipped data can be compared
+// with the government artifact directly.
+//
+//go:embed syukujitsu.csv
+var builtinCSV []byte
+
+// builtinTable parses builtinCSV. It is called once, on first use of the
+// built-in dataset, and panics if the embedded file is corrupt.
+func builtinTable() map[date]string {
+	text, err := decodeShiftJIS(builtinCSV, csvShiftJIS)
+	if err == nil {
+		var names map[date]string
+		if names, err = parseHolidayCSV(strings.NewReader(text)); err == nil {
+			return names
+		}
+	}
+	panic(fmt.Sprintf("jpholiday: embedded syukujitsu.csv: %v", err))
+}
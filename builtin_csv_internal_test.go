@@ -0,0 +1,20 @@
+//go:build jpholiday_csv
+
+package jpholiday
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBuiltinCSV(t *testing.T) {
+	if err := VerifyDataIntegrity(); err != nil {
+		t.Fatal(err)
+	}
+	if got := builtin().names[newDate(2026, time.July, 20)]; got != "海の日" {
+		t.Errorf("2026-07-20 = %q, want 海の日", got)
+	}
+	if got := DatasetInfo().Rows; got != len(builtin().names) {
+		t.Errorf("Rows = %d, want %d", got, len(builtin().names))
+	}
+}
@@ -0,0 +1,7 @@
+//go:build !jpholiday_csv
+
+package jpholiday
+
+// builtinTable returns the holidays generated as Go source by
+// cmd/genholidays.
+func builtinTable() map[date]string { return builtinHolidays }
@@ -0,0 +1,21 @@
+package jpholiday
+
+import "time"
+
+// HolidayChecker is the subset of [Calendar] that most callers depend on.
+// Accepting a HolidayChecker instead of a *Calendar lets code be tested
+// with a fake calendar, or run against another country's holidays behind
+// the same contract.
+type HolidayChecker interface {
+	// IsHoliday reports whether t is a holiday.
+	IsHoliday(t time.Time) bool
+
+	// IsBusinessDay reports whether t is a business day.
+	IsBusinessDay(t time.Time) bool
+
+	// HolidaysBetween returns the holidays in [from, to], inclusive, in
+	// chronological order.
+	HolidaysBetween(from, to time.Time) []Holiday
+}
+
+var _ HolidayChecker = (*Calendar)(nil)
@@ -0,0 +1,55 @@
+package jpholiday_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/rabitt1ove/jp-holidays"
+)
+
+// fakeChecker treats every Wednesday as a holiday.
+type fakeChecker struct{}
+
+func (fakeChecker) IsHoliday(t time.Time) bool { return t.Weekday() == time.Wednesday }
+
+func (f fakeChecker) IsBusinessDay(t time.Time) bool {
+	wd := t.Weekday()
+	return wd != time.Saturday && wd != time.Sunday && !f.IsHoliday(t)
+}
+
+func (fakeChecker) HolidaysBetween(from, to time.Time) []Holiday {
+	var hs []Holiday
+	for t := from; !t.After(to); t = t.AddDate(0, 0, 1) {
+		if t.Weekday() == time.Wednesday {
+			hs = append(hs, Holiday{Date: t, Name: "水曜日"})
+		}
+	}
+	return hs
+}
+
+// countHolidays stands in for downstream code written against the interface.
+func countHolidays(hc HolidayChecker, from, to time.Time) int {
+	return len(hc.HolidaysBetween(from, to))
+}
+
+func TestHolidayChecker(t *testing.T) {
+	t.Parallel()
+
+	from, to := d(2026, time.May, 1), d(2026, time.May, 31)
+	tests := []struct {
+		name string
+		hc   HolidayChecker
+		want int
+	}{
+		{"Calendar", New(), 4},
+		{"fake", fakeChecker{}, 4},
+	}
+	for _, tt := range tests {
+		if got := countHolidays(tt.hc, from, to); got != tt.want {
+			t.Errorf("%s: countHolidays = %d, want %d", tt.name, got, tt.want)
+		}
+	}
+	if (fakeChecker{}).IsBusinessDay(d(2026, time.May, 6)) || !New().IsHoliday(d(2026, time.May, 6)) {
+		t.Error("2026-05-06 should be a day off for both checkers")
+	}
+}
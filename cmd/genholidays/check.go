@@ -0,0 +1,78 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+)
+
+// checkStubSource declares the jpholiday types referenced by generated code so
+// the output can be compiled in isolation. The %s verb is the holiday table,
+// which differs between the Go and CSV build modes.
+const checkStubSource = `package jpholiday
+
+import "time"
+
+type date int
+
+type DatasetMetadata struct {
+	SourceURL string
+	FetchedAt time.Time
+	SHA256    string
+	Rows      int
+}
+
+var (
+	_ = builtinDataset
+	_ = builtinChecksum
+	_ = builtinEnglishNames
+	_ = %s
+)
+`
+
+// compileCheck builds generated Go source in a throwaway module and runs
+// go vet on it with the given build tags, returning the tool output on
+// failure. This catches escaping or naming problems before the file is
+// committed. extra holds other generated files that src depends on.
+func compileCheck(ctx context.Context, src []byte, tags string, extra ...[]byte) error {
+	dir, err := os.MkdirTemp("", "genholidays-check-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	table := "builtinHolidays"
+	if tags == csvTag {
+		table = "csvShiftJIS"
+	}
+	files := map[string][]byte{
+		"go.mod":           []byte("module jpholidaycheck\n\ngo 1.25\n"),
+		"stub.go":          fmt.Appendf(nil, checkStubSource, table),
+		"holidays_data.go": src,
+	}
+	for i, content := range extra {
+		files[fmt.Sprintf("extra%d.go", i)] = content
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), content, 0600); err != nil {
+			return err
+		}
+	}
+
+	for _, cmdName := range []string{"build", "vet"} {
+		args := []string{cmdName, "-tags", tags, "./..."}
+		cmd := exec.CommandContext(ctx, "go", args...)
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(), "GOWORK=off", "GOFLAGS=-mod=mod")
+		var out bytes.Buffer
+		cmd.Stdout = &out
+		cmd.Stderr = &out
+		if err := cmd.Run(); err != nil {
+			return fmt.Errorf("go %s: %w\n%s", args[0], err, out.String())
+		}
+	}
+	return nil
+}
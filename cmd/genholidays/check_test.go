@@ -0,0 +1,67 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCompileCheck(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping go toolchain invocation in short mode")
+	}
+	t.Parallel()
+
+	src, err := generate(
+		[]holiday{{2024, time.January, 1, "元日"}, {2024, time.May, 3, `"quoted" \ name`}},
+		datasetMeta{SourceURL: fallbackURL1, FetchedAt: time.Date(2026, time.April, 12, 0, 0, 0, 0, time.UTC), SHA256: "abc", Rows: 2},
+		map[string]string{"元日": "New Year's Day"},
+	)
+	if err != nil {
+		t.Fatalf("generate error: %v", err)
+	}
+	if err := compileCheck(context.Background(), src, ""); err != nil {
+		t.Fatalf("compileCheck on generated source failed: %v", err)
+	}
+
+	recent, err := generateRecent(
+		[]holiday{{1999, time.January, 1, "元日"}, {2024, time.January, 1, "元日"}},
+		datasetMeta{Rows: 2},
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("generateRecent error: %v", err)
+	}
+	if err := compileCheck(context.Background(), recent, recentTag); err != nil {
+		t.Fatalf("compileCheck on recent source failed: %v", err)
+	}
+
+	csvMode, err := generateCSVMode([]byte("\x8c\xb3\x93\xfa"), []holiday{{2024, time.January, 1, "元日"}}, datasetMeta{Rows: 1}, nil)
+	if err != nil {
+		t.Fatalf("generateCSVMode error: %v", err)
+	}
+	sjis, err := generateShiftJIS([]byte("\x8c\xb3\x93\xfa"))
+	if err != nil {
+		t.Fatalf("generateShiftJIS error: %v", err)
+	}
+	if err := compileCheck(context.Background(), csvMode, csvTag, sjis); err != nil {
+		t.Fatalf("compileCheck on CSV mode source failed: %v", err)
+	}
+}
+
+func TestCompileCheck_Broken(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping go toolchain invocation in short mode")
+	}
+	t.Parallel()
+
+	src := []byte("package jpholiday\n\nvar builtinHolidays = map[date]string{\n\t20240101: undefinedName,\n}\n")
+	err := compileCheck(context.Background(), src, "")
+	if err == nil {
+		t.Fatal("expected compile check failure")
+	}
+	if !strings.Contains(err.Error(), "go build") {
+		t.Errorf("error should mention go build, got: %v", err)
+	}
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestNewHTTPClient_Default(t *testing.T) {
+	t.Parallel()
+
+	client, err := newHTTPClient("")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if client.Timeout != httpTimeout {
+		t.Errorf("Timeout = %v, want %v", client.Timeout, httpTimeout)
+	}
+	transport, ok := client.Transport.(*http.Transport)
+	if !ok {
+		t.Fatalf("Transport = %T, want *http.Transport", client.Transport)
+	}
+	if transport.Proxy == nil {
+		t.Error("Proxy should be configured from the environment")
+	}
+}
+
+func TestNewHTTPClient_CustomCA(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer ts.Close()
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw})
+	client, err := newHTTPClient(writeTempFile(t, "ca.pem", string(certPEM)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	resp, err := client.Get(ts.URL)
+	if err != nil {
+		t.Fatalf("request with custom CA failed: %v", err)
+	}
+	defer resp.Body.Close()
+	if got := mustReadAll(t, resp.Body); got != "ok" {
+		t.Errorf("body = %q, want ok", got)
+	}
+}
+
+func TestNewHTTPClient_UntrustedWithoutCA(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer ts.Close()
+
+	client, err := newHTTPClient("")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := client.Get(ts.URL); err == nil {
+		t.Error("expected TLS verification failure without -cacert")
+	}
+}
+
+func TestNewHTTPClient_CAErrors(t *testing.T) {
+	t.Parallel()
+
+	if _, err := newHTTPClient(filepath.Join(t.TempDir(), "missing.pem")); err == nil {
+		t.Error("expected error for missing CA file")
+	}
+
+	_, err := newHTTPClient(writeTempFile(t, "bad.pem", "not a certificate"))
+	if err == nil || !strings.Contains(err.Error(), "no PEM certificates") {
+		t.Errorf("error = %v, want no PEM certificates", err)
+	}
+}
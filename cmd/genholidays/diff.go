@@ -0,0 +1,198 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// holidayDiff describes the changes between two holiday datasets.
+type holidayDiff struct {
+	Added   []holiday
+	Removed []holiday
+	Renamed []renamedHoliday
+}
+
+// renamedHoliday is a date present in both datasets with a different name.
+type renamedHoliday struct {
+	Old holiday
+	New holiday
+}
+
+// empty reports whether the diff contains no changes.
+func (d holidayDiff) empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Renamed) == 0
+}
+
+// loadGenerated reads and parses a previously generated holidays_data.go file.
+func loadGenerated(path string) ([]holiday, error) {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return parseGenerated(src)
+}
+
+// parseGenerated extracts the builtinHolidays map literal from generated Go source.
+func parseGenerated(src []byte) ([]holiday, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "holidays_data.go", src, 0)
+	if err != nil {
+		return nil, fmt.Errorf("parsing generated source: %w", err)
+	}
+
+	lit := findVarLiteral(file, "builtinHolidays")
+	if lit == nil {
+		return nil, fmt.Errorf("builtinHolidays map literal not found")
+	}
+
+	holidays := make([]holiday, 0, len(lit.Elts))
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			return nil, fmt.Errorf("%s: unexpected map element", fset.Position(elt.Pos()))
+		}
+		h, err := parseGeneratedEntry(kv)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", fset.Position(kv.Pos()), err)
+		}
+		holidays = append(holidays, h)
+	}
+	return holidays, nil
+}
+
+// findVarLiteral returns the composite literal assigned to the named
+// package-level variable, or nil if there is none.
+func findVarLiteral(file *ast.File, name string) *ast.CompositeLit {
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.VAR {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			vs, ok := spec.(*ast.ValueSpec)
+			if !ok || len(vs.Names) != 1 || vs.Names[0].Name != name || len(vs.Values) != 1 {
+				continue
+			}
+			if cl, ok := vs.Values[0].(*ast.CompositeLit); ok {
+				return cl
+			}
+		}
+	}
+	return nil
+}
+
+// parseGeneratedEntry decodes a single `YYYYMMDD: "name"` entry.
+func parseGeneratedEntry(kv *ast.KeyValueExpr) (holiday, error) {
+	key, err := intLit(kv.Key)
+	if err != nil {
+		return holiday{}, fmt.Errorf("map key: %w", err)
+	}
+	t, err := time.Parse("20060102", strconv.Itoa(key))
+	if err != nil {
+		return holiday{}, fmt.Errorf("map key: invalid date %d", key)
+	}
+	year, month, day := t.Date()
+
+	val, ok := kv.Value.(*ast.BasicLit)
+	if !ok || val.Kind != token.STRING {
+		return holiday{}, fmt.Errorf("expected string value")
+	}
+	name, err := strconv.Unquote(val.Value)
+	if err != nil {
+		return holiday{}, fmt.Errorf("name: %w", err)
+	}
+
+	return holiday{year: year, month: month, day: day, name: name}, nil
+}
+
+func intLit(e ast.Expr) (int, error) {
+	lit, ok := e.(*ast.BasicLit)
+	if !ok || lit.Kind != token.INT {
+		return 0, fmt.Errorf("expected integer literal")
+	}
+	return strconv.Atoi(lit.Value)
+}
+
+// diffHolidays compares two datasets by date and reports added, removed,
+// and renamed holidays. Results are sorted by date.
+func diffHolidays(oldHolidays, newHolidays []holiday) holidayDiff {
+	type key struct {
+		year  int
+		month time.Month
+		day   int
+	}
+	oldByDate := make(map[key]holiday, len(oldHolidays))
+	for _, h := range oldHolidays {
+		oldByDate[key{h.year, h.month, h.day}] = h
+	}
+	newByDate := make(map[key]holiday, len(newHolidays))
+	for _, h := range newHolidays {
+		newByDate[key{h.year, h.month, h.day}] = h
+	}
+
+	var d holidayDiff
+	for k, nh := range newByDate {
+		oh, ok := oldByDate[k]
+		switch {
+		case !ok:
+			d.Added = append(d.Added, nh)
+		case oh.name != nh.name:
+			d.Renamed = append(d.Renamed, renamedHoliday{Old: oh, New: nh})
+		}
+	}
+	for k, oh := range oldByDate {
+		if _, ok := newByDate[k]; !ok {
+			d.Removed = append(d.Removed, oh)
+		}
+	}
+
+	sortHolidays(d.Added)
+	sortHolidays(d.Removed)
+	sort.Slice(d.Renamed, func(i, j int) bool {
+		return holidayLess(d.Renamed[i].New, d.Renamed[j].New)
+	})
+	return d
+}
+
+// checkAnomaly reports an error if more than maxChanges existing holidays
+// were removed or renamed, which usually indicates a truncated or mangled
+// upstream CSV rather than a real revision. Added holidays are not counted.
+func checkAnomaly(d holidayDiff, maxChanges int) error {
+	changed := len(d.Removed) + len(d.Renamed)
+	if changed > maxChanges {
+		return fmt.Errorf("%d existing holidays removed or renamed (limit %d)", changed, maxChanges)
+	}
+	return nil
+}
+
+// writeDiff prints a human-readable diff report.
+func writeDiff(w io.Writer, d holidayDiff) error {
+	if d.empty() {
+		_, err := fmt.Fprintln(w, "no changes")
+		return err
+	}
+	for _, h := range d.Added {
+		if _, err := fmt.Fprintf(w, "+ %s %s\n", h.dateString(), h.name); err != nil {
+			return err
+		}
+	}
+	for _, h := range d.Removed {
+		if _, err := fmt.Fprintf(w, "- %s %s\n", h.dateString(), h.name); err != nil {
+			return err
+		}
+	}
+	for _, r := range d.Renamed {
+		if _, err := fmt.Fprintf(w, "~ %s %s -> %s\n", r.New.dateString(), r.Old.name, r.New.name); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintf(w, "%d added, %d removed, %d renamed\n", len(d.Added), len(d.Removed), len(d.Renamed))
+	return err
+}
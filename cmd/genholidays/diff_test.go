@@ -0,0 +1,188 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseGenerated_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	want := []holiday{
+		{2024, time.January, 1, "元日"},
+		{2024, time.May, 3, "憲法記念日"},
+		{2025, time.January, 1, "元日"},
+	}
+	src, err := generate(append([]holiday(nil), want...), datasetMeta{}, nil)
+	if err != nil {
+		t.Fatalf("generate error: %v", err)
+	}
+
+	got, err := parseGenerated(src)
+	if err != nil {
+		t.Fatalf("parseGenerated error: %v", err)
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d holidays, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("holiday[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestParseGenerated_MissingMap(t *testing.T) {
+	t.Parallel()
+
+	_, err := parseGenerated([]byte("package jpholiday\n\nvar other = map[int]string{}\n"))
+	if err == nil {
+		t.Fatal("expected error when builtinHolidays is missing")
+	}
+	if !strings.Contains(err.Error(), "not found") {
+		t.Errorf("error should mention missing literal, got: %v", err)
+	}
+}
+
+func TestParseGenerated_InvalidSource(t *testing.T) {
+	t.Parallel()
+
+	if _, err := parseGenerated([]byte("not go source")); err == nil {
+		t.Fatal("expected parse error")
+	}
+}
+
+func TestParseGenerated_InvalidKey(t *testing.T) {
+	t.Parallel()
+
+	src := "package jpholiday\n\nvar builtinHolidays = map[date]string{\n\t20241301: \"元日\",\n}\n"
+	_, err := parseGenerated([]byte(src))
+	if err == nil {
+		t.Fatal("expected error for invalid date key")
+	}
+	if !strings.Contains(err.Error(), "20241301") {
+		t.Errorf("error should mention the bad key, got: %v", err)
+	}
+}
+
+func TestLoadGenerated(t *testing.T) {
+	t.Parallel()
+
+	src, err := generate([]holiday{{2024, time.January, 1, "元日"}}, datasetMeta{}, nil)
+	if err != nil {
+		t.Fatalf("generate error: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "holidays_data.go")
+	if err := os.WriteFile(path, src, 0644); err != nil {
+		t.Fatalf("write error: %v", err)
+	}
+
+	got, err := loadGenerated(path)
+	if err != nil {
+		t.Fatalf("loadGenerated error: %v", err)
+	}
+	if len(got) != 1 || got[0].name != "元日" {
+		t.Errorf("loadGenerated = %+v, want single 元日 entry", got)
+	}
+
+	if _, err := loadGenerated(filepath.Join(t.TempDir(), "missing.go")); err == nil {
+		t.Error("expected error for missing file")
+	}
+}
+
+func TestDiffHolidays(t *testing.T) {
+	t.Parallel()
+
+	oldHolidays := []holiday{
+		{2024, time.January, 1, "元日"},
+		{2024, time.October, 14, "体育の日"},
+		{2024, time.December, 23, "天皇誕生日"},
+	}
+	newHolidays := []holiday{
+		{2024, time.January, 1, "元日"},
+		{2024, time.October, 14, "スポーツの日"},
+		{2025, time.January, 1, "元日"},
+		{2024, time.November, 4, "休日"},
+	}
+
+	d := diffHolidays(oldHolidays, newHolidays)
+
+	if len(d.Added) != 2 {
+		t.Fatalf("added = %d, want 2", len(d.Added))
+	}
+	if d.Added[0].month != time.November || d.Added[1].year != 2025 {
+		t.Errorf("added should be sorted by date, got %+v", d.Added)
+	}
+	if len(d.Removed) != 1 || d.Removed[0].name != "天皇誕生日" {
+		t.Errorf("removed = %+v, want 天皇誕生日", d.Removed)
+	}
+	if len(d.Renamed) != 1 || d.Renamed[0].Old.name != "体育の日" || d.Renamed[0].New.name != "スポーツの日" {
+		t.Errorf("renamed = %+v, want 体育の日 -> スポーツの日", d.Renamed)
+	}
+}
+
+func TestWriteDiff(t *testing.T) {
+	t.Parallel()
+
+	d := holidayDiff{
+		Added:   []holiday{{2025, time.January, 1, "元日"}},
+		Removed: []holiday{{2024, time.December, 23, "天皇誕生日"}},
+		Renamed: []renamedHoliday{{
+			Old: holiday{2024, time.October, 14, "体育の日"},
+			New: holiday{2024, time.October, 14, "スポーツの日"},
+		}},
+	}
+
+	var b strings.Builder
+	if err := writeDiff(&b, d); err != nil {
+		t.Fatalf("writeDiff error: %v", err)
+	}
+
+	want := "+ 2025/01/01 元日\n" +
+		"- 2024/12/23 天皇誕生日\n" +
+		"~ 2024/10/14 体育の日 -> スポーツの日\n" +
+		"1 added, 1 removed, 1 renamed\n"
+	if got := b.String(); got != want {
+		t.Errorf("writeDiff output =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestWriteDiff_NoChanges(t *testing.T) {
+	t.Parallel()
+
+	var b strings.Builder
+	if err := writeDiff(&b, holidayDiff{}); err != nil {
+		t.Fatalf("writeDiff error: %v", err)
+	}
+	if got := b.String(); got != "no changes\n" {
+		t.Errorf("writeDiff output = %q, want %q", got, "no changes\n")
+	}
+}
+
+func TestCheckAnomaly(t *testing.T) {
+	t.Parallel()
+
+	h := holiday{2024, time.January, 1, "元日"}
+	tests := []struct {
+		name    string
+		diff    holidayDiff
+		max     int
+		wantErr bool
+	}{
+		{"no changes", holidayDiff{}, 0, false},
+		{"additions only", holidayDiff{Added: []holiday{h, h, h}}, 0, false},
+		{"at limit", holidayDiff{Removed: []holiday{h}, Renamed: []renamedHoliday{{h, h}}}, 2, false},
+		{"over limit", holidayDiff{Removed: []holiday{h, h}, Renamed: []renamedHoliday{{h, h}}}, 2, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkAnomaly(tt.diff, tt.max)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("checkAnomaly() error = %v, wantErr = %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Supported values for the -format flag.
+const (
+	formatGo   = "go"
+	formatJSON = "json"
+	formatYAML = "yaml"
+)
+
+// holidayRecord is the serialized form of a holiday in JSON and YAML output.
+type holidayRecord struct {
+	Date string `json:"date"`
+	Name string `json:"name"`
+}
+
+// validateFormat reports an error if format is not a supported -format value.
+func validateFormat(format string) error {
+	switch format {
+	case formatGo, formatJSON, formatYAML:
+		return nil
+	default:
+		return fmt.Errorf("unknown format %q (expected %s, %s, or %s)", format, formatGo, formatJSON, formatYAML)
+	}
+}
+
+// render produces the output file contents in the requested format.
+// Dataset metadata and English names are only embedded in Go output.
+func render(format string, holidays []holiday, meta datasetMeta, english map[string]string) ([]byte, error) {
+	switch format {
+	case formatGo:
+		return generate(holidays, meta, english)
+	case formatJSON:
+		return generateJSON(holidays)
+	case formatYAML:
+		return generateYAML(holidays), nil
+	default:
+		return nil, validateFormat(format)
+	}
+}
+
+// isoDate formats the holiday date as YYYY-MM-DD.
+func (h holiday) isoDate() string {
+	return fmt.Sprintf("%04d-%02d-%02d", h.year, int(h.month), h.day)
+}
+
+func toRecords(holidays []holiday) []holidayRecord {
+	sortHolidays(holidays)
+	records := make([]holidayRecord, len(holidays))
+	for i, h := range holidays {
+		records[i] = holidayRecord{Date: h.isoDate(), Name: h.name}
+	}
+	return records
+}
+
+// generateJSON produces an indented JSON array of {"date", "name"} objects.
+func generateJSON(holidays []holiday) ([]byte, error) {
+	b, err := json.MarshalIndent(toRecords(holidays), "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '\n'), nil
+}
+
+// generateYAML produces a YAML sequence of {date, name} mappings.
+// Names are emitted as double-quoted scalars, which YAML parses identically
+// to Go's quoted string syntax for the characters found in the dataset.
+func generateYAML(holidays []holiday) []byte {
+	var b strings.Builder
+	b.WriteString("# Code generated by cmd/genholidays; DO NOT EDIT.\n")
+	for _, r := range toRecords(holidays) {
+		fmt.Fprintf(&b, "- date: %s\n  name: %s\n", r.Date, strconv.Quote(r.Name))
+	}
+	return []byte(b.String())
+}
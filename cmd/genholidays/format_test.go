@@ -0,0 +1,91 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestValidateFormat(t *testing.T) {
+	t.Parallel()
+
+	for _, f := range []string{formatGo, formatJSON, formatYAML} {
+		if err := validateFormat(f); err != nil {
+			t.Errorf("validateFormat(%q) = %v, want nil", f, err)
+		}
+	}
+	if err := validateFormat("xml"); err == nil {
+		t.Error("validateFormat(xml) should fail")
+	}
+}
+
+func TestRender_UnknownFormat(t *testing.T) {
+	t.Parallel()
+
+	if _, err := render("xml", nil, datasetMeta{}, nil); err == nil {
+		t.Fatal("expected error for unknown format")
+	}
+}
+
+func TestRender_Go(t *testing.T) {
+	t.Parallel()
+
+	src, err := render(formatGo, []holiday{{2024, time.January, 1, "元日"}}, datasetMeta{}, nil)
+	if err != nil {
+		t.Fatalf("render error: %v", err)
+	}
+	if !strings.Contains(string(src), "var builtinHolidays") {
+		t.Error("go format should produce the builtinHolidays map")
+	}
+}
+
+func TestRender_JSON(t *testing.T) {
+	t.Parallel()
+
+	holidays := []holiday{
+		{2024, time.May, 3, "憲法記念日"},
+		{2024, time.January, 1, "元日"},
+	}
+	out, err := render(formatJSON, holidays, datasetMeta{}, nil)
+	if err != nil {
+		t.Fatalf("render error: %v", err)
+	}
+
+	var records []holidayRecord
+	if err := json.Unmarshal(out, &records); err != nil {
+		t.Fatalf("output is not valid JSON: %v", err)
+	}
+	want := []holidayRecord{
+		{Date: "2024-01-01", Name: "元日"},
+		{Date: "2024-05-03", Name: "憲法記念日"},
+	}
+	if len(records) != len(want) {
+		t.Fatalf("got %d records, want %d", len(records), len(want))
+	}
+	for i := range want {
+		if records[i] != want[i] {
+			t.Errorf("record[%d] = %+v, want %+v", i, records[i], want[i])
+		}
+	}
+}
+
+func TestRender_YAML(t *testing.T) {
+	t.Parallel()
+
+	holidays := []holiday{
+		{2024, time.May, 3, "憲法記念日"},
+		{2024, time.January, 1, "元日"},
+	}
+	out, err := render(formatYAML, holidays, datasetMeta{}, nil)
+	if err != nil {
+		t.Fatalf("render error: %v", err)
+	}
+
+	want := "# Code generated by cmd/genholidays; DO NOT EDIT.\n" +
+		"- date: 2024-01-01\n  name: \"元日\"\n" +
+		"- date: 2024-05-03\n  name: \"憲法記念日\"\n"
+	if got := string(out); got != want {
+		t.Errorf("YAML output =\n%s\nwant\n%s", got, want)
+	}
+}
@@ -8,12 +8,51 @@
 //
 // Usage:
 //
-//	go run main.go -output ../../holidays_data.go
+//	go run . -output ../../holidays_data.go
+//
+// With -diff, the existing output file is compared against the freshly
+// fetched dataset and a report of added, removed, and renamed holidays is
+// printed instead of writing the file:
+//
+//	go run . -output ../../holidays_data.go -diff
+//
+// With -verify, the existing output file is checked against the freshly
+// fetched dataset and the command exits non-zero if it is stale, which is
+// suitable for scheduled CI freshness checks:
+//
+//	go run . -output ../../holidays_data.go -verify
+//
+// Go output is written three times: the full dataset to the -output file,
+// the holidays from 2000 onward to a sibling file with a _recent suffix
+// (e.g., holidays_data_recent.go), and the metadata for the CSV build mode
+// to a sibling file with a _csv suffix, next to the downloaded CSV itself
+// (syukujitsu.csv). Build constraints select between them: the
+// jpholiday_recent build tag compiles the trimmed file instead of the full
+// one, for size-constrained targets such as TinyGo or WebAssembly, and the
+// jpholiday_csv build tag embeds the verbatim CSV instead of Go literals,
+// for users who must ship the government artifact for auditing. A fourth
+// sibling file with a _sjis suffix, compiled in every build, holds the
+// Shift_JIS characters of the CSV, with which the package decodes the
+// embedded CSV and the CSV downloaded by RefreshFromOfficialSource.
+//
+// With -format json or -format yaml, the dataset is written as a list of
+// date/name records instead of Go source, for consumers outside Go:
+//
+//	go run . -format json -output holidays.json
+//
+// The HTTP client honors the HTTPS_PROXY, HTTP_PROXY, and NO_PROXY
+// environment variables. Use -cacert to trust an additional CA, such as the
+// one used by a TLS-intercepting corporate proxy:
+//
+//	HTTPS_PROXY=http://proxy.example.com:8080 go run . -cacert corp-ca.pem
 package main
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
 	"encoding/csv"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"flag"
@@ -21,13 +60,18 @@ import (
 	"go/format"
 	"io"
 	"log"
+	"maps"
 	"net/http"
 	"net/url"
 	"os"
+	"os/signal"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strings"
+	"syscall"
 	"time"
+	"unicode/utf8"
 
 	"golang.org/x/text/encoding/japanese"
 	"golang.org/x/text/transform"
@@ -43,9 +87,17 @@ const (
 
 	minExpectedRows = 1000
 
+	// defaultMaxChanges is the number of existing rows that may be removed or
+	// renamed in a single update before generation is aborted. Legitimate
+	// revisions (e.g., a moved holiday) touch only a handful of rows.
+	defaultMaxChanges = 10
+
 	httpTimeout = 30 * time.Second
 	maxRetries  = 3
 
+	// defaultTimeout bounds the whole fetch, including retries and fallbacks.
+	defaultTimeout = 3 * time.Minute
+
 	// Maximum response sizes to prevent memory exhaustion.
 	maxJSONResponseSize = 1 * 1024 * 1024 // 1 MB for CKAN API response
 	maxCSVResponseSize  = 5 * 1024 * 1024 // 5 MB for CSV data
@@ -54,6 +106,16 @@ const (
 
 	// cacheMetadataPath stores validators used for conditional GET requests.
 	cacheMetadataPath = ".cache/fetch-metadata.json"
+
+	// recentTag is the build tag that selects the trimmed dataset, and
+	// recentFromYear is the first year it includes.
+	recentTag      = "jpholiday_recent"
+	recentFromYear = 2000
+
+	// csvTag is the build tag that embeds the downloaded CSV, and
+	// csvFileName is the name of the CSV written next to the Go output.
+	csvTag      = "jpholiday_csv"
+	csvFileName = "syukujitsu.csv"
 )
 
 // retryBaseDelay is the base delay between retry attempts (variable for testing).
@@ -83,10 +145,12 @@ type cacheEntry struct {
 }
 
 type csvFetchResult struct {
-	Reader       io.Reader
+	Reader       io.Reader // Decoded (UTF-8) CSV content.
+	Raw          []byte    // Undecoded (Shift_JIS) CSV content.
 	URL          string
 	ETag         string
 	LastModified string
+	SHA256       string // Hex-encoded SHA-256 of the raw CSV bytes.
 	NotModified  bool
 }
 
@@ -110,14 +174,58 @@ type holiday struct {
 
 func main() {
 	output := flag.String("output", "holidays_data.go", "output file path")
+	outputFormat := flag.String("format", formatGo, "output format: go, json, or yaml")
+	diffMode := flag.Bool("diff", false, "print changes against the existing output file instead of writing it")
+	translationsPath := flag.String("translations", "translations.json", "JSON file mapping Japanese holiday names to English (empty to disable)")
+	timeout := flag.Duration("timeout", defaultTimeout, "overall time limit for fetching, including retries (0 disables)")
+	caCert := flag.String("cacert", "", "PEM file with additional trusted CA certificates (e.g., for an intercepting proxy)")
+	maxChanges := flag.Int("max-changes", defaultMaxChanges, "abort if more than this many existing holidays are removed or renamed")
+	check := flag.Bool("check", true, "compile and vet generated Go source before writing it")
+	force := flag.Bool("force", false, "write output even if the anomaly check fails")
+	verifyMode := flag.Bool("verify", false, "exit non-zero if the existing output file is stale")
 	flag.Parse()
 
 	log.SetFlags(0)
 	log.SetPrefix("genholidays: ")
 
-	client := &http.Client{Timeout: httpTimeout}
+	if err := validateFormat(*outputFormat); err != nil {
+		log.Fatal(err)
+	}
+
+	client, err := newHTTPClient(*caCert)
+	if err != nil {
+		log.Fatalf("failed to configure HTTP client: %v", err)
+	}
 
-	result, err := fetchCSV(client)
+	english, err := loadTranslations(*translationsPath)
+	if err != nil {
+		log.Fatalf("failed to load translations: %v", err)
+	}
+
+	metadataPath := fetchMetadataPath(*outputFormat, *diffMode || *verifyMode)
+
+	// The existing Go output is regenerated, even from an unchanged CSV,
+	// if its English names differ from the translations, so the cached
+	// validators are not sent and the CSV checksum is not compared.
+	var existing []byte
+	if *outputFormat == formatGo && !*diffMode && !*verifyMode {
+		existing, _ = os.ReadFile(*output)
+	}
+	translationsChanged := existing != nil && !translationsCurrent(existing, english)
+	fetchPath := metadataPath
+	if translationsChanged {
+		log.Printf("translations changed; regenerating %s", *output)
+		fetchPath = ""
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+	if *timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
+	}
+
+	result, err := fetchCSV(ctx, client, fetchPath)
 	if err != nil {
 		log.Fatalf("failed to fetch CSV: %v", err)
 	}
@@ -135,32 +243,134 @@ func main() {
 		log.Fatalf("validation failed: expected at least %d rows, got %d", minExpectedRows, len(holidays))
 	}
 
-	src, err := generate(holidays)
+	if *diffMode || *verifyMode {
+		previous, err := loadGenerated(*output)
+		if err != nil {
+			log.Fatalf("failed to load existing output: %v", err)
+		}
+		d := diffHolidays(previous, holidays)
+		if err := writeDiff(os.Stdout, d); err != nil {
+			log.Fatalf("failed to write diff: %v", err)
+		}
+		if *verifyMode && !d.empty() {
+			log.Fatalf("%s is stale; run without -verify to regenerate", *output)
+		}
+		return
+	}
+
+	if existing != nil {
+		if sum, err := parseGeneratedSHA256(existing); err == nil && sum == result.SHA256 && !translationsChanged {
+			log.Printf("source CSV checksum unchanged; skipping generation")
+			return
+		}
+		previous, err := parseGenerated(existing)
+		if err != nil {
+			log.Printf("warning: skipping anomaly check, existing output unreadable: %v", err)
+		} else if err := checkAnomaly(diffHolidays(previous, holidays), *maxChanges); err != nil {
+			if !*force {
+				log.Fatalf("anomaly check failed: %v (rerun with -force to accept)", err)
+			}
+			log.Printf("warning: %v (accepted with -force)", err)
+		}
+	}
+
+	if *translationsPath != "" {
+		for _, name := range missingTranslations(holidays, english) {
+			log.Printf("warning: no English translation for %q in %s", name, *translationsPath)
+		}
+	}
+
+	meta := datasetMeta{
+		SourceURL: result.URL,
+		FetchedAt: time.Now().UTC().Truncate(time.Second),
+		SHA256:    result.SHA256,
+		Rows:      len(holidays),
+	}
+	src, err := render(*outputFormat, holidays, meta, english)
 	if err != nil {
-		log.Fatalf("failed to generate source: %v", err)
+		log.Fatalf("failed to generate output: %v", err)
+	}
+
+	var recentSrc, csvSrc, sjisSrc []byte
+	if *outputFormat == formatGo {
+		if recentSrc, err = generateRecent(holidays, meta, english); err != nil {
+			log.Fatalf("failed to generate output: %v", err)
+		}
+		if csvSrc, err = generateCSVMode(result.Raw, holidays, meta, english); err != nil {
+			log.Fatalf("failed to generate output: %v", err)
+		}
+		if sjisSrc, err = generateShiftJIS(result.Raw); err != nil {
+			log.Fatalf("failed to generate output: %v", err)
+		}
+	}
+
+	if *outputFormat == formatGo && *check {
+		if err := compileCheck(ctx, src, ""); err != nil {
+			log.Fatalf("generated source failed compile check: %v", err)
+		}
+		if err := compileCheck(ctx, recentSrc, recentTag); err != nil {
+			log.Fatalf("generated %s source failed compile check: %v", recentTag, err)
+		}
+		if err := compileCheck(ctx, csvSrc, csvTag, sjisSrc); err != nil {
+			log.Fatalf("generated %s source failed compile check: %v", csvTag, err)
+		}
 	}
 
 	if err := os.WriteFile(*output, src, 0644); err != nil {
 		log.Fatalf("failed to write output: %v", err)
 	}
+	if recentSrc != nil {
+		if err := os.WriteFile(recentOutputPath(*output), recentSrc, 0644); err != nil {
+			log.Fatalf("failed to write output: %v", err)
+		}
+	}
+	if csvSrc != nil {
+		if err := os.WriteFile(csvModeOutputPath(*output), csvSrc, 0644); err != nil {
+			log.Fatalf("failed to write output: %v", err)
+		}
+		if err := os.WriteFile(filepath.Join(filepath.Dir(*output), csvFileName), result.Raw, 0644); err != nil {
+			log.Fatalf("failed to write output: %v", err)
+		}
+	}
+	if sjisSrc != nil {
+		if err := os.WriteFile(sjisOutputPath(*output), sjisSrc, 0644); err != nil {
+			log.Fatalf("failed to write output: %v", err)
+		}
+	}
 
-	if err := updateFetchMetadata(cacheMetadataPath, result.URL, result.ETag, result.LastModified); err != nil {
-		log.Printf("warning: failed to update fetch metadata: %v", err)
+	if metadataPath != "" {
+		if err := updateFetchMetadata(metadataPath, result.URL, result.ETag, result.LastModified); err != nil {
+			log.Printf("warning: failed to update fetch metadata: %v", err)
+		}
 	}
 
 	log.Printf("wrote %d holidays to %s", len(holidays), *output)
 }
 
+// fetchMetadataPath returns the path of the validators used for conditional
+// GET requests, or "" to fetch the full CSV without recording validators.
+// The validators describe the last generation of Go source, so only that
+// uses them: a JSON or YAML export must neither be skipped because the Go
+// source is current nor make a later Go generation skip as not modified.
+// Comparison modes always fetch the full CSV so the result reflects the
+// committed file rather than the last local generation.
+func fetchMetadataPath(outputFormat string, compare bool) string {
+	if outputFormat != formatGo || compare {
+		return ""
+	}
+	return cacheMetadataPath
+}
+
 // resolveCSVURL queries the CKAN API to get the current CSV download URL.
-func resolveCSVURL(client *http.Client) (string, error) {
-	return resolveCSVURLWithRetry(client, ckanAPIURL)
+func resolveCSVURL(ctx context.Context, client *http.Client) (string, error) {
+	return resolveCSVURLWithRetry(ctx, client, ckanAPIURL)
 }
 
 // resolveCSVURLFrom queries the given CKAN API endpoint to get the current CSV download URL.
-func resolveCSVURLFrom(client *http.Client, apiURL string) (string, error) {
+func resolveCSVURLFrom(ctx context.Context, client *http.Client, apiURL string) (string, error) {
 	log.Printf("resolving CSV URL via CKAN API: %s", apiURL)
 
-	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, apiURL, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, apiURL, nil)
 	if err != nil {
 		return "", err
 	}
@@ -204,16 +414,18 @@ func resolveCSVURLFrom(client *http.Client, apiURL string) (string, error) {
 	return "", fmt.Errorf("no CSV resource found in CKAN response")
 }
 
-func resolveCSVURLWithRetry(client *http.Client, apiURL string) (string, error) {
+func resolveCSVURLWithRetry(ctx context.Context, client *http.Client, apiURL string) (string, error) {
 	var lastErr error
 	for attempt := range maxRetries {
 		if attempt > 0 {
 			delay := retryBaseDelay * time.Duration(1<<(attempt-1))
 			log.Printf("  retrying CKAN API in %v (attempt %d/%d)", delay, attempt+1, maxRetries)
-			time.Sleep(delay)
+			if err := sleepContext(ctx, delay); err != nil {
+				return "", fmt.Errorf("CKAN API: %w (last error: %v)", err, lastErr)
+			}
 		}
 
-		resolvedURL, err := resolveCSVURLFrom(client, apiURL)
+		resolvedURL, err := resolveCSVURLFrom(ctx, client, apiURL)
 		if err == nil {
 			return resolvedURL, nil
 		}
@@ -244,16 +456,18 @@ func validateCSVURL(rawURL string) error {
 
 // fetchCSV resolves the CSV URL and fetches it with retries.
 // Strategy: CKAN API -> fallback URL 1 -> fallback URL 2.
-func fetchCSV(client *http.Client) (csvFetchResult, error) {
-	return fetchCSVWithFallbacks(client, ckanAPIURL, fallbackURL1, fallbackURL2)
+// Cached validators in metadataPath are used for conditional GET requests;
+// an empty metadataPath always fetches the full CSV.
+func fetchCSV(ctx context.Context, client *http.Client, metadataPath string) (csvFetchResult, error) {
+	return fetchCSVWithFallbacksAndMetadata(ctx, client, ckanAPIURL, fallbackURL1, fallbackURL2, metadataPath)
 }
 
 // fetchCSVWithFallbacks resolves the CSV URL via the given CKAN API and fetches it with retries.
-func fetchCSVWithFallbacks(client *http.Client, ckanURL, fb1, fb2 string) (csvFetchResult, error) {
-	return fetchCSVWithFallbacksAndMetadata(client, ckanURL, fb1, fb2, cacheMetadataPath)
+func fetchCSVWithFallbacks(ctx context.Context, client *http.Client, ckanURL, fb1, fb2 string) (csvFetchResult, error) {
+	return fetchCSVWithFallbacksAndMetadata(ctx, client, ckanURL, fb1, fb2, cacheMetadataPath)
 }
 
-func fetchCSVWithFallbacksAndMetadata(client *http.Client, ckanURL, fb1, fb2, metadataPath string) (csvFetchResult, error) {
+func fetchCSVWithFallbacksAndMetadata(ctx context.Context, client *http.Client, ckanURL, fb1, fb2, metadataPath string) (csvFetchResult, error) {
 	// Build ordered list of URLs to try.
 	var urls []string
 	meta, err := loadFetchMetadata(metadataPath)
@@ -263,7 +477,7 @@ func fetchCSVWithFallbacksAndMetadata(client *http.Client, ckanURL, fb1, fb2, me
 	}
 
 	// Try CKAN API first.
-	if resolved, err := resolveCSVURLWithRetry(client, ckanURL); err != nil {
+	if resolved, err := resolveCSVURLWithRetry(ctx, client, ckanURL); err != nil {
 		log.Printf("  CKAN API failed: %v (falling back to direct URLs)", err)
 	} else {
 		urls = append(urls, resolved)
@@ -278,8 +492,11 @@ func fetchCSVWithFallbacksAndMetadata(client *http.Client, ckanURL, fb1, fb2, me
 
 	var lastErr error
 	for _, url := range urls {
+		if err := ctx.Err(); err != nil {
+			return csvFetchResult{}, fmt.Errorf("fetch canceled: %w", err)
+		}
 		entry := meta.Entries[url]
-		reader, etag, lastModified, notModified, err := fetchWithRetry(client, url, entry.ETag, entry.LastModified)
+		reader, etag, lastModified, notModified, err := fetchWithRetry(ctx, client, url, entry.ETag, entry.LastModified)
 		if err != nil {
 			lastErr = err
 			continue
@@ -290,28 +507,39 @@ func fetchCSVWithFallbacksAndMetadata(client *http.Client, ckanURL, fb1, fb2, me
 				NotModified: true,
 			}, nil
 		}
+		raw, err := io.ReadAll(reader)
+		if err != nil {
+			lastErr = fmt.Errorf("reading %s: %w", url, err)
+			continue
+		}
+		sum := sha256.Sum256(raw)
 		return csvFetchResult{
-			Reader:       reader,
+			Reader:       transform.NewReader(bytes.NewReader(raw), japanese.ShiftJIS.NewDecoder()),
+			Raw:          raw,
 			URL:          url,
 			ETag:         etag,
 			LastModified: lastModified,
+			SHA256:       hex.EncodeToString(sum[:]),
 		}, nil
 	}
 	return csvFetchResult{}, fmt.Errorf("all URLs failed, last error: %w", lastErr)
 }
 
 // fetchWithRetry fetches a URL with exponential backoff retries.
-func fetchWithRetry(client *http.Client, url, etag, lastModified string) (io.Reader, string, string, bool, error) {
+// The returned reader yields the raw (undecoded) response body.
+func fetchWithRetry(ctx context.Context, client *http.Client, url, etag, lastModified string) (io.Reader, string, string, bool, error) {
 	var lastErr error
 	for attempt := range maxRetries {
 		if attempt > 0 {
 			delay := retryBaseDelay * time.Duration(1<<(attempt-1))
 			log.Printf("  retrying in %v (attempt %d/%d)", delay, attempt+1, maxRetries)
-			time.Sleep(delay)
+			if err := sleepContext(ctx, delay); err != nil {
+				return nil, "", "", false, fmt.Errorf("GET %s: %w (last error: %v)", url, err, lastErr)
+			}
 		}
 
 		log.Printf("fetching %s", url)
-		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, url, nil)
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 		if err != nil {
 			return nil, "", "", false, fmt.Errorf("creating request: %w", err)
 		}
@@ -348,19 +576,38 @@ func fetchWithRetry(client *http.Client, url, etag, lastModified string) (io.Rea
 		}
 
 		limited := io.LimitReader(resp.Body, maxCSVResponseSize)
-		decoder := japanese.ShiftJIS.NewDecoder()
-		return transform.NewReader(limited, decoder), resp.Header.Get("ETag"), resp.Header.Get("Last-Modified"), false, nil
+		return limited, resp.Header.Get("ETag"), resp.Header.Get("Last-Modified"), false, nil
 	}
 	return nil, "", "", false, lastErr
 }
 
+// sleepContext waits for d or until ctx is done, whichever comes first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
 func isRetryableStatus(statusCode int) bool {
 	return statusCode == http.StatusTooManyRequests ||
 		statusCode == http.StatusServiceUnavailable ||
 		statusCode >= 500
 }
 
+// loadFetchMetadata reads cached validators from path.
+// An empty path disables conditional requests.
 func loadFetchMetadata(path string) (fetchMetadata, error) {
+	if path == "" {
+		return fetchMetadata{Entries: map[string]cacheEntry{}}, nil
+	}
 	data, err := os.ReadFile(path)
 	if err != nil {
 		if errors.Is(err, os.ErrNotExist) {
@@ -458,27 +705,159 @@ func parseCSV(r io.Reader) ([]holiday, error) {
 	return holidays, nil
 }
 
+// dateString formats the holiday date as YYYY/MM/DD.
+func (h holiday) dateString() string {
+	return fmt.Sprintf("%04d/%02d/%02d", h.year, int(h.month), h.day)
+}
+
+// holidayLess orders holidays chronologically.
+func holidayLess(a, b holiday) bool {
+	if a.year != b.year {
+		return a.year < b.year
+	}
+	if a.month != b.month {
+		return a.month < b.month
+	}
+	return a.day < b.day
+}
+
+// sortHolidays sorts holidays chronologically in place.
+func sortHolidays(holidays []holiday) {
+	sort.Slice(holidays, func(i, j int) bool {
+		return holidayLess(holidays[i], holidays[j])
+	})
+}
+
 // monthConstName returns the time.Month constant name (e.g., "time.January").
 func monthConstName(m time.Month) string {
 	return "time." + m.String()
 }
 
-// generate produces a formatted Go source file containing the holiday data.
-func generate(holidays []holiday) ([]byte, error) {
-	sort.Slice(holidays, func(i, j int) bool {
-		if holidays[i].year != holidays[j].year {
-			return holidays[i].year < holidays[j].year
+// generate produces a formatted Go source file containing the holiday data,
+// the dataset metadata exposed by jpholiday.DatasetInfo, and English names
+// for the holidays present in the dataset. Holiday dates are written as
+// packed YYYYMMDD keys, matching the package's internal date type. The file
+// is excluded by the jpholiday_recent build tag.
+func generate(holidays []holiday, meta datasetMeta, english map[string]string) ([]byte, error) {
+	return generateConstrained(holidays, meta, english, "!"+recentTag+" && !"+csvTag)
+}
+
+// generateRecent is like generate but keeps only the holidays from
+// recentFromYear onward, and the file is compiled only with the
+// jpholiday_recent build tag. Rows counts the holidays kept; the other
+// metadata still describes the source CSV.
+func generateRecent(holidays []holiday, meta datasetMeta, english map[string]string) ([]byte, error) {
+	var recent []holiday
+	for _, h := range holidays {
+		if h.year >= recentFromYear {
+			recent = append(recent, h)
+		}
+	}
+	meta.Rows = len(recent)
+	return generateConstrained(recent, meta, english, recentTag+" && !"+csvTag)
+}
+
+// recentOutputPath returns the path of the trimmed dataset written next to
+// the Go output file path.
+func recentOutputPath(path string) string {
+	return strings.TrimSuffix(path, ".go") + "_recent.go"
+}
+
+// csvModeOutputPath returns the path of the CSV build mode file written
+// next to the Go output file path.
+func csvModeOutputPath(path string) string {
+	return strings.TrimSuffix(path, ".go") + "_csv.go"
+}
+
+// sjisOutputPath returns the path of the Shift_JIS table written next to
+// the Go output file path.
+func sjisOutputPath(path string) string {
+	return strings.TrimSuffix(path, ".go") + "_sjis.go"
+}
+
+// generateCSVMode produces the Go source compiled with the jpholiday_csv
+// build tag, which embeds raw, the undecoded CSV, instead of a holiday map
+// literal. It holds the dataset metadata, the table checksum, and the
+// English names; raw is decoded with the table from generateShiftJIS.
+func generateCSVMode(raw []byte, holidays []holiday, meta datasetMeta, english map[string]string) ([]byte, error) {
+	sortHolidays(holidays)
+
+	var b strings.Builder
+	b.WriteString("// Code generated by cmd/genholidays; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "//go:build %s\n\n", csvTag)
+	b.WriteString("package jpholiday\n\n")
+	if !meta.FetchedAt.IsZero() {
+		b.WriteString("import \"time\"\n\n")
+	}
+	writeDatasetMeta(&b, meta)
+	writeTableChecksum(&b, holidays)
+	writeEnglishNames(&b, holidays, english)
+
+	return format.Source([]byte(b.String()))
+}
+
+// generateShiftJIS produces the Go source, compiled in every build, of
+// csvShiftJIS, the Shift_JIS code points used by raw, since the standard
+// library cannot decode Shift_JIS.
+func generateShiftJIS(raw []byte) ([]byte, error) {
+	table, err := shiftJISTable(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	var b strings.Builder
+	b.WriteString("// Code generated by cmd/genholidays; DO NOT EDIT.\n\n")
+	b.WriteString("package jpholiday\n\n")
+	b.WriteString("var csvShiftJIS = map[uint16]rune{\n")
+	for _, code := range slices.Sorted(maps.Keys(table)) {
+		fmt.Fprintf(&b, "\t0x%04X: %q,\n", code, table[code])
+	}
+	b.WriteString("}\n")
+
+	return format.Source([]byte(b.String()))
+}
+
+// shiftJISTable returns the double-byte characters in the Shift_JIS text
+// raw, keyed by their two-byte codes.
+func shiftJISTable(raw []byte) (map[uint16]rune, error) {
+	dec := japanese.ShiftJIS.NewDecoder()
+	table := make(map[uint16]rune)
+	for i := 0; i < len(raw); i++ {
+		c := raw[i]
+		if c < 0x80 || 0xA1 <= c && c <= 0xDF {
+			continue // ASCII or half-width katakana
 		}
-		if holidays[i].month != holidays[j].month {
-			return holidays[i].month < holidays[j].month
+		if i+1 == len(raw) {
+			return nil, fmt.Errorf("truncated Shift_JIS character at byte %d", i)
 		}
-		return holidays[i].day < holidays[j].day
-	})
+		code := uint16(c)<<8 | uint16(raw[i+1])
+		i++
+		if _, ok := table[code]; ok {
+			continue
+		}
+		s, err := dec.Bytes([]byte{byte(code >> 8), byte(code)})
+		r, _ := utf8.DecodeRune(s)
+		if err != nil || r == utf8.RuneError {
+			return nil, fmt.Errorf("invalid Shift_JIS character %#04x at byte %d", code, i-1)
+		}
+		table[code] = r
+	}
+	return table, nil
+}
+
+func generateConstrained(holidays []holiday, meta datasetMeta, english map[string]string, constraint string) ([]byte, error) {
+	sortHolidays(holidays)
 
 	var b strings.Builder
 	b.WriteString("// Code generated by cmd/genholidays; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "//go:build %s\n\n", constraint)
 	b.WriteString("package jpholiday\n\n")
-	b.WriteString("import \"time\"\n\n")
+	if !meta.FetchedAt.IsZero() {
+		b.WriteString("import \"time\"\n\n")
+	}
+	writeDatasetMeta(&b, meta)
+	writeTableChecksum(&b, holidays)
+	writeEnglishNames(&b, holidays, english)
 	b.WriteString("var builtinHolidays = map[date]string{\n")
 
 	currentYear := 0
@@ -490,7 +869,7 @@ func generate(holidays []holiday) ([]byte, error) {
 			fmt.Fprintf(&b, "\t// %d\n", h.year)
 			currentYear = h.year
 		}
-		fmt.Fprintf(&b, "\t{%d, %s, %d}: %q,\n", h.year, monthConstName(h.month), h.day, h.name)
+		fmt.Fprintf(&b, "\t%04d%02d%02d: %q,\n", h.year, h.month, h.day, h.name)
 	}
 
 	b.WriteString("}\n")
@@ -1,15 +1,20 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
+
+	"golang.org/x/text/encoding/japanese"
 )
 
 func TestMain(m *testing.M) {
@@ -192,7 +197,7 @@ func TestGenerate(t *testing.T) {
 		{2024, time.January, 1, "元日"},
 	}
 
-	src, err := generate(holidays)
+	src, err := generate(holidays, datasetMeta{}, nil)
 	if err != nil {
 		t.Fatalf("generate error: %v", err)
 	}
@@ -209,8 +214,27 @@ func TestGenerate(t *testing.T) {
 	if janIdx > mayIdx {
 		t.Error("holidays should be sorted by date")
 	}
-	if !strings.Contains(code, "time.January") || !strings.Contains(code, "time.May") {
-		t.Error("should use time.Month constants")
+	if !strings.Contains(code, "20240101: \"元日\"") || !strings.Contains(code, "20240503: \"憲法記念日\"") {
+		t.Error("should use packed YYYYMMDD keys")
+	}
+}
+
+func TestGenerate_Checksum(t *testing.T) {
+	t.Parallel()
+
+	holidays := []holiday{
+		{2024, time.May, 3, "憲法記念日"},
+		{2024, time.January, 1, "元日"},
+	}
+	src, err := generate(holidays, datasetMeta{}, nil)
+	if err != nil {
+		t.Fatalf("generate error: %v", err)
+	}
+	// jpholiday.VerifyDataIntegrity recomputes the same value; see
+	// TestTableChecksum there.
+	want := `const builtinChecksum = "fca1e263bca2c140e8257c223a738360f6dd3066c7b8fb530ed6cb92a5ebfae6"`
+	if !strings.Contains(string(src), want) {
+		t.Errorf("missing %s in:\n%s", want, src)
 	}
 }
 
@@ -222,7 +246,7 @@ func TestGenerate_MultipleYears(t *testing.T) {
 		{2024, time.January, 1, "元日"},
 	}
 
-	src, err := generate(holidays)
+	src, err := generate(holidays, datasetMeta{}, nil)
 	if err != nil {
 		t.Fatalf("generate error: %v", err)
 	}
@@ -242,7 +266,7 @@ func TestGenerate_SortByMonthThenDay(t *testing.T) {
 		{2024, time.January, 8, "成人の日"},
 	}
 
-	src, err := generate(holidays)
+	src, err := generate(holidays, datasetMeta{}, nil)
 	if err != nil {
 		t.Fatalf("generate error: %v", err)
 	}
@@ -255,6 +279,104 @@ func TestGenerate_SortByMonthThenDay(t *testing.T) {
 	}
 }
 
+func TestGenerateRecent(t *testing.T) {
+	t.Parallel()
+
+	holidays := []holiday{
+		{1999, time.December, 23, "天皇誕生日"},
+		{2000, time.January, 1, "元日"},
+	}
+	full, err := generate(holidays, datasetMeta{}, nil)
+	if err != nil {
+		t.Fatalf("generate error: %v", err)
+	}
+	recent, err := generateRecent(holidays, datasetMeta{}, nil)
+	if err != nil {
+		t.Fatalf("generateRecent error: %v", err)
+	}
+
+	if !strings.Contains(string(full), "//go:build !jpholiday_recent && !jpholiday_csv\n") || !strings.Contains(string(full), "19991223:") {
+		t.Errorf("full output should be excluded by the tag and keep 1999:\n%s", full)
+	}
+	if !strings.Contains(string(recent), "//go:build jpholiday_recent && !jpholiday_csv\n") {
+		t.Errorf("recent output should require the tag:\n%s", recent)
+	}
+	if strings.Contains(string(recent), "19991223:") || !strings.Contains(string(recent), "20000101:") {
+		t.Errorf("recent output should start in 2000:\n%s", recent)
+	}
+	if got := recentOutputPath("../../holidays_data.go"); got != "../../holidays_data_recent.go" {
+		t.Errorf("recentOutputPath = %q", got)
+	}
+}
+
+func TestGenerateCSVMode(t *testing.T) {
+	t.Parallel()
+
+	raw, err := japanese.ShiftJIS.NewEncoder().Bytes([]byte("国民の祝日・休日月日,国民の祝日・休日名称\r\n2024/1/1,元日\r\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	src, err := generateCSVMode(raw, []holiday{{2024, time.January, 1, "元日"}}, datasetMeta{Rows: 1}, nil)
+	if err != nil {
+		t.Fatalf("generateCSVMode error: %v", err)
+	}
+
+	code := string(src)
+	for _, want := range []string{
+		"//go:build jpholiday_csv\n",
+		"const builtinChecksum = ",
+	} {
+		if !strings.Contains(code, want) {
+			t.Errorf("missing %q in:\n%s", want, code)
+		}
+	}
+	if strings.Contains(code, "builtinHolidays") || strings.Contains(code, "csvShiftJIS") {
+		t.Errorf("CSV mode should contain neither the holiday map nor the Shift_JIS table:\n%s", code)
+	}
+}
+
+func TestGenerateShiftJIS(t *testing.T) {
+	t.Parallel()
+
+	raw, err := japanese.ShiftJIS.NewEncoder().Bytes([]byte("国民の祝日・休日月日,国民の祝日・休日名称\r\n2024/1/1,元日\r\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	src, err := generateShiftJIS(raw)
+	if err != nil {
+		t.Fatalf("generateShiftJIS error: %v", err)
+	}
+
+	code := string(src)
+	if strings.Contains(code, "//go:build") {
+		t.Errorf("the Shift_JIS table should be compiled in every build:\n%s", code)
+	}
+	for _, want := range []string{
+		"var csvShiftJIS = map[uint16]rune{",
+		"0x8CB3: '元',",
+		"0x93FA: '日',",
+		"0x8145: '・',",
+	} {
+		if !strings.Contains(code, want) {
+			t.Errorf("missing %q in:\n%s", want, code)
+		}
+	}
+	if got := sjisOutputPath("../../holidays_data.go"); got != "../../holidays_data_sjis.go" {
+		t.Errorf("sjisOutputPath = %q", got)
+	}
+}
+
+func TestShiftJISTable_Invalid(t *testing.T) {
+	t.Parallel()
+
+	if _, err := shiftJISTable([]byte("2024/1/1,\x8c")); err == nil {
+		t.Error("expected error for a truncated character")
+	}
+	if _, err := shiftJISTable([]byte("\x85\x40")); err == nil {
+		t.Error("expected error for an unassigned code")
+	}
+}
+
 func TestMonthConstName(t *testing.T) {
 	t.Parallel()
 
@@ -306,7 +428,7 @@ func TestResolveCSVURLFrom_Success(t *testing.T) {
 	}))
 	defer ts.Close()
 
-	got, err := resolveCSVURLFrom(ts.Client(), ts.URL)
+	got, err := resolveCSVURLFrom(context.Background(), ts.Client(), ts.URL)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -331,7 +453,7 @@ func TestResolveCSVURL_Wrapper(t *testing.T) {
 		}),
 	}
 
-	got, err := resolveCSVURL(client)
+	got, err := resolveCSVURL(context.Background(), client)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -348,7 +470,7 @@ func TestResolveCSVURLFrom_NonOKStatus(t *testing.T) {
 	}))
 	defer ts.Close()
 
-	_, err := resolveCSVURLFrom(ts.Client(), ts.URL)
+	_, err := resolveCSVURLFrom(context.Background(), ts.Client(), ts.URL)
 	if err == nil {
 		t.Fatal("expected error for non-200 status")
 	}
@@ -362,7 +484,7 @@ func TestResolveCSVURLFrom_SuccessFalse(t *testing.T) {
 	}))
 	defer ts.Close()
 
-	_, err := resolveCSVURLFrom(ts.Client(), ts.URL)
+	_, err := resolveCSVURLFrom(context.Background(), ts.Client(), ts.URL)
 	if err == nil {
 		t.Fatal("expected error for success=false")
 	}
@@ -381,7 +503,7 @@ func TestResolveCSVURLFrom_NoCSVResource(t *testing.T) {
 	}))
 	defer ts.Close()
 
-	_, err := resolveCSVURLFrom(ts.Client(), ts.URL)
+	_, err := resolveCSVURLFrom(context.Background(), ts.Client(), ts.URL)
 	if err == nil {
 		t.Fatal("expected error for no CSV resource")
 	}
@@ -395,7 +517,7 @@ func TestResolveCSVURLFrom_InvalidJSON(t *testing.T) {
 	}))
 	defer ts.Close()
 
-	_, err := resolveCSVURLFrom(ts.Client(), ts.URL)
+	_, err := resolveCSVURLFrom(context.Background(), ts.Client(), ts.URL)
 	if err == nil {
 		t.Fatal("expected error for invalid JSON")
 	}
@@ -409,7 +531,7 @@ func TestResolveCSVURLFrom_SSRFBlocked(t *testing.T) {
 	}))
 	defer ts.Close()
 
-	_, err := resolveCSVURLFrom(ts.Client(), ts.URL)
+	_, err := resolveCSVURLFrom(context.Background(), ts.Client(), ts.URL)
 	if err == nil {
 		t.Fatal("expected error for SSRF-blocked URL")
 	}
@@ -418,7 +540,7 @@ func TestResolveCSVURLFrom_SSRFBlocked(t *testing.T) {
 func TestResolveCSVURLFrom_NetworkError(t *testing.T) {
 	t.Parallel()
 
-	_, err := resolveCSVURLFrom(&http.Client{Timeout: 1 * time.Second}, closedServerURL())
+	_, err := resolveCSVURLFrom(context.Background(), &http.Client{Timeout: 1 * time.Second}, closedServerURL())
 	if err == nil {
 		t.Fatal("expected error for network failure")
 	}
@@ -437,7 +559,7 @@ func TestFetchWithRetry_Success(t *testing.T) {
 	}))
 	defer ts.Close()
 
-	reader, etag, lastModified, notModified, err := fetchWithRetry(ts.Client(), ts.URL, "", "")
+	reader, etag, lastModified, notModified, err := fetchWithRetry(context.Background(), ts.Client(), ts.URL, "", "")
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -463,7 +585,7 @@ func TestFetchWithRetry_404_NoRetry(t *testing.T) {
 	}))
 	defer ts.Close()
 
-	_, _, _, _, err := fetchWithRetry(ts.Client(), ts.URL, "", "")
+	_, _, _, _, err := fetchWithRetry(context.Background(), ts.Client(), ts.URL, "", "")
 	if err == nil {
 		t.Fatal("expected error for 404")
 	}
@@ -483,7 +605,7 @@ func TestFetchWithRetry_ServerError_RetriesThenSucceeds(t *testing.T) {
 	}))
 	defer ts.Close()
 
-	reader, _, _, _, err := fetchWithRetry(ts.Client(), ts.URL, "", "")
+	reader, _, _, _, err := fetchWithRetry(context.Background(), ts.Client(), ts.URL, "", "")
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -506,7 +628,7 @@ func TestFetchWithRetry_AllRetriesFail_503(t *testing.T) {
 	}))
 	defer ts.Close()
 
-	_, _, _, _, err := fetchWithRetry(ts.Client(), ts.URL, "", "")
+	_, _, _, _, err := fetchWithRetry(context.Background(), ts.Client(), ts.URL, "", "")
 	if err == nil {
 		t.Fatal("expected error after all retries fail")
 	}
@@ -526,7 +648,7 @@ func TestFetchWithRetry_429_RetriesThenSucceeds(t *testing.T) {
 	}))
 	defer ts.Close()
 
-	reader, _, _, _, err := fetchWithRetry(ts.Client(), ts.URL, "", "")
+	reader, _, _, _, err := fetchWithRetry(context.Background(), ts.Client(), ts.URL, "", "")
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -553,6 +675,7 @@ func TestFetchWithRetry_ConditionalGET_304(t *testing.T) {
 	defer ts.Close()
 
 	reader, _, _, notModified, err := fetchWithRetry(
+		context.Background(),
 		ts.Client(),
 		ts.URL,
 		`"etag-1"`,
@@ -585,7 +708,7 @@ func TestFetchCSV_Wrapper(t *testing.T) {
 		}),
 	}
 
-	result, err := fetchCSV(client)
+	result, err := fetchCSV(context.Background(), client, "")
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -598,12 +721,16 @@ func TestFetchCSV_Wrapper(t *testing.T) {
 	if got := mustReadAll(t, result.Reader); got != "csvdata" {
 		t.Errorf("response body = %q, want %q", got, "csvdata")
 	}
+	// sha256("csvdata")
+	if want := "bfab5972362a09f03994369307b08bbb3bf9b2514b373c7303fb0c533f6041c9"; result.SHA256 != want {
+		t.Errorf("SHA256 = %q, want %q", result.SHA256, want)
+	}
 }
 
 func TestFetchWithRetry_NetworkError(t *testing.T) {
 	t.Parallel()
 
-	_, _, _, _, err := fetchWithRetry(&http.Client{Timeout: 1 * time.Second}, closedServerURL(), "", "")
+	_, _, _, _, err := fetchWithRetry(context.Background(), &http.Client{Timeout: 1 * time.Second}, closedServerURL(), "", "")
 	if err == nil {
 		t.Fatal("expected error for network failure")
 	}
@@ -625,7 +752,7 @@ func TestResolveCSVURLWithRetry_RetryableStatusThenSuccess(t *testing.T) {
 	}))
 	defer ts.Close()
 
-	got, err := resolveCSVURLWithRetry(ts.Client(), ts.URL)
+	got, err := resolveCSVURLWithRetry(context.Background(), ts.Client(), ts.URL)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -647,7 +774,7 @@ func TestResolveCSVURLWithRetry_NonRetryableStatus_NoRetry(t *testing.T) {
 	}))
 	defer ts.Close()
 
-	_, err := resolveCSVURLWithRetry(ts.Client(), ts.URL)
+	_, err := resolveCSVURLWithRetry(context.Background(), ts.Client(), ts.URL)
 	if err == nil {
 		t.Fatal("expected error")
 	}
@@ -676,7 +803,7 @@ func TestFetchCSVWithFallbacks_CKANFails_Fb1Succeeds(t *testing.T) {
 	}))
 	defer fb2.Close()
 
-	result, err := fetchCSVWithFallbacks(&http.Client{Timeout: 5 * time.Second}, ckan.URL, fb1.URL, fb2.URL)
+	result, err := fetchCSVWithFallbacks(context.Background(), &http.Client{Timeout: 5 * time.Second}, ckan.URL, fb1.URL, fb2.URL)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -711,7 +838,7 @@ func TestFetchCSVWithFallbacks_CKANResolvesToSameAsFb1(t *testing.T) {
 	}))
 	defer fb2.Close()
 
-	result, err := fetchCSVWithFallbacks(&http.Client{Timeout: 5 * time.Second}, ckan.URL, fb1.URL, fb2.URL)
+	result, err := fetchCSVWithFallbacks(context.Background(), &http.Client{Timeout: 5 * time.Second}, ckan.URL, fb1.URL, fb2.URL)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -736,7 +863,7 @@ func TestFetchCSVWithFallbacks_AllFail(t *testing.T) {
 	}))
 	defer ckan.Close()
 
-	_, err := fetchCSVWithFallbacks(&http.Client{Timeout: 5 * time.Second}, ckan.URL, failServer.URL, failServer.URL)
+	_, err := fetchCSVWithFallbacks(context.Background(), &http.Client{Timeout: 5 * time.Second}, ckan.URL, failServer.URL, failServer.URL)
 	if err == nil {
 		t.Fatal("expected error when all URLs fail")
 	}
@@ -763,7 +890,7 @@ func TestFetchCSVWithFallbacks_Fb1Fails_Fb2Succeeds(t *testing.T) {
 	}))
 	defer ckan.Close()
 
-	result, err := fetchCSVWithFallbacks(&http.Client{Timeout: 5 * time.Second}, ckan.URL, fail.URL, ok.URL)
+	result, err := fetchCSVWithFallbacks(context.Background(), &http.Client{Timeout: 5 * time.Second}, ckan.URL, fail.URL, ok.URL)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -774,3 +901,97 @@ func TestFetchCSVWithFallbacks_Fb1Fails_Fb2Succeeds(t *testing.T) {
 		t.Errorf("response body = %q, want %q", got, "csvdata")
 	}
 }
+
+// --- loadFetchMetadata ---
+
+func TestLoadFetchMetadata_EmptyPathDisablesCache(t *testing.T) {
+	t.Parallel()
+
+	meta, err := loadFetchMetadata("")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if meta.Entries == nil || len(meta.Entries) != 0 {
+		t.Errorf("Entries = %v, want empty non-nil map", meta.Entries)
+	}
+}
+
+// --- context cancellation ---
+
+func TestSleepContext(t *testing.T) {
+	t.Parallel()
+
+	if err := sleepContext(context.Background(), 0); err != nil {
+		t.Errorf("sleepContext with zero delay = %v, want nil", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := sleepContext(ctx, time.Hour); !errors.Is(err, context.Canceled) {
+		t.Errorf("sleepContext on canceled context = %v, want context.Canceled", err)
+	}
+}
+
+func TestFetchWithRetry_CanceledContext(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, _, _, _, err := fetchWithRetry(ctx, ts.Client(), ts.URL, "", "")
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("error = %v, want context.Canceled", err)
+	}
+	if got := calls.Load(); got != 0 {
+		t.Errorf("server received %d requests, want 0", got)
+	}
+}
+
+func TestResolveCSVURLWithRetry_CanceledContext(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := resolveCSVURLWithRetry(ctx, &http.Client{Timeout: time.Second}, closedServerURL())
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("error = %v, want context.Canceled", err)
+	}
+}
+
+func TestFetchCSVWithFallbacks_CanceledContextSkipsFallbacks(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := fetchCSVWithFallbacks(ctx, &http.Client{Timeout: time.Second}, closedServerURL(), closedServerURL(), closedServerURL())
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("error = %v, want context.Canceled", err)
+	}
+}
+
+func TestFetchMetadataPath(t *testing.T) {
+	tests := []struct {
+		format  string
+		compare bool
+		want    string
+	}{
+		{formatGo, false, cacheMetadataPath},
+		{formatGo, true, ""},
+		{formatJSON, false, ""},
+		{formatYAML, false, ""},
+	}
+	for _, tt := range tests {
+		if got := fetchMetadataPath(tt.format, tt.compare); got != tt.want {
+			t.Errorf("fetchMetadataPath(%q, %v) = %q, want %q", tt.format, tt.compare, got, tt.want)
+		}
+	}
+}
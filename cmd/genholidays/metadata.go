@@ -0,0 +1,83 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// datasetMeta describes the fetched CSV and is embedded into the generated
+// Go source as jpholiday.DatasetMetadata.
+type datasetMeta struct {
+	SourceURL string
+	FetchedAt time.Time
+	SHA256    string
+	Rows      int
+}
+
+// writeDatasetMeta writes the builtinDataset variable declaration.
+// Zero-valued fields are omitted so the literal stays valid for partial metadata.
+func writeDatasetMeta(b *strings.Builder, meta datasetMeta) {
+	b.WriteString("var builtinDataset = DatasetMetadata{\n")
+	if meta.SourceURL != "" {
+		fmt.Fprintf(b, "\tSourceURL: %q,\n", meta.SourceURL)
+	}
+	if !meta.FetchedAt.IsZero() {
+		t := meta.FetchedAt.UTC()
+		fmt.Fprintf(b, "\tFetchedAt: time.Date(%d, %s, %d, %d, %d, %d, 0, time.UTC),\n",
+			t.Year(), monthConstName(t.Month()), t.Day(), t.Hour(), t.Minute(), t.Second())
+	}
+	if meta.SHA256 != "" {
+		fmt.Fprintf(b, "\tSHA256: %q,\n", meta.SHA256)
+	}
+	fmt.Fprintf(b, "\tRows: %d,\n", meta.Rows)
+	b.WriteString("}\n\n")
+}
+
+// writeTableChecksum writes the builtinChecksum constant: the hex-encoded
+// SHA-256 of the holiday table, checked at run time by
+// jpholiday.VerifyDataIntegrity. The table is hashed as one
+// "YYYYMMDD\tname\n" line per holiday in chronological order, so the
+// checksum does not depend on the formatting of the generated source.
+func writeTableChecksum(b *strings.Builder, holidays []holiday) {
+	h := sha256.New()
+	for _, hd := range holidays {
+		fmt.Fprintf(h, "%04d%02d%02d\t%s\n", hd.year, hd.month, hd.day, hd.name)
+	}
+	fmt.Fprintf(b, "const builtinChecksum = %q\n\n", hex.EncodeToString(h.Sum(nil)))
+}
+
+// parseGeneratedSHA256 returns the SHA256 field of the builtinDataset literal
+// in generated Go source, or "" if the file carries no checksum.
+func parseGeneratedSHA256(src []byte) (string, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), "holidays_data.go", src, 0)
+	if err != nil {
+		return "", fmt.Errorf("parsing generated source: %w", err)
+	}
+
+	lit := findVarLiteral(file, "builtinDataset")
+	if lit == nil {
+		return "", nil
+	}
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		if key, ok := kv.Key.(*ast.Ident); !ok || key.Name != "SHA256" {
+			continue
+		}
+		val, ok := kv.Value.(*ast.BasicLit)
+		if !ok || val.Kind != token.STRING {
+			return "", fmt.Errorf("SHA256: expected string literal")
+		}
+		return strconv.Unquote(val.Value)
+	}
+	return "", nil
+}
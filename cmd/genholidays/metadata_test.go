@@ -0,0 +1,80 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGenerate_EmbedsDatasetMeta(t *testing.T) {
+	t.Parallel()
+
+	meta := datasetMeta{
+		SourceURL: fallbackURL1,
+		FetchedAt: time.Date(2026, time.April, 12, 0, 1, 2, 0, time.UTC),
+		SHA256:    "abc123",
+		Rows:      1,
+	}
+	src, err := generate([]holiday{{2024, time.January, 1, "元日"}}, meta, nil)
+	if err != nil {
+		t.Fatalf("generate error: %v", err)
+	}
+
+	code := string(src)
+	for _, want := range []string{
+		"var builtinDataset = DatasetMetadata{",
+		`SourceURL: "` + fallbackURL1 + `"`,
+		"FetchedAt: time.Date(2026, time.April, 12, 0, 1, 2, 0, time.UTC)",
+		`SHA256:    "abc123"`,
+		"Rows:      1,",
+	} {
+		if !strings.Contains(code, want) {
+			t.Errorf("generated source missing %q", want)
+		}
+	}
+
+	sum, err := parseGeneratedSHA256(src)
+	if err != nil {
+		t.Fatalf("parseGeneratedSHA256 error: %v", err)
+	}
+	if sum != "abc123" {
+		t.Errorf("parseGeneratedSHA256 = %q, want %q", sum, "abc123")
+	}
+}
+
+func TestGenerate_PartialDatasetMeta(t *testing.T) {
+	t.Parallel()
+
+	src, err := generate([]holiday{{2024, time.January, 1, "元日"}}, datasetMeta{Rows: 1}, nil)
+	if err != nil {
+		t.Fatalf("generate error: %v", err)
+	}
+
+	code := string(src)
+	if strings.Contains(code, "FetchedAt") || strings.Contains(code, "SHA256") {
+		t.Error("zero-valued metadata fields should be omitted")
+	}
+	sum, err := parseGeneratedSHA256(src)
+	if err != nil {
+		t.Fatalf("parseGeneratedSHA256 error: %v", err)
+	}
+	if sum != "" {
+		t.Errorf("parseGeneratedSHA256 = %q, want empty", sum)
+	}
+}
+
+func TestParseGeneratedSHA256_NoDataset(t *testing.T) {
+	t.Parallel()
+
+	sum, err := parseGeneratedSHA256([]byte("package jpholiday\n\nvar builtinHolidays = map[date]string{}\n"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sum != "" {
+		t.Errorf("parseGeneratedSHA256 = %q, want empty", sum)
+	}
+
+	if _, err := parseGeneratedSHA256([]byte("not go source")); err == nil {
+		t.Error("expected parse error")
+	}
+}
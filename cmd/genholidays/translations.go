@@ -0,0 +1,131 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"maps"
+	"os"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// loadTranslations reads a JSON object mapping Japanese holiday names to
+// English names. An empty path yields no translations.
+func loadTranslations(path string) (map[string]string, error) {
+	if path == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var tr map[string]string
+	if err := json.Unmarshal(data, &tr); err != nil {
+		return nil, fmt.Errorf("decoding %s: %w", path, err)
+	}
+	for ja, en := range tr {
+		if strings.TrimSpace(en) == "" {
+			return nil, fmt.Errorf("%s: empty translation for %q", path, ja)
+		}
+	}
+	return tr, nil
+}
+
+// missingTranslations returns the distinct holiday names without an English
+// translation, sorted for stable output.
+func missingTranslations(holidays []holiday, tr map[string]string) []string {
+	seen := make(map[string]bool)
+	var missing []string
+	for _, h := range holidays {
+		if seen[h.name] {
+			continue
+		}
+		seen[h.name] = true
+		if _, ok := tr[h.name]; !ok {
+			missing = append(missing, h.name)
+		}
+	}
+	sort.Strings(missing)
+	return missing
+}
+
+// usedTranslations returns the translations of the names that occur in the
+// dataset, so stale translations are dropped.
+func usedTranslations(holidays []holiday, tr map[string]string) map[string]string {
+	used := make(map[string]string)
+	for _, h := range holidays {
+		if en, ok := tr[h.name]; ok {
+			used[h.name] = en
+		}
+	}
+	return used
+}
+
+// writeEnglishNames writes the builtinEnglishNames variable declaration,
+// limited to names that occur in the dataset.
+func writeEnglishNames(b *strings.Builder, holidays []holiday, tr map[string]string) {
+	used := usedTranslations(holidays, tr)
+	b.WriteString("var builtinEnglishNames = map[string]string{\n")
+	for _, name := range slices.Sorted(maps.Keys(used)) {
+		fmt.Fprintf(b, "\t%q: %q,\n", name, used[name])
+	}
+	b.WriteString("}\n\n")
+}
+
+// translationsCurrent reports whether generated Go source already holds the
+// translations of its holidays in tr, so that a run with an unchanged CSV
+// can be skipped only if it would not change builtinEnglishNames.
+func translationsCurrent(src []byte, tr map[string]string) bool {
+	holidays, err := parseGenerated(src)
+	if err != nil {
+		return false
+	}
+	english, err := parseGeneratedEnglishNames(src)
+	if err != nil {
+		return false
+	}
+	return maps.Equal(english, usedTranslations(holidays, tr))
+}
+
+// parseGeneratedEnglishNames extracts the builtinEnglishNames map literal
+// from generated Go source.
+func parseGeneratedEnglishNames(src []byte) (map[string]string, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), "holidays_data.go", src, 0)
+	if err != nil {
+		return nil, fmt.Errorf("parsing generated source: %w", err)
+	}
+	lit := findVarLiteral(file, "builtinEnglishNames")
+	if lit == nil {
+		return nil, fmt.Errorf("builtinEnglishNames map literal not found")
+	}
+	english := make(map[string]string, len(lit.Elts))
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			return nil, fmt.Errorf("unexpected map element")
+		}
+		ja, err := stringLit(kv.Key)
+		if err != nil {
+			return nil, err
+		}
+		en, err := stringLit(kv.Value)
+		if err != nil {
+			return nil, err
+		}
+		english[ja] = en
+	}
+	return english, nil
+}
+
+func stringLit(e ast.Expr) (string, error) {
+	lit, ok := e.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", fmt.Errorf("expected string literal")
+	}
+	return strconv.Unquote(lit.Value)
+}
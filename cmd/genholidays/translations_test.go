@@ -0,0 +1,152 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func writeTempFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("write error: %v", err)
+	}
+	return path
+}
+
+func TestLoadTranslations(t *testing.T) {
+	t.Parallel()
+
+	path := writeTempFile(t, "tr.json", `{"元日": "New Year's Day"}`)
+	tr, err := loadTranslations(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tr["元日"] != "New Year's Day" {
+		t.Errorf("tr[元日] = %q, want New Year's Day", tr["元日"])
+	}
+}
+
+func TestLoadTranslations_EmptyPath(t *testing.T) {
+	t.Parallel()
+
+	tr, err := loadTranslations("")
+	if err != nil || tr != nil {
+		t.Errorf("loadTranslations(\"\") = %v, %v; want nil, nil", tr, err)
+	}
+}
+
+func TestLoadTranslations_Errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{"invalid JSON", `{`, "decoding"},
+		{"empty translation", `{"元日": " "}`, "empty translation"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := loadTranslations(writeTempFile(t, "tr.json", tt.content))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want containing %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	if _, err := loadTranslations(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("expected error for missing file")
+	}
+}
+
+func TestLoadTranslations_Maintained(t *testing.T) {
+	t.Parallel()
+
+	tr, err := loadTranslations("translations.json")
+	if err != nil {
+		t.Fatalf("maintained translations.json failed to load: %v", err)
+	}
+	if len(tr) == 0 {
+		t.Fatal("maintained translations.json is empty")
+	}
+}
+
+func TestMissingTranslations(t *testing.T) {
+	t.Parallel()
+
+	holidays := []holiday{
+		{2024, time.January, 1, "元日"},
+		{2024, time.May, 3, "憲法記念日"},
+		{2025, time.May, 3, "憲法記念日"},
+		{2024, time.April, 29, "昭和の日"},
+	}
+	got := missingTranslations(holidays, map[string]string{"元日": "New Year's Day"})
+	want := []string{"憲法記念日", "昭和の日"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("missingTranslations = %v, want %v", got, want)
+	}
+}
+
+func TestGenerate_EnglishNames(t *testing.T) {
+	t.Parallel()
+
+	holidays := []holiday{{2024, time.January, 1, "元日"}}
+	tr := map[string]string{
+		"元日":   "New Year's Day",
+		"体育の日": "Health and Sports Day", // not in dataset; should be dropped
+	}
+	src, err := generate(holidays, datasetMeta{}, tr)
+	if err != nil {
+		t.Fatalf("generate error: %v", err)
+	}
+
+	code := string(src)
+	if !strings.Contains(code, "var builtinEnglishNames = map[string]string{") {
+		t.Fatal("missing builtinEnglishNames declaration")
+	}
+	if !strings.Contains(code, `"New Year's Day"`) {
+		t.Error("missing translation for 元日")
+	}
+	if strings.Contains(code, "Health and Sports Day") {
+		t.Error("translations for names outside the dataset should be omitted")
+	}
+}
+
+func TestTranslationsCurrent(t *testing.T) {
+	t.Parallel()
+
+	// The CSV is unchanged, so the holidays and checksum are the same; only
+	// the translations are edited.
+	holidays := []holiday{{2024, time.January, 1, "元日"}, {2024, time.May, 3, "憲法記念日"}}
+	tr := map[string]string{"元日": "New Year's Day", "憲法記念日": "Constitution Day"}
+	src, err := generate(holidays, datasetMeta{SHA256: "abc", Rows: len(holidays)}, tr)
+	if err != nil {
+		t.Fatalf("generate error: %v", err)
+	}
+	if !translationsCurrent(src, tr) {
+		t.Error("translationsCurrent = false for the translations the source was generated with")
+	}
+	// A translation of a name outside the dataset does not change the output.
+	if !translationsCurrent(src, map[string]string{"元日": "New Year's Day", "憲法記念日": "Constitution Day", "体育の日": "Sports Day"}) {
+		t.Error("translationsCurrent = false after adding an unused translation")
+	}
+
+	tests := []struct {
+		name string
+		tr   map[string]string
+	}{
+		{"edited", map[string]string{"元日": "New Year's Day", "憲法記念日": "Constitution Memorial Day"}},
+		{"deleted", map[string]string{"元日": "New Year's Day"}},
+		{"disabled", nil},
+	}
+	for _, tt := range tests {
+		if translationsCurrent(src, tt.tr) {
+			t.Errorf("%s: translationsCurrent = true, want false", tt.name)
+		}
+	}
+}
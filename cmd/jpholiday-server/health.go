@@ -0,0 +1,115 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"runtime/debug"
+	"time"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+)
+
+// jst is the Asia/Tokyo timezone used to determine "today".
+var jst = time.FixedZone("Asia/Tokyo", 9*60*60)
+
+// now returns the current time; tests replace it.
+var now = time.Now
+
+// datasetResponse describes the holiday dataset served, in /version.
+type datasetResponse struct {
+	Version   string `json:"version"` // Calendar.DatasetVersion
+	First     string `json:"first"`   // first date the dataset covers, YYYY-MM-DD
+	Last      string `json:"last"`    // last date the dataset covers, YYYY-MM-DD
+	Rows      int    `json:"rows"`
+	SHA256    string `json:"sha256,omitempty"`
+	FetchedAt string `json:"fetched_at,omitempty"` // RFC 3339
+	SourceURL string `json:"source_url"`
+}
+
+// versionResponse is the response of /version.
+type versionResponse struct {
+	Version   string          `json:"version"`
+	GoVersion string          `json:"go_version"`
+	Dataset   datasetResponse `json:"dataset"`
+}
+
+// healthResponse is the response of /healthz.
+type healthResponse struct {
+	Status       string `json:"status"` // "ok" or "coverage_low"
+	CoverageEnd  string `json:"coverage_end"`
+	CoverageDays int    `json:"coverage_days"` // days from today (JST) to CoverageEnd
+}
+
+// dataset is the subset of [jpholiday.Calendar] describing the dataset
+// the server answers from.
+type dataset interface {
+	DatasetInfo() jpholiday.DatasetMetadata
+	DatasetRange() (first, last time.Time)
+	DatasetVersion() string
+}
+
+// datasetInfo summarizes the dataset of cal. First and Last come from the
+// dataset range, so custom holidays loaded with -config do not move them.
+func datasetInfo(cal dataset) datasetResponse {
+	meta := cal.DatasetInfo()
+	first, last := cal.DatasetRange()
+	info := datasetResponse{
+		Version:   cal.DatasetVersion(),
+		First:     first.Format(time.DateOnly),
+		Last:      last.Format(time.DateOnly),
+		Rows:      meta.Rows,
+		SHA256:    meta.SHA256,
+		SourceURL: meta.SourceURL,
+	}
+	if !meta.FetchedAt.IsZero() {
+		info.FetchedAt = meta.FetchedAt.UTC().Format(time.RFC3339)
+	}
+	return info
+}
+
+// healthHandler serves /healthz. It reports 503 when the dataset of cal ends
+// within minCoverageDays days, so monitoring can alert before holiday data runs
+// out; minCoverage <= 0 disables the check. The range is read on each
+// request, so a refreshed or patched dataset is reported.
+func healthHandler(cal dataset, minCoverageDays int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, end := cal.DatasetRange()
+		y, m, d := now().In(jst).Date()
+		today := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+		resp := healthResponse{
+			Status:       "ok",
+			CoverageEnd:  end.Format(time.DateOnly),
+			CoverageDays: int(end.Sub(today).Hours() / 24),
+		}
+		status := http.StatusOK
+		if minCoverageDays > 0 && resp.CoverageDays < minCoverageDays {
+			resp.Status = "coverage_low"
+			status = http.StatusServiceUnavailable
+		}
+		writeJSON(w, status, resp)
+	})
+}
+
+// versionHandler serves /version, describing the dataset of cal as of each
+// request.
+func versionHandler(cal dataset) http.Handler {
+	build := versionResponse{Version: "(devel)"}
+	if bi, ok := debug.ReadBuildInfo(); ok {
+		build.GoVersion = bi.GoVersion
+		if bi.Main.Version != "" {
+			build.Version = bi.Main.Version
+		}
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := build
+		resp.Dataset = datasetInfo(cal)
+		writeJSON(w, http.StatusOK, resp)
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	// An encoding error here means the client has gone away.
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,156 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+)
+
+func TestHealthz(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		minCoverage int
+		wantStatus  int
+		want        healthResponse
+	}{
+		{"ok", 90, http.StatusOK, healthResponse{Status: "ok", CoverageEnd: "2027-12-31", CoverageDays: 609}},
+		{"disabled", 0, http.StatusOK, healthResponse{Status: "ok", CoverageEnd: "2027-12-31", CoverageDays: 609}},
+		{"coverage low", 610, http.StatusServiceUnavailable, healthResponse{Status: "coverage_low", CoverageEnd: "2027-12-31", CoverageDays: 609}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			h, err := newHandler(config{rate: 1, burst: 1, minCoverageDays: tt.minCoverage})
+			if err != nil {
+				t.Fatal(err)
+			}
+			// Health checks are not rate limited.
+			for range 3 {
+				rec := httptest.NewRecorder()
+				h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+				if rec.Code != tt.wantStatus {
+					t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+				}
+				var got healthResponse
+				if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+					t.Fatal(err)
+				}
+				if got != tt.want {
+					t.Errorf("response = %+v, want %+v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestVersion(t *testing.T) {
+	t.Parallel()
+
+	h, err := newHandler(config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/version", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d", rec.Code)
+	}
+	var got versionResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	// The dataset compiled in depends on the build tags.
+	first, last := jpholiday.DatasetRange()
+	want := datasetResponse{
+		Version:   jpholiday.DatasetVersion(),
+		First:     first.Format(time.DateOnly),
+		Last:      last.Format(time.DateOnly),
+		Rows:      len(jpholiday.Holidays()),
+		SHA256:    jpholiday.DatasetInfo().SHA256,
+		FetchedAt: jpholiday.DatasetGeneratedAt().Format(time.RFC3339),
+		SourceURL: "https://www8.cao.go.jp/chosei/shukujitsu/syukujitsu.csv",
+	}
+	if got.Dataset != want {
+		t.Errorf("dataset = %+v, want %+v", got.Dataset, want)
+	}
+	if got.Version == "" || got.GoVersion == "" {
+		t.Errorf("version = %q, go_version = %q", got.Version, got.GoVersion)
+	}
+}
+
+func TestHealthAndVersion_Config(t *testing.T) {
+	t.Parallel()
+
+	// A patch in the configuration extends the dataset of the served calendar.
+	path := filepath.Join(t.TempDir(), "company.yaml")
+	// Custom holidays outside the dataset do not move its range.
+	cfg := "patch:\n  - date: 2028-01-01\n    name: 元日\n" +
+		"holidays:\n  - date: 2030-05-01\n    name: 創立記念日\n"
+	if err := os.WriteFile(path, []byte(cfg), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	h, err := newHandler(config{configPath: path})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	var health healthResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &health); err != nil {
+		t.Fatal(err)
+	}
+	if health.CoverageEnd != "2028-12-31" {
+		t.Errorf("/healthz coverage_end = %q, want 2028-12-31", health.CoverageEnd)
+	}
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/version", nil))
+	var version versionResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &version); err != nil {
+		t.Fatal(err)
+	}
+	if got := version.Dataset; got.Last != "2028-12-31" || got.Version == jpholiday.DatasetVersion() {
+		t.Errorf("/version dataset = %+v, want the patched range and version", got)
+	}
+}
+
+func TestHealthAndVersion_Patched(t *testing.T) {
+	t.Parallel()
+
+	// Both endpoints follow the calendar's dataset after they are created.
+	cal := jpholiday.New()
+	health, version := healthHandler(cal, 0), versionHandler(cal)
+	patch := jpholiday.DatasetPatch{Holidays: []jpholiday.ConfigHoliday{{Date: "2028-01-01", Name: "元日"}}}
+	if err := cal.ApplyPatch(patch); err != nil {
+		t.Fatal(err)
+	}
+
+	rec := httptest.NewRecorder()
+	health.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	var h healthResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &h); err != nil {
+		t.Fatal(err)
+	}
+	if h.CoverageEnd != "2028-12-31" {
+		t.Errorf("/healthz coverage_end = %q, want 2028-12-31", h.CoverageEnd)
+	}
+
+	rec = httptest.NewRecorder()
+	version.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/version", nil))
+	var v versionResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &v); err != nil {
+		t.Fatal(err)
+	}
+	if v.Dataset.Last != "2028-12-31" || v.Dataset.Version != cal.DatasetVersion() {
+		t.Errorf("/version dataset = %+v, want the patched range and version", v.Dataset)
+	}
+}
@@ -0,0 +1,174 @@
+// Command jpholiday-server serves the httpapi holiday API as a standalone,
+// self-hostable service.
+//
+// Usage:
+//
+//	jpholiday-server [-addr :8080] [-config company.yaml] [-rate 10] [-burst 20]
+//
+// Besides the httpapi endpoints, the server answers:
+//
+//	/healthz   {"status": "ok", ...}, or 503 with "coverage_low" when the
+//	           built-in holiday data ends within -min-coverage days
+//	/version   build version and the built-in dataset's version, range, hash, and fetch time
+//
+// Flags:
+//
+//	-addr              listen address (default ":8080")
+//	-config            calendar configuration file (YAML, or TOML/JSON by extension)
+//	-rate              requests per second allowed per client IP; 0 disables limiting (default 10)
+//	-burst             requests a client IP may make at once before -rate applies (default 20)
+//	-shutdown-timeout  how long to wait for in-flight requests on SIGINT or SIGTERM (default 10s)
+//	-min-coverage      days of remaining dataset coverage below which /healthz fails; 0 disables (default 90)
+//
+// Clients over the limit receive 429 Too Many Requests with a Retry-After
+// header. Clients are identified by the connection's remote address, so
+// behind a reverse proxy the limit should be enforced by the proxy instead.
+// /healthz and /version are not rate limited.
+//
+// Install with:
+//
+//	go install github.com/rabitt1ove/jp-holidays/cmd/jpholiday-server@latest
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+	"github.com/rabitt1ove/jp-holidays/httpapi"
+)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	os.Exit(run(ctx, os.Args[1:], os.Stderr))
+}
+
+// config holds the parsed command-line flags.
+type config struct {
+	addr            string
+	configPath      string
+	rate            float64
+	burst           int
+	shutdownTimeout time.Duration
+	minCoverageDays int
+}
+
+// run starts the server and blocks until ctx is done, returning the process
+// exit status.
+func run(ctx context.Context, args []string, stderr io.Writer) int {
+	cfg, err := parseFlags(args, stderr)
+	if err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+	h, err := newHandler(cfg)
+	if err != nil {
+		fmt.Fprintf(stderr, "jpholiday-server: %v\n", err)
+		return 1
+	}
+	ln, err := net.Listen("tcp", cfg.addr)
+	if err != nil {
+		fmt.Fprintf(stderr, "jpholiday-server: %v\n", err)
+		return 1
+	}
+	logger := log.New(stderr, "jpholiday-server: ", log.LstdFlags)
+	logger.Printf("listening on %s", ln.Addr())
+	if err := serve(ctx, ln, h, cfg.shutdownTimeout); err != nil {
+		logger.Print(err)
+		return 1
+	}
+	return 0
+}
+
+func parseFlags(args []string, stderr io.Writer) (config, error) {
+	var cfg config
+	fs := flag.NewFlagSet("jpholiday-server", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.StringVar(&cfg.addr, "addr", ":8080", "listen `address`")
+	fs.StringVar(&cfg.configPath, "config", "", "calendar configuration `file` (YAML, or TOML/JSON by extension)")
+	fs.Float64Var(&cfg.rate, "rate", 10, "requests per second allowed per client IP; 0 disables limiting")
+	fs.IntVar(&cfg.burst, "burst", 20, "requests a client IP may make at once before -rate applies")
+	fs.IntVar(&cfg.minCoverageDays, "min-coverage", 90, "`days` of remaining dataset coverage below which /healthz fails; 0 disables")
+	fs.DurationVar(&cfg.shutdownTimeout, "shutdown-timeout", 10*time.Second, "how long to wait for in-flight requests on shutdown")
+	if err := fs.Parse(args); err != nil {
+		return cfg, err
+	}
+	if fs.NArg() > 0 {
+		fmt.Fprintf(stderr, "jpholiday-server: unexpected argument %q\n", fs.Arg(0))
+		return cfg, errors.New("unexpected argument")
+	}
+	if cfg.rate < 0 || cfg.burst < 1 {
+		fmt.Fprintln(stderr, "jpholiday-server: -rate must not be negative and -burst must be at least 1")
+		return cfg, errors.New("invalid rate limit")
+	}
+	return cfg, nil
+}
+
+// newHandler builds the server's handler: the API, wrapped in the rate
+// limiter when enabled, and the unlimited health and version endpoints.
+func newHandler(cfg config) (http.Handler, error) {
+	opts := httpapi.Options{}
+	var cal dataset = jpholiday.Default()
+	if cfg.configPath != "" {
+		c, err := jpholiday.LoadConfigFile(cfg.configPath)
+		if err != nil {
+			return nil, fmt.Errorf("config %s: %w", cfg.configPath, err)
+		}
+		opts.Calendar = c
+		cal = c
+	}
+	var api http.Handler = httpapi.NewHandler(opts)
+	if cfg.rate > 0 {
+		api = newRateLimiter(cfg.rate, cfg.burst).Middleware(api)
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/", api)
+	mux.Handle("GET /healthz", healthHandler(cal, cfg.minCoverageDays))
+	mux.Handle("GET /version", versionHandler(cal))
+	return mux, nil
+}
+
+// serve serves h on ln until ctx is done, then shuts down gracefully,
+// waiting up to timeout for in-flight requests.
+func serve(ctx context.Context, ln net.Listener, h http.Handler, timeout time.Duration) error {
+	// Request contexts are cancelled on shutdown so that long-lived
+	// /events streams end instead of holding up Shutdown.
+	baseCtx, cancelBase := context.WithCancel(context.Background())
+	defer cancelBase()
+	srv := &http.Server{
+		Handler:           h,
+		ReadHeaderTimeout: 10 * time.Second,
+		BaseContext:       func(net.Listener) context.Context { return baseCtx },
+	}
+	srv.RegisterOnShutdown(cancelBase)
+	errc := make(chan error, 1)
+	go func() { errc <- srv.Serve(ln) }()
+
+	select {
+	case err := <-errc:
+		return err
+	case <-ctx.Done():
+	}
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("shutdown: %w", err)
+	}
+	if err := <-errc; !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
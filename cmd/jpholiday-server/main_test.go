@@ -0,0 +1,112 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rabitt1ove/jp-holidays/httpapi"
+)
+
+func TestMain(m *testing.M) {
+	// Pin "today" to 2026-05-01 10:00 JST for all tests.
+	now = func() time.Time { return time.Date(2026, time.May, 1, 1, 0, 0, 0, time.UTC) }
+	os.Exit(m.Run())
+}
+
+func TestParseFlags(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{"defaults", nil, ""},
+		{"all", []string{"-addr", "127.0.0.1:0", "-config", "c.yaml", "-rate", "0", "-burst", "1", "-shutdown-timeout", "1s", "-min-coverage", "0"}, ""},
+		{"negative rate", []string{"-rate", "-1"}, "-rate must not be negative"},
+		{"zero burst", []string{"-burst", "0"}, "-burst must be at least 1"},
+		{"argument", []string{"extra"}, "unexpected argument"},
+		{"unknown flag", []string{"-port", "80"}, "flag provided but not defined"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var stderr strings.Builder
+			_, err := parseFlags(tt.args, &stderr)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("error = %v, stderr = %q", err, stderr.String())
+				}
+				return
+			}
+			if err == nil || !strings.Contains(stderr.String(), tt.wantErr) {
+				t.Errorf("error = %v, stderr = %q, want containing %q", err, stderr.String(), tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRun_InvalidConfig(t *testing.T) {
+	t.Parallel()
+
+	var stderr strings.Builder
+	code := run(context.Background(), []string{"-config", filepath.Join(t.TempDir(), "missing.yaml")}, &stderr)
+	if code != 1 || !strings.Contains(stderr.String(), "missing.yaml") {
+		t.Errorf("exit code = %d, stderr = %q", code, stderr.String())
+	}
+}
+
+func TestServe(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "company.yaml")
+	if err := os.WriteFile(path, []byte("holidays:\n  - date: 2026-06-15\n    name: 創立記念日\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	h, err := newHandler(config{configPath: path, rate: 100, burst: 10})
+	if err != nil {
+		t.Fatalf("newHandler error: %v", err)
+	}
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- serve(ctx, ln, h, time.Second) }()
+
+	resp, err := http.Get("http://" + ln.Addr().String() + "/is_holiday?date=2026-06-15")
+	if err != nil {
+		t.Fatalf("GET error: %v", err)
+	}
+	var got httpapi.IsHolidayResponse
+	err = json.NewDecoder(resp.Body).Decode(&got)
+	resp.Body.Close()
+	if err != nil || got.Name != "創立記念日" {
+		t.Errorf("response = %+v, %v; want the configured holiday", got, err)
+	}
+
+	// An open event stream must not hold up shutdown.
+	stream, err := http.Get("http://" + ln.Addr().String() + "/events")
+	if err != nil {
+		t.Fatalf("GET /events error: %v", err)
+	}
+	defer stream.Body.Close()
+
+	cancel()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("serve error after shutdown: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("serve did not return after shutdown")
+	}
+}
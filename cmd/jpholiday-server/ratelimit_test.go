@@ -0,0 +1,61 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRateLimiter(t *testing.T) {
+	t.Parallel()
+
+	clock := time.Date(2026, time.May, 1, 0, 0, 0, 0, time.UTC)
+	l := newRateLimiter(2, 3)
+	l.now = func() time.Time { return clock }
+	h := l.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	request := func(addr string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/holidays/2026", nil)
+		req.RemoteAddr = addr
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec
+	}
+
+	for i := range 3 {
+		if rec := request("192.0.2.1:1000"); rec.Code != http.StatusOK {
+			t.Fatalf("request %d: status = %d, want 200 within the burst", i, rec.Code)
+		}
+	}
+	rec := request("192.0.2.1:1001")
+	if rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("status = %d, want 429 after the burst", rec.Code)
+	}
+	if got := rec.Header().Get("Retry-After"); got != "1" {
+		t.Errorf("Retry-After = %q, want 1", got)
+	}
+	if body := rec.Body.String(); body != "{\"error\":\"rate limit exceeded\"}\n" {
+		t.Errorf("body = %q", body)
+	}
+	if rec := request("192.0.2.2:1000"); rec.Code != http.StatusOK {
+		t.Errorf("other client: status = %d, want 200", rec.Code)
+	}
+
+	clock = clock.Add(500 * time.Millisecond) // one token at 2/s
+	if rec := request("192.0.2.1:1000"); rec.Code != http.StatusOK {
+		t.Errorf("status after refill = %d, want 200", rec.Code)
+	}
+	if rec := request("192.0.2.1:1000"); rec.Code != http.StatusTooManyRequests {
+		t.Errorf("status = %d, want 429", rec.Code)
+	}
+
+	clock = clock.Add(sweepInterval)
+	request("192.0.2.3:1000")
+	l.mu.Lock()
+	n := len(l.buckets)
+	l.mu.Unlock()
+	if n != 1 {
+		t.Errorf("%d buckets after sweep, want 1 (idle clients forgotten)", n)
+	}
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"flag"
+	"io"
+	"os"
+	"strconv"
+	"time"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+	"github.com/rabitt1ove/jp-holidays/render"
+)
+
+// calFlags registers the flags of the cal command. With --format tsv or
+// json, cal lists the holidays of the period instead of drawing a grid.
+func calFlags(fs *flag.FlagSet) func(e *env, args []string) error {
+	month := fs.Int("month", 0, "show only this month (1-12)")
+	color := fs.String("color", "auto", "highlight holidays with color: auto, always, or never")
+	return func(e *env, args []string) error {
+		year, err := strconv.Atoi(args[0])
+		if err != nil || len(args[0]) != 4 {
+			return usagef("invalid year %q (want YYYY)", args[0])
+		}
+		if *month < 0 || *month > 12 {
+			return usagef("invalid month %d (want 1-12)", *month)
+		}
+		opts := render.Options{Calendar: e.cal, Locale: e.cal.Locale()}
+		switch *color {
+		case "auto":
+			opts.Color = isTerminal(e.stdout) && os.Getenv("NO_COLOR") == ""
+		case "always":
+			opts.Color = true
+		case "never":
+		default:
+			return usagef("invalid color mode %q (want auto, always, or never)", *color)
+		}
+
+		if e.format != formatTable {
+			var holidays []jpholiday.Holiday
+			if *month == 0 {
+				holidays = e.cal.HolidaysInYear(year)
+			} else {
+				holidays = e.cal.HolidaysInMonth(year, time.Month(*month))
+			}
+			return writeHolidays(e, holidays)
+		}
+		if *month == 0 {
+			return render.YearText(e.stdout, year, opts)
+		}
+		return render.MonthText(e.stdout, year, time.Month(*month), opts)
+	}
+}
+
+// isTerminal reports whether w is a character device such as a terminal.
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	fi, err := f.Stat()
+	return err == nil && fi.Mode()&os.ModeCharDevice != 0
+}
@@ -0,0 +1,27 @@
+package main
+
+import "flag"
+
+// checkFlags registers the flags of the check command. Exactly one of
+// --business-day and --holiday must be given.
+func checkFlags(fs *flag.FlagSet) func(e *env, args []string) error {
+	businessDay := fs.Bool("business-day", false, "succeed if the date is a business day")
+	holiday := fs.Bool("holiday", false, "succeed if the date is a holiday")
+	return func(e *env, args []string) error {
+		if *businessDay == *holiday {
+			return usagef("exactly one of --business-day and --holiday is required")
+		}
+		t, err := dateOrToday(args)
+		if err != nil {
+			return err
+		}
+		ok := e.cal.IsHoliday(t)
+		if *businessDay {
+			ok = e.cal.IsBusinessDay(t)
+		}
+		if !ok {
+			return errCheckFailed
+		}
+		return nil
+	}
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+)
+
+func runCompare(e *env, args []string) error {
+	var years [2]int
+	for i, arg := range args {
+		year, err := strconv.Atoi(arg)
+		if err != nil || len(arg) != 4 {
+			return usagef("invalid year %q (want YYYY)", arg)
+		}
+		years[i] = year
+	}
+	return writeYearDiff(e, e.cal.CompareYears(years[0], years[1]))
+}
+
+// writeYearDiff prints a comparison of two years in the selected format.
+// The table format follows diff, with moves shown as
+//
+//	~ 2019-07-15 (月) -> 2020-07-23 (木) 海の日
+func writeYearDiff(e *env, d jpholiday.YearDiff) error {
+	var b strings.Builder
+	switch e.format {
+	case formatJSON:
+		for _, hs := range []*[]jpholiday.Holiday{&d.Added, &d.Removed} {
+			if *hs == nil {
+				*hs = []jpholiday.Holiday{}
+			}
+		}
+		if d.Moved == nil {
+			d.Moved = []jpholiday.HolidayMove{}
+		}
+		return writeJSON(e.stdout, d)
+	case formatTSV:
+		b.WriteString("Change\tDate\tName\tOldDate\n")
+		for _, m := range d.Moved {
+			fmt.Fprintf(&b, "moved\t%s\t%s\t%s\n", m.To.Format("2006-01-02"), tsvField.Replace(m.Name), m.From.Format("2006-01-02"))
+		}
+		for _, h := range d.Added {
+			fmt.Fprintf(&b, "added\t%s\t%s\t\n", h.Date.Format("2006-01-02"), tsvField.Replace(h.Name))
+		}
+		for _, h := range d.Removed {
+			fmt.Fprintf(&b, "removed\t\t%s\t%s\n", tsvField.Replace(h.Name), h.Date.Format("2006-01-02"))
+		}
+	default:
+		for _, m := range d.Moved {
+			fmt.Fprintf(&b, "~ %s -> %s %s\n", e.formatDate(m.From), e.formatDate(m.To), m.Name)
+		}
+		for _, h := range d.Added {
+			fmt.Fprintf(&b, "+ %s %s\n", e.formatDate(h.Date), h.Name)
+		}
+		for _, h := range d.Removed {
+			fmt.Fprintf(&b, "- %s %s\n", e.formatDate(h.Date), h.Name)
+		}
+		fmt.Fprintf(&b, "%d moved, %d added, %d removed\n", len(d.Moved), len(d.Added), len(d.Removed))
+	}
+	_, err := io.WriteString(e.stdout, b.String())
+	return err
+}
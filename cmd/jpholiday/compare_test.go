@@ -0,0 +1,57 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+)
+
+func TestRun_Compare(t *testing.T) {
+	t.Parallel()
+
+	stdout, stderr, code := runCLI(t, "compare", "2023", "2024")
+	if code != 0 {
+		t.Fatalf("exit code = %d, stderr = %q", code, stderr)
+	}
+	for _, want := range []string{
+		"~ 2023-03-21 (火) -> 2024-03-20 (水) 春分の日\n",
+		"~ 2023-09-23 (土) -> 2024-09-22 (日) 秋分の日\n",
+	} {
+		if !strings.Contains(stdout, want) {
+			t.Errorf("stdout missing %q:\n%s", want, stdout)
+		}
+	}
+	if !strings.HasSuffix(stdout, " removed\n") {
+		t.Errorf("stdout should end with a summary:\n%s", stdout)
+	}
+
+	stdout, _, code = runCLI(t, "compare", "2019", "2020", "--format", "tsv")
+	if code != 0 || !strings.HasPrefix(stdout, "Change\tDate\tName\tOldDate\n") ||
+		!strings.Contains(stdout, "moved\t2020-07-23\t海の日\t2019-07-15\n") ||
+		!strings.Contains(stdout, "removed\t\t休日（祝日扱い）\t2019-05-01\n") {
+		t.Errorf("tsv (exit %d) =\n%s", code, stdout)
+	}
+
+	stdout, _, code = runCLI(t, "compare", "2026", "2026", "--format", "json")
+	if code != 0 {
+		t.Fatalf("json exit code = %d", code)
+	}
+	var got jpholiday.YearDiff
+	if err := json.Unmarshal([]byte(stdout), &got); err != nil {
+		t.Fatalf("unmarshal: %v\n%s", err, stdout)
+	}
+	if len(got.Moved)+len(got.Added)+len(got.Removed) != 0 || !strings.Contains(stdout, `"Moved": []`) {
+		t.Errorf("json = %s", stdout)
+	}
+}
+
+func TestRun_CompareInvalidYear(t *testing.T) {
+	t.Parallel()
+
+	_, stderr, code := runCLI(t, "compare", "2026", "26")
+	if code != 2 || !strings.Contains(stderr, `invalid year "26"`) {
+		t.Errorf("exit code = %d, stderr = %q", code, stderr)
+	}
+}
@@ -0,0 +1,6 @@
+//go:build jpholiday_recent
+
+package main
+
+// generatedDataFile is the generated data file compiled into this build.
+const generatedDataFile = "holidays_data_recent.go"
@@ -0,0 +1,303 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+)
+
+// datasetDiff describes the changes between two holiday datasets. The JSON
+// encoding uses these field names.
+type datasetDiff struct {
+	Added   []jpholiday.Holiday
+	Removed []jpholiday.Holiday
+	Renamed []renamedHoliday
+}
+
+// renamedHoliday is a date present in both datasets with a different name.
+type renamedHoliday struct {
+	Old jpholiday.Holiday
+	New jpholiday.Holiday
+}
+
+func runDiff(e *env, args []string) error {
+	oldHolidays, err := loadDataset(args[0])
+	if err != nil {
+		return err
+	}
+	newHolidays, err := loadDataset(args[1])
+	if err != nil {
+		return err
+	}
+	return writeDiff(e, diffDatasets(oldHolidays, newHolidays))
+}
+
+// loadDataset reads a generated holidays_data.go file or a holiday CSV in
+// the Cabinet Office layout, chosen by the file extension.
+func loadDataset(path string) ([]jpholiday.Holiday, error) {
+	ext := strings.ToLower(filepath.Ext(path))
+	if ext != ".go" && ext != ".csv" {
+		return nil, usagef("%s: unsupported dataset file (want .go or .csv)", path)
+	}
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var holidays []jpholiday.Holiday
+	if ext == ".go" {
+		holidays, err = parseGoDataset(path, src)
+	} else {
+		holidays, err = parseCSVDataset(src)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return holidays, nil
+}
+
+// monthsByConstName maps time.Month constant names (e.g., "January") back to
+// their values for parsing generated source.
+var monthsByConstName = func() map[string]time.Month {
+	m := make(map[string]time.Month, 12)
+	for month := time.January; month <= time.December; month++ {
+		m[month.String()] = month
+	}
+	return m
+}()
+
+// parseGoDataset extracts the builtinHolidays map literal from a data file
+// generated by cmd/genholidays.
+func parseGoDataset(path string, src []byte) ([]jpholiday.Holiday, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, src, 0)
+	if err != nil {
+		return nil, err
+	}
+	lit := findVarLiteral(file, "builtinHolidays")
+	if lit == nil {
+		return nil, fmt.Errorf("builtinHolidays map literal not found")
+	}
+
+	holidays := make([]jpholiday.Holiday, 0, len(lit.Elts))
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			return nil, fmt.Errorf("%s: unexpected map element", fset.Position(elt.Pos()))
+		}
+		h, err := parseGoEntry(kv)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", fset.Position(kv.Pos()), err)
+		}
+		holidays = append(holidays, h)
+	}
+	return holidays, nil
+}
+
+// findVarLiteral returns the composite literal assigned to the named
+// package-level variable, or nil if there is none.
+func findVarLiteral(file *ast.File, name string) *ast.CompositeLit {
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.VAR {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			vs, ok := spec.(*ast.ValueSpec)
+			if !ok || len(vs.Names) != 1 || vs.Names[0].Name != name || len(vs.Values) != 1 {
+				continue
+			}
+			if cl, ok := vs.Values[0].(*ast.CompositeLit); ok {
+				return cl
+			}
+		}
+	}
+	return nil
+}
+
+// parseGoEntry decodes a single `YYYYMMDD: "name"` entry, or a
+// `{year, time.Month, day}: "name"` entry from data files generated before
+// dates were packed into integers.
+func parseGoEntry(kv *ast.KeyValueExpr) (jpholiday.Holiday, error) {
+	date, err := parseGoKey(kv.Key)
+	if err != nil {
+		return jpholiday.Holiday{}, err
+	}
+	val, ok := kv.Value.(*ast.BasicLit)
+	if !ok || val.Kind != token.STRING {
+		return jpholiday.Holiday{}, fmt.Errorf("expected string value")
+	}
+	name, err := strconv.Unquote(val.Value)
+	if err != nil {
+		return jpholiday.Holiday{}, fmt.Errorf("name: %w", err)
+	}
+	return jpholiday.Holiday{Date: date, Name: name}, nil
+}
+
+// parseGoKey decodes a builtinHolidays map key.
+func parseGoKey(e ast.Expr) (time.Time, error) {
+	if lit, ok := e.(*ast.BasicLit); ok && lit.Kind == token.INT {
+		t, err := time.Parse("20060102", lit.Value)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid date key %s", lit.Value)
+		}
+		return t, nil
+	}
+
+	key, ok := e.(*ast.CompositeLit)
+	if !ok || len(key.Elts) != 3 {
+		return time.Time{}, fmt.Errorf("unexpected map key")
+	}
+	year, err := intLit(key.Elts[0])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("year: %w", err)
+	}
+	sel, ok := key.Elts[1].(*ast.SelectorExpr)
+	if !ok {
+		return time.Time{}, fmt.Errorf("month: expected time.Month constant")
+	}
+	month, ok := monthsByConstName[sel.Sel.Name]
+	if !ok {
+		return time.Time{}, fmt.Errorf("month: unknown constant %q", sel.Sel.Name)
+	}
+	day, err := intLit(key.Elts[2])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("day: %w", err)
+	}
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC), nil
+}
+
+func intLit(e ast.Expr) (int, error) {
+	lit, ok := e.(*ast.BasicLit)
+	if !ok || lit.Kind != token.INT {
+		return 0, fmt.Errorf("expected integer literal")
+	}
+	return strconv.Atoi(lit.Value)
+}
+
+// parseCSVDataset reads "YYYY/M/D,name" rows as written by
+// jpholiday.ExportCSV. A header row is skipped. The file must be UTF-8; the
+// Shift_JIS original from the Cabinet Office has to be converted first
+// (e.g., with iconv -f SHIFT_JIS -t UTF-8).
+func parseCSVDataset(src []byte) ([]jpholiday.Holiday, error) {
+	src = bytes.TrimPrefix(src, []byte("\uFEFF"))
+	if !utf8.Valid(src) {
+		return nil, fmt.Errorf("not UTF-8; convert Shift_JIS files with iconv -f SHIFT_JIS -t UTF-8")
+	}
+	cr := csv.NewReader(bytes.NewReader(src))
+	cr.FieldsPerRecord = 2
+	var holidays []jpholiday.Holiday
+	for line := 1; ; line++ {
+		rec, err := cr.Read()
+		if err == io.EOF {
+			return holidays, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		t, err := time.Parse("2006/1/2", strings.TrimSpace(rec[0]))
+		if err != nil {
+			if line == 1 {
+				continue // header
+			}
+			return nil, fmt.Errorf("line %d: invalid date %q", line, rec[0])
+		}
+		holidays = append(holidays, jpholiday.Holiday{Date: t, Name: strings.TrimSpace(rec[1])})
+	}
+}
+
+// diffDatasets compares two datasets by date and reports added, removed,
+// and renamed holidays, each sorted by date.
+func diffDatasets(oldHolidays, newHolidays []jpholiday.Holiday) datasetDiff {
+	oldByDate := make(map[time.Time]jpholiday.Holiday, len(oldHolidays))
+	for _, h := range oldHolidays {
+		oldByDate[h.Date] = h
+	}
+	newByDate := make(map[time.Time]jpholiday.Holiday, len(newHolidays))
+	for _, h := range newHolidays {
+		newByDate[h.Date] = h
+	}
+
+	var d datasetDiff
+	for date, nh := range newByDate {
+		oh, ok := oldByDate[date]
+		switch {
+		case !ok:
+			d.Added = append(d.Added, nh)
+		case oh.Name != nh.Name:
+			d.Renamed = append(d.Renamed, renamedHoliday{Old: oh, New: nh})
+		}
+	}
+	for date, oh := range oldByDate {
+		if _, ok := newByDate[date]; !ok {
+			d.Removed = append(d.Removed, oh)
+		}
+	}
+
+	byDate := func(hs []jpholiday.Holiday) {
+		sort.Slice(hs, func(i, j int) bool { return hs[i].Date.Before(hs[j].Date) })
+	}
+	byDate(d.Added)
+	byDate(d.Removed)
+	sort.Slice(d.Renamed, func(i, j int) bool { return d.Renamed[i].New.Date.Before(d.Renamed[j].New.Date) })
+	return d
+}
+
+// writeDiff prints the diff in the selected format. The table format is a
+// summary suitable for pasting into a pull request:
+//
+//   - 2027-09-20 (月) 敬老の日
+//   - 2027-09-21 (火) 休日
+//     ~ 2027-10-11 (月) 体育の日 -> スポーツの日
+//     1 added, 1 removed, 1 renamed
+func writeDiff(e *env, d datasetDiff) error {
+	var b strings.Builder
+	switch e.format {
+	case formatJSON:
+		for _, hs := range []*[]jpholiday.Holiday{&d.Added, &d.Removed} {
+			if *hs == nil {
+				*hs = []jpholiday.Holiday{}
+			}
+		}
+		if d.Renamed == nil {
+			d.Renamed = []renamedHoliday{}
+		}
+		return writeJSON(e.stdout, d)
+	case formatTSV:
+		b.WriteString("Change\tDate\tName\tOldName\n")
+		for _, h := range d.Added {
+			fmt.Fprintf(&b, "added\t%s\t%s\t\n", h.Date.Format("2006-01-02"), tsvField.Replace(h.Name))
+		}
+		for _, h := range d.Removed {
+			fmt.Fprintf(&b, "removed\t%s\t\t%s\n", h.Date.Format("2006-01-02"), tsvField.Replace(h.Name))
+		}
+		for _, r := range d.Renamed {
+			fmt.Fprintf(&b, "renamed\t%s\t%s\t%s\n", r.New.Date.Format("2006-01-02"), tsvField.Replace(r.New.Name), tsvField.Replace(r.Old.Name))
+		}
+	default:
+		for _, h := range d.Added {
+			fmt.Fprintf(&b, "+ %s %s\n", e.formatDate(h.Date), h.Name)
+		}
+		for _, h := range d.Removed {
+			fmt.Fprintf(&b, "- %s %s\n", e.formatDate(h.Date), h.Name)
+		}
+		for _, r := range d.Renamed {
+			fmt.Fprintf(&b, "~ %s %s -> %s\n", e.formatDate(r.New.Date), r.Old.Name, r.New.Name)
+		}
+		fmt.Fprintf(&b, "%d added, %d removed, %d renamed\n", len(d.Added), len(d.Removed), len(d.Renamed))
+	}
+	_, err := io.WriteString(e.stdout, b.String())
+	return err
+}
@@ -0,0 +1,159 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+)
+
+const oldDataGo = `package jpholiday
+
+import "time"
+
+var builtinHolidays = map[date]string{
+	{2027, time.September, 20}: "敬老の日",
+	{2027, time.September, 21}: "休日",
+	{2027, time.October, 11}:   "体育の日",
+}
+`
+
+const newDataCSV = "国民の祝日・休日月日,国民の祝日・休日名称\r\n" +
+	"2027/9/20,敬老の日\r\n" +
+	"2027/9/23,秋分の日\r\n" +
+	"2027/10/11,スポーツの日\r\n"
+
+// writeDatasets writes the old and new test datasets and returns their paths.
+func writeDatasets(t *testing.T) (oldPath, newPath string) {
+	t.Helper()
+	dir := t.TempDir()
+	oldPath = filepath.Join(dir, "holidays_data.go")
+	newPath = filepath.Join(dir, "syukujitsu.csv")
+	if err := os.WriteFile(oldPath, []byte(oldDataGo), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(newPath, []byte(newDataCSV), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return oldPath, newPath
+}
+
+func TestRun_Diff(t *testing.T) {
+	t.Parallel()
+
+	oldPath, newPath := writeDatasets(t)
+	stdout, stderr, code := runCLI(t, "diff", oldPath, newPath)
+	if code != 0 {
+		t.Fatalf("exit code = %d, stderr = %q", code, stderr)
+	}
+	want := "+ 2027-09-23 (木) 秋分の日\n" +
+		"- 2027-09-21 (火) 休日\n" +
+		"~ 2027-10-11 (月) 体育の日 -> スポーツの日\n" +
+		"1 added, 1 removed, 1 renamed\n"
+	if stdout != want {
+		t.Errorf("stdout =\n%s\nwant\n%s", stdout, want)
+	}
+}
+
+func TestRun_DiffFormats(t *testing.T) {
+	t.Parallel()
+
+	oldPath, newPath := writeDatasets(t)
+
+	stdout, _, code := runCLI(t, "diff", oldPath, newPath, "--format", "tsv")
+	if code != 0 {
+		t.Fatalf("tsv exit code = %d", code)
+	}
+	wantTSV := "Change\tDate\tName\tOldName\n" +
+		"added\t2027-09-23\t秋分の日\t\n" +
+		"removed\t2027-09-21\t\t休日\n" +
+		"renamed\t2027-10-11\tスポーツの日\t体育の日\n"
+	if stdout != wantTSV {
+		t.Errorf("tsv =\n%s\nwant\n%s", stdout, wantTSV)
+	}
+
+	stdout, _, code = runCLI(t, "diff", oldPath, newPath, "--format", "json")
+	if code != 0 {
+		t.Fatalf("json exit code = %d", code)
+	}
+	var got struct {
+		Added, Removed []jpholiday.Holiday
+		Renamed        []struct{ Old, New jpholiday.Holiday }
+	}
+	if err := json.Unmarshal([]byte(stdout), &got); err != nil {
+		t.Fatalf("unmarshal: %v\n%s", err, stdout)
+	}
+	if len(got.Added) != 1 || got.Added[0].Name != "秋分の日" ||
+		len(got.Removed) != 1 || got.Removed[0].Name != "休日" ||
+		len(got.Renamed) != 1 || got.Renamed[0].Old.Name != "体育の日" || got.Renamed[0].New.Name != "スポーツの日" {
+		t.Errorf("json = %+v", got)
+	}
+}
+
+// TestRun_DiffBuiltinAgainstExport checks that the generated data file
+// compiled into this build and its own CSV export describe the same dataset.
+func TestRun_DiffBuiltinAgainstExport(t *testing.T) {
+	t.Parallel()
+
+	csvPath := filepath.Join(t.TempDir(), "export.csv")
+	var b strings.Builder
+	if err := jpholiday.New().ExportCSV(&b, jpholiday.CSVOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(csvPath, []byte(b.String()), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	stdout, stderr, code := runCLI(t, "diff", filepath.Join("..", "..", generatedDataFile), csvPath)
+	if code != 0 {
+		t.Fatalf("exit code = %d, stderr = %q", code, stderr)
+	}
+	if stdout != "0 added, 0 removed, 0 renamed\n" {
+		t.Errorf("stdout = %q", stdout)
+	}
+}
+
+func TestRun_DiffErrors(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	sjis := filepath.Join(dir, "sjis.csv")
+	if err := os.WriteFile(sjis, []byte{0x8c, 0xb3, 0x93, 0xfa, '\n'}, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	badGo := filepath.Join(dir, "other.go")
+	if err := os.WriteFile(badGo, []byte("package x\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	badRow := filepath.Join(dir, "bad.csv")
+	if err := os.WriteFile(badRow, []byte("2026/1/1,元日\nsoon,休日\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		path string
+		code int
+		want string
+	}{
+		{"unsupported extension", filepath.Join(dir, "data.txt"), 2, "unsupported dataset file"},
+		{"shift_jis", sjis, 1, "not UTF-8"},
+		{"no dataset", badGo, 1, "builtinHolidays map literal not found"},
+		{"bad row", badRow, 1, "line 2: invalid date"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, stderr, code := runCLI(t, "diff", tt.path, tt.path)
+			if code != tt.code {
+				t.Errorf("exit code = %d, want %d (stderr %q)", code, tt.code, stderr)
+			}
+			if !strings.Contains(stderr, tt.want) {
+				t.Errorf("stderr = %q, want it to contain %q", stderr, tt.want)
+			}
+		})
+	}
+}
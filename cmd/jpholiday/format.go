@@ -0,0 +1,111 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+)
+
+// Output formats accepted by --format.
+const (
+	formatTable = "table"
+	formatTSV   = "tsv"
+	formatJSON  = "json"
+)
+
+func validateFormat(format string) error {
+	switch format {
+	case formatTable, formatTSV, formatJSON:
+		return nil
+	}
+	return usagef("unsupported format %q (want table, tsv, or json)", format)
+}
+
+// formatDate formats a date as "2006-01-02 (月)", or "2006-01-02 (Mon)"
+// when the calendar's locale is English.
+func (e *env) formatDate(t time.Time) string {
+	label := jpholiday.WeekdayLabel(t.Weekday())
+	if e.cal.Locale() == jpholiday.LocaleEnglish {
+		label = t.Weekday().String()[:3]
+	}
+	return fmt.Sprintf("%s (%s)", t.Format("2006-01-02"), label)
+}
+
+// tsvField removes characters that would break a TSV row.
+var tsvField = strings.NewReplacer("\t", " ", "\n", " ", "\r", " ")
+
+// writeHolidays writes a list of holidays in the selected format. JSON
+// output is always an array, even when empty.
+func writeHolidays(e *env, holidays []jpholiday.Holiday) error {
+	switch e.format {
+	case formatJSON:
+		if holidays == nil {
+			holidays = []jpholiday.Holiday{}
+		}
+		return writeJSON(e.stdout, holidays)
+	case formatTSV:
+		var b strings.Builder
+		b.WriteString("Date\tName\n")
+		for _, h := range holidays {
+			fmt.Fprintf(&b, "%s\t%s\n", h.Date.Format("2006-01-02"), tsvField.Replace(h.Name))
+		}
+		_, err := io.WriteString(e.stdout, b.String())
+		return err
+	default:
+		tw := tabwriter.NewWriter(e.stdout, 0, 0, 1, ' ', 0)
+		for _, h := range holidays {
+			fmt.Fprintf(tw, "%s\t%s\n", e.formatDate(h.Date), h.Name)
+		}
+		return tw.Flush()
+	}
+}
+
+// writeHoliday writes a single holiday in the selected format.
+func writeHoliday(e *env, h jpholiday.Holiday) error {
+	if e.format == formatJSON {
+		return writeJSON(e.stdout, h)
+	}
+	if e.format == formatTSV {
+		return writeHolidays(e, []jpholiday.Holiday{h})
+	}
+	_, err := fmt.Fprintf(e.stdout, "%s %s\n", e.formatDate(h.Date), h.Name)
+	return err
+}
+
+// writeDate writes a single date. JSON output is a "2006-01-02" string.
+func writeDate(e *env, t time.Time) error {
+	var err error
+	switch e.format {
+	case formatJSON:
+		err = writeJSON(e.stdout, t.Format("2006-01-02"))
+	case formatTSV:
+		_, err = fmt.Fprintln(e.stdout, t.Format("2006-01-02"))
+	default:
+		_, err = fmt.Fprintln(e.stdout, e.formatDate(t))
+	}
+	return err
+}
+
+// writeInt writes a count; the spelling is the same in every format.
+func writeInt(e *env, n int) error {
+	_, err := fmt.Fprintln(e.stdout, n)
+	return err
+}
+
+// writeBool writes true or false; the spelling is the same in every format.
+func writeBool(e *env, v bool) error {
+	_, err := fmt.Fprintln(e.stdout, strconv.FormatBool(v))
+	return err
+}
+
+func writeJSON(w io.Writer, v any) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
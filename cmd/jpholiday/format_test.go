@@ -0,0 +1,106 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+)
+
+func TestRun_FormatTSV(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"list", []string{"list", "2026-05", "--format", "tsv"}, "Date\tName\n" +
+			"2026-05-03\t憲法記念日\n" +
+			"2026-05-04\tみどりの日\n" +
+			"2026-05-05\tこどもの日\n" +
+			"2026-05-06\t休日\n"},
+		{"list empty", []string{"list", "2026-06", "--format=tsv"}, "Date\tName\n"},
+		{"next", []string{"next", "--format", "tsv", "2026-05-06"}, "Date\tName\n2026-07-20\t海の日\n"},
+		{"is", []string{"is", "2026-01-01", "--format", "tsv"}, "true\n"},
+		{"name", []string{"name", "2026-01-12", "--format", "tsv"}, "成人の日\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			stdout, stderr, code := runCLI(t, tt.args...)
+			if code != 0 {
+				t.Fatalf("exit code = %d, stderr = %q", code, stderr)
+			}
+			if stdout != tt.want {
+				t.Errorf("stdout = %q, want %q", stdout, tt.want)
+			}
+		})
+	}
+}
+
+func TestRun_FormatJSON(t *testing.T) {
+	t.Parallel()
+
+	t.Run("list", func(t *testing.T) {
+		t.Parallel()
+		stdout, _, code := runCLI(t, "list", "2026-05", "--format", "json")
+		if code != 0 {
+			t.Fatalf("exit code = %d", code)
+		}
+		var got []jpholiday.Holiday
+		if err := json.Unmarshal([]byte(stdout), &got); err != nil {
+			t.Fatalf("unmarshal: %v\n%s", err, stdout)
+		}
+		want := jpholiday.New().HolidaysInMonth(2026, time.May)
+		if len(got) != len(want) {
+			t.Fatalf("got %d holidays, want %d", len(got), len(want))
+		}
+		for i := range want {
+			if !got[i].Date.Equal(want[i].Date) || got[i].Name != want[i].Name {
+				t.Errorf("holiday %d = %+v, want %+v", i, got[i], want[i])
+			}
+		}
+		if !strings.Contains(stdout, `"Date": "2026-05-03T00:00:00Z"`) || !strings.Contains(stdout, `"Name": "憲法記念日"`) {
+			t.Errorf("field names should match Holiday's JSON encoding:\n%s", stdout)
+		}
+	})
+
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"list empty", []string{"list", "2026-06", "--format", "json"}, "[]\n"},
+		{"is", []string{"is", "2026-01-02", "--format", "json"}, "false\n"},
+		{"name not holiday", []string{"name", "2026-01-13", "--format", "json"}, "null\n"},
+		{"name", []string{"name", "2026-01-12", "--format", "json"}, "{\n  \"Date\": \"2026-01-12T00:00:00Z\",\n  \"Name\": \"成人の日\"\n}\n"},
+		{"next", []string{"next", "2026-05-06", "--format", "json"}, "{\n  \"Date\": \"2026-07-20T00:00:00Z\",\n  \"Name\": \"海の日\"\n}\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			stdout, stderr, code := runCLI(t, tt.args...)
+			if code != 0 {
+				t.Fatalf("exit code = %d, stderr = %q", code, stderr)
+			}
+			if stdout != tt.want {
+				t.Errorf("stdout = %q, want %q", stdout, tt.want)
+			}
+		})
+	}
+}
+
+func TestRun_FormatInvalid(t *testing.T) {
+	t.Parallel()
+
+	_, stderr, code := runCLI(t, "list", "2026", "--format", "xml")
+	if code != 2 {
+		t.Errorf("exit code = %d, want 2", code)
+	}
+	if !strings.Contains(stderr, "unsupported format") {
+		t.Errorf("stderr = %q", stderr)
+	}
+}
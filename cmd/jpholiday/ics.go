@@ -0,0 +1,82 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"io"
+	"os"
+	"strconv"
+	"time"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+)
+
+// icsFlags registers the flags of the ics command.
+func icsFlags(fs *flag.FlagSet) func(e *env, args []string) error {
+	from := fs.String("from", "", "first year or date to include (default: this year)")
+	to := fs.String("to", "", "last year or date to include (default: the --from year)")
+	output := fs.String("o", "", "write to this file instead of standard output")
+	name := fs.String("name", "日本の祝日", "calendar display name")
+	return func(e *env, args []string) error {
+		if e.format != formatTable {
+			return usagef("--format is not supported; ics always writes iCalendar")
+		}
+		start, err := parseBound(*from, false)
+		if err != nil {
+			return err
+		}
+		if start.IsZero() {
+			start = time.Date(now().In(jst).Year(), time.January, 1, 0, 0, 0, 0, jst)
+		}
+		end, err := parseBound(*to, true)
+		if err != nil {
+			return err
+		}
+		if end.IsZero() {
+			end = time.Date(start.Year(), time.December, 31, 0, 0, 0, 0, jst)
+		}
+		if end.Before(start) {
+			return usagef("--to %s is before --from %s", end.Format("2006-01-02"), start.Format("2006-01-02"))
+		}
+
+		opts := jpholiday.ICSOptions{From: start, To: end, Name: *name}
+		if *output == "" {
+			return e.cal.ExportICS(e.stdout, opts)
+		}
+		return writeFile(*output, func(w io.Writer) error { return e.cal.ExportICS(w, opts) })
+	}
+}
+
+// parseBound parses a --from or --to value: a year (YYYY) or a date. A year
+// stands for its first day, or its last day when last is set. An empty
+// value yields the zero time.
+func parseBound(s string, last bool) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	if len(s) == 4 {
+		year, err := strconv.Atoi(s)
+		if err != nil {
+			return time.Time{}, usagef("invalid year %q (want YYYY or YYYY-MM-DD)", s)
+		}
+		if last {
+			return time.Date(year, time.December, 31, 0, 0, 0, 0, jst), nil
+		}
+		return time.Date(year, time.January, 1, 0, 0, 0, 0, jst), nil
+	}
+	return parseDate(s)
+}
+
+// writeFile creates path and fills it with write, removing the file again
+// if writing fails.
+func writeFile(path string, write func(w io.Writer) error) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := errors.Join(write(f), f.Close()); err != nil {
+		os.Remove(path)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,355 @@
+// Command jpholiday queries Japanese holidays from the command line.
+//
+// Usage:
+//
+//	jpholiday is <date>                 # prints true or false
+//	jpholiday name <date>               # prints the holiday name, if any
+//	jpholiday next [date]               # the next holiday after date
+//	jpholiday list <year|year-month>    # holidays in a year or month
+//	jpholiday workdays <from> <to>      # business days in [from, to]
+//	jpholiday add-workdays <date> <n>   # the date n business days away
+//	jpholiday next-workday [date]       # the next business day after date
+//	jpholiday check --business-day [date]  # exit 0 on a business day, 1 otherwise
+//	jpholiday cal <year> [--month m]    # cal(1)-style calendar with holidays
+//	jpholiday ics --from 2026 --to 2028 -o holidays.ics  # iCalendar export
+//	jpholiday compare <year1> <year2>   # holidays moved, added, or removed
+//	jpholiday diff <old> <new>          # compare two datasets (.go or .csv)
+//	jpholiday upcoming [-n 5]           # the next holidays with "in N days"
+//	jpholiday verify [--url u] [--names]  # compare with holidays-jp; exit 1 on differences
+//
+// check prints nothing and reports through its exit status, so it can guard
+// cron jobs directly:
+//
+//	0 9 * * * jpholiday check --business-day && run-batch
+//
+// Dates are YYYY-MM-DD (or YYYY/MM/DD) and refer to the Japanese calendar.
+// Where a date is optional it defaults to today in JST.
+//
+// Every command accepts --config with a calendar configuration file (YAML,
+// or TOML/JSON by extension; see jpholiday.Config), so that company
+// holidays, closures, and weekend rules are reflected in the output:
+//
+//	jpholiday workdays 2026-08-01 2026-08-31 --config company.yaml
+//
+// Every command accepts --lang ja|en to choose the language of holiday
+// names and weekday labels. It defaults to the config file's locale, or ja.
+//
+// Every command accepts --format table|tsv|json. The default table output
+// is for people; tsv (with a Date/Name header) imports into spreadsheets;
+// json uses the field names of jpholiday.Holiday's JSON encoding:
+//
+//	jpholiday list 2026 --format json | jq -r '.[].Name'
+//
+// Install with:
+//
+//	go install github.com/rabitt1ove/jp-holidays/cmd/jpholiday@latest
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+)
+
+// jst is the Asia/Tokyo timezone used to determine "today".
+var jst = time.FixedZone("Asia/Tokyo", 9*60*60)
+
+// now returns the current time; tests replace it.
+var now = time.Now
+
+// env carries the streams and calendar shared by all subcommands.
+type env struct {
+	stdout io.Writer
+	stderr io.Writer
+	cal    *jpholiday.Calendar
+	format string // output format: formatTable, formatTSV, or formatJSON
+}
+
+// command is a single subcommand.
+type command struct {
+	name  string
+	args  string // argument synopsis for usage output
+	help  string
+	run   func(e *env, args []string) error
+	nargs [2]int // minimum and maximum positional arguments
+
+	// flags, if set, registers command-specific flags and returns the run
+	// function bound to them; it takes the place of run.
+	flags func(fs *flag.FlagSet) func(e *env, args []string) error
+}
+
+var commands = []command{
+	{name: "is", args: "<date>", help: "print true if the date is a holiday, false otherwise", run: runIs, nargs: [2]int{1, 1}},
+	{name: "name", args: "<date>", help: "print the holiday name for the date, if any", run: runName, nargs: [2]int{1, 1}},
+	{name: "next", args: "[date]", help: "print the next holiday after the date (default: today)", run: runNext, nargs: [2]int{0, 1}},
+	{name: "list", args: "<year|year-month>", help: "list holidays in a year or month", run: runList, nargs: [2]int{1, 1}},
+	{name: "workdays", args: "<from> <to>", help: "count business days from one date to another, inclusive", run: runWorkdays, nargs: [2]int{2, 2}},
+	{name: "add-workdays", args: "<date> <n>", help: "print the date n business days after the date (n may be negative)", run: runAddWorkdays, nargs: [2]int{2, 2}},
+	{name: "next-workday", args: "[date]", help: "print the next business day after the date (default: today)", run: runNextWorkday, nargs: [2]int{0, 1}},
+	{name: "check", args: "--business-day|--holiday [date]", help: "exit 0 if the date (default: today) matches, 1 otherwise", flags: checkFlags, nargs: [2]int{0, 1}},
+	{name: "cal", args: "<year> [--month m]", help: "show a calendar of the year or month with holidays highlighted", flags: calFlags, nargs: [2]int{1, 1}},
+	{name: "ics", args: "[--from y] [--to y] [-o file]", help: "export holidays as an iCalendar (.ics) file", flags: icsFlags, nargs: [2]int{0, 0}},
+	{name: "upcoming", args: "[-n count]", help: "list the next holidays from today with the days remaining", flags: upcomingFlags, nargs: [2]int{0, 0}},
+	{name: "compare", args: "<year1> <year2>", help: "print holidays moved, added, or removed from one year to another", run: runCompare, nargs: [2]int{2, 2}},
+	{name: "diff", args: "<old> <new>", help: "print holidays added, removed, or renamed between two datasets (.go or .csv)", run: runDiff, nargs: [2]int{2, 2}},
+	{name: "verify", args: "[--url u] [--names]", help: "compare the dataset with holidays-jp and exit 1 if they differ", flags: verifyFlags, nargs: [2]int{0, 0}},
+}
+
+// errCheckFailed makes check exit with status 1 without printing anything.
+var errCheckFailed = errors.New("check failed")
+
+// usageError is reported for invalid invocations; it exits with status 2.
+type usageError struct{ msg string }
+
+func (e *usageError) Error() string { return e.msg }
+
+func usagef(format string, args ...any) error {
+	return &usageError{msg: fmt.Sprintf(format, args...)}
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run executes the CLI and returns the process exit status.
+func run(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 || args[0] == "-h" || args[0] == "--help" || args[0] == "help" {
+		printUsage(stderr)
+		if len(args) == 0 {
+			return 2
+		}
+		return 0
+	}
+
+	cmd, ok := findCommand(args[0])
+	if !ok {
+		fmt.Fprintf(stderr, "jpholiday: unknown command %q\n\n", args[0])
+		printUsage(stderr)
+		return 2
+	}
+
+	e := &env{stdout: stdout, stderr: stderr, cal: jpholiday.New()}
+	err := runCommand(e, cmd, args[1:])
+	var uerr *usageError
+	switch {
+	case err == nil:
+		return 0
+	case errors.Is(err, flag.ErrHelp):
+		return 0
+	case errors.Is(err, errCheckFailed):
+		return 1
+	case errors.As(err, &uerr):
+		fmt.Fprintf(stderr, "jpholiday %s: %v\nusage: jpholiday %s %s\n", cmd.name, err, cmd.name, cmd.args)
+		return 2
+	default:
+		fmt.Fprintf(stderr, "jpholiday %s: %v\n", cmd.name, err)
+		return 1
+	}
+}
+
+func findCommand(name string) (command, bool) {
+	for _, c := range commands {
+		if c.name == name {
+			return c, true
+		}
+	}
+	return command{}, false
+}
+
+func printUsage(w io.Writer) {
+	fmt.Fprintln(w, "Usage: jpholiday <command> [arguments]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Commands:")
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, c := range commands {
+		fmt.Fprintf(tw, "  %s\t%s\n", c.name+" "+c.args, c.help)
+	}
+	tw.Flush()
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Dates are YYYY-MM-DD and refer to the Japanese calendar (JST).")
+}
+
+// runCommand parses the subcommand's flags and checks its argument count.
+func runCommand(e *env, cmd command, args []string) error {
+	fs := flag.NewFlagSet(cmd.name, flag.ContinueOnError)
+	fs.SetOutput(e.stderr)
+	fs.Usage = func() {
+		fmt.Fprintf(e.stderr, "usage: jpholiday %s %s\n", cmd.name, cmd.args)
+		fs.PrintDefaults()
+	}
+	fs.StringVar(&e.format, "format", formatTable, "output format: table, tsv, or json")
+	config := fs.String("config", "", "calendar configuration file (YAML, TOML, or JSON)")
+	lang := fs.String("lang", "", "language of holiday names and weekday labels: ja or en")
+	run := cmd.run
+	if cmd.flags != nil {
+		run = cmd.flags(fs)
+	}
+	positional, err := parseInterleaved(fs, args)
+	if err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return err
+		}
+		return &usageError{msg: err.Error()}
+	}
+	if err := validateFormat(e.format); err != nil {
+		return err
+	}
+	if *config != "" {
+		cal, err := jpholiday.LoadConfigFile(*config)
+		if err != nil {
+			return err
+		}
+		e.cal = cal
+	}
+	if *lang != "" {
+		if err := e.cal.SetLocale(*lang); err != nil {
+			return usagef("unsupported language %q (want one of %s)", *lang, strings.Join(jpholiday.Locales(), ", "))
+		}
+	}
+	if len(positional) < cmd.nargs[0] || len(positional) > cmd.nargs[1] {
+		return usagef("wrong number of arguments")
+	}
+	return run(e, positional)
+}
+
+// parseInterleaved parses flags that may appear before or after positional
+// arguments, returning the positional arguments in order. Negative integers
+// are treated as positional arguments rather than flags.
+func parseInterleaved(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for len(args) > 0 {
+		if isNegativeInt(args[0]) {
+			positional = append(positional, args[0])
+			args = args[1:]
+			continue
+		}
+		end := len(args)
+		for i, a := range args {
+			if isNegativeInt(a) {
+				end = i
+				break
+			}
+		}
+		if err := fs.Parse(args[:end]); err != nil {
+			return nil, err
+		}
+		rest := fs.Args()
+		if len(rest) == 0 {
+			args = args[end:]
+			continue
+		}
+		positional = append(positional, rest[0])
+		args = append(rest[1:len(rest):len(rest)], args[end:]...)
+	}
+	return positional, nil
+}
+
+func isNegativeInt(s string) bool {
+	if len(s) < 2 || s[0] != '-' {
+		return false
+	}
+	_, err := strconv.Atoi(s[1:])
+	return err == nil
+}
+
+// parseDate parses YYYY-MM-DD, YYYY/MM/DD, or a wareki date such as
+// 令和8年1月1日 as a Japanese calendar date.
+func parseDate(s string) (time.Time, error) {
+	for _, layout := range []string{"2006-01-02", "2006/01/02", "2006/1/2"} {
+		if t, err := time.ParseInLocation(layout, s, jst); err == nil {
+			return t, nil
+		}
+	}
+	if t, err := jpholiday.ParseWareki(s); err == nil {
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, jst), nil
+	}
+	return time.Time{}, usagef("invalid date %q (want YYYY-MM-DD)", s)
+}
+
+// dateOrToday parses the optional date argument, defaulting to today in JST.
+func dateOrToday(args []string) (time.Time, error) {
+	if len(args) == 0 {
+		y, m, d := now().In(jst).Date()
+		return time.Date(y, m, d, 0, 0, 0, 0, jst), nil
+	}
+	return parseDate(args[0])
+}
+
+func runIs(e *env, args []string) error {
+	t, err := parseDate(args[0])
+	if err != nil {
+		return err
+	}
+	return writeBool(e, e.cal.IsHoliday(t))
+}
+
+func runName(e *env, args []string) error {
+	t, err := parseDate(args[0])
+	if err != nil {
+		return err
+	}
+	name := e.cal.HolidayName(t)
+	if e.format == formatJSON {
+		if name == "" {
+			return writeJSON(e.stdout, nil)
+		}
+		y, m, d := t.Date()
+		return writeJSON(e.stdout, jpholiday.Holiday{Date: time.Date(y, m, d, 0, 0, 0, 0, time.UTC), Name: name})
+	}
+	if name != "" {
+		_, err = fmt.Fprintln(e.stdout, name)
+	}
+	return err
+}
+
+func runNext(e *env, args []string) error {
+	t, err := dateOrToday(args)
+	if err != nil {
+		return err
+	}
+	h, ok := e.cal.NextHoliday(t)
+	if !ok {
+		return fmt.Errorf("no holiday after %s in the dataset", t.Format("2006-01-02"))
+	}
+	return writeHoliday(e, h)
+}
+
+func runList(e *env, args []string) error {
+	year, month, err := parseYearMonth(args[0])
+	if err != nil {
+		return err
+	}
+	var holidays []jpholiday.Holiday
+	if month == 0 {
+		holidays = e.cal.HolidaysInYear(year)
+	} else {
+		holidays = e.cal.HolidaysInMonth(year, month)
+	}
+	return writeHolidays(e, holidays)
+}
+
+// parseYearMonth parses "2026" or "2026-05" (also "2026/05"). The month is
+// zero when only a year is given.
+func parseYearMonth(s string) (int, time.Month, error) {
+	ys, ms, hasMonth := strings.Cut(strings.ReplaceAll(s, "/", "-"), "-")
+	year, err := strconv.Atoi(ys)
+	if err != nil || len(ys) != 4 {
+		return 0, 0, usagef("invalid year %q (want YYYY or YYYY-MM)", s)
+	}
+	if !hasMonth {
+		return year, 0, nil
+	}
+	month, err := strconv.Atoi(ms)
+	if err != nil || month < 1 || month > 12 {
+		return 0, 0, usagef("invalid month %q (want YYYY-MM)", s)
+	}
+	return year, time.Month(month), nil
+}
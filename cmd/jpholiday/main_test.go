@@ -0,0 +1,596 @@
+package main
+
+import (
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+)
+
+func TestMain(m *testing.M) {
+	// Pin "today" to 2026-05-01 10:00 JST for all tests.
+	now = func() time.Time { return time.Date(2026, time.May, 1, 1, 0, 0, 0, time.UTC) }
+	os.Exit(m.Run())
+}
+
+// runCLI runs the command with args and returns its output and exit status.
+func runCLI(t *testing.T, args ...string) (stdout, stderr string, code int) {
+	t.Helper()
+	var out, errOut strings.Builder
+	code = run(args, &out, &errOut)
+	return out.String(), errOut.String(), code
+}
+
+func TestRun_Commands(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"is holiday", []string{"is", "2026-01-01"}, "true\n"},
+		{"is not holiday", []string{"is", "2026-01-02"}, "false\n"},
+		{"is slash date", []string{"is", "2026/1/12"}, "true\n"},
+		{"is wareki date", []string{"is", "令和8年1月12日"}, "true\n"},
+		{"name abbreviated wareki date", []string{"name", "R8.5.6"}, "休日\n"},
+		{"name", []string{"name", "2026-01-12"}, "成人の日\n"},
+		{"name not holiday", []string{"name", "2026-01-13"}, ""},
+		{"next", []string{"next", "2026-05-06"}, "2026-07-20 (月) 海の日\n"},
+		{"next today", []string{"next"}, "2026-05-03 (日) 憲法記念日\n"},
+		{"list month", []string{"list", "2026-05"}, "2026-05-03 (日) 憲法記念日\n" +
+			"2026-05-04 (月) みどりの日\n" +
+			"2026-05-05 (火) こどもの日\n" +
+			"2026-05-06 (水) 休日\n"},
+		{"list empty month", []string{"list", "2026/06"}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			stdout, stderr, code := runCLI(t, tt.args...)
+			if code != 0 {
+				t.Fatalf("exit code = %d, stderr = %q", code, stderr)
+			}
+			if stdout != tt.want {
+				t.Errorf("stdout = %q, want %q", stdout, tt.want)
+			}
+		})
+	}
+}
+
+func TestRun_ListYear(t *testing.T) {
+	t.Parallel()
+
+	stdout, _, code := runCLI(t, "list", "2026")
+	if code != 0 {
+		t.Fatalf("exit code = %d", code)
+	}
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	if len(lines) < 16 {
+		t.Errorf("2026 should have at least 16 holidays, got %d", len(lines))
+	}
+	if lines[0] != "2026-01-01 (木) 元日" {
+		t.Errorf("first line = %q", lines[0])
+	}
+}
+
+func TestRun_UsageErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"no command", nil, "Usage: jpholiday"},
+		{"unknown command", []string{"bogus"}, "unknown command"},
+		{"missing argument", []string{"is"}, "wrong number of arguments"},
+		{"extra argument", []string{"name", "2026-01-01", "2026-01-02"}, "wrong number of arguments"},
+		{"bad date", []string{"is", "tomorrow"}, "invalid date"},
+		{"bad year", []string{"list", "26"}, "invalid year"},
+		{"bad month", []string{"list", "2026-13"}, "invalid month"},
+		{"unknown flag", []string{"list", "--nope", "2026"}, "flag provided but not defined"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, stderr, code := runCLI(t, tt.args...)
+			if code != 2 {
+				t.Errorf("exit code = %d, want 2", code)
+			}
+			if !strings.Contains(stderr, tt.want) {
+				t.Errorf("stderr = %q, want it to contain %q", stderr, tt.want)
+			}
+		})
+	}
+}
+
+func TestRun_Help(t *testing.T) {
+	t.Parallel()
+
+	_, stderr, code := runCLI(t, "--help")
+	if code != 0 {
+		t.Errorf("exit code = %d, want 0", code)
+	}
+	for _, c := range commands {
+		if !strings.Contains(stderr, c.name+" "+c.args) {
+			t.Errorf("usage should list %q", c.name)
+		}
+	}
+}
+
+func TestRun_NextBeyondDataset(t *testing.T) {
+	t.Parallel()
+
+	_, stderr, code := runCLI(t, "next", "2999-01-01")
+	if code != 1 {
+		t.Errorf("exit code = %d, want 1", code)
+	}
+	if !strings.Contains(stderr, "no holiday after") {
+		t.Errorf("stderr = %q", stderr)
+	}
+}
+
+func TestParseInterleaved(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		args []string
+		want []string
+	}{
+		{[]string{"a", "b"}, []string{"a", "b"}},
+		{[]string{"-v", "a", "-v", "b"}, []string{"a", "b"}},
+		{[]string{"a", "-3", "-v"}, []string{"a", "-3"}},
+		{[]string{"-5"}, []string{"-5"}},
+	}
+	for _, tt := range tests {
+		fs := newTestFlagSet()
+		got, err := parseInterleaved(fs, tt.args)
+		if err != nil {
+			t.Errorf("parseInterleaved(%q) error: %v", tt.args, err)
+			continue
+		}
+		if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+			t.Errorf("parseInterleaved(%q) = %q, want %q", tt.args, got, tt.want)
+		}
+	}
+}
+
+func newTestFlagSet() *flag.FlagSet {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.Bool("v", false, "")
+	return fs
+}
+
+func TestRun_Workdays(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"golden week", []string{"workdays", "2026-04-27", "2026-05-08"}, "6\n"},
+		{"single day", []string{"workdays", "2026-05-01", "2026-05-01"}, "1\n"},
+		{"holiday only", []string{"workdays", "2026-05-03", "2026-05-06"}, "0\n"},
+		{"add", []string{"add-workdays", "2026-05-01", "1"}, "2026-05-07 (木)\n"},
+		{"add several", []string{"add-workdays", "2026-04-30", "3"}, "2026-05-08 (金)\n"},
+		{"add negative", []string{"add-workdays", "2026-05-07", "-1"}, "2026-05-01 (金)\n"},
+		{"add zero on holiday", []string{"add-workdays", "2026-05-03", "0"}, "2026-05-07 (木)\n"},
+		{"add zero on business day", []string{"add-workdays", "2026-05-01", "0"}, "2026-05-01 (金)\n"},
+		{"add json", []string{"add-workdays", "2026-05-01", "1", "--format", "json"}, "\"2026-05-07\"\n"},
+		{"next workday", []string{"next-workday", "2026-05-01"}, "2026-05-07 (木)\n"},
+		{"next workday today", []string{"next-workday", "--format", "tsv"}, "2026-05-07\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			stdout, stderr, code := runCLI(t, tt.args...)
+			if code != 0 {
+				t.Fatalf("exit code = %d, stderr = %q", code, stderr)
+			}
+			if stdout != tt.want {
+				t.Errorf("stdout = %q, want %q", stdout, tt.want)
+			}
+		})
+	}
+}
+
+func TestRun_WorkdaysErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"reversed range", []string{"workdays", "2026-05-08", "2026-05-01"}, "is before"},
+		{"bad count", []string{"add-workdays", "2026-05-01", "three"}, "invalid number of days"},
+		{"missing count", []string{"add-workdays", "2026-05-01"}, "wrong number of arguments"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, stderr, code := runCLI(t, tt.args...)
+			if code != 2 {
+				t.Errorf("exit code = %d, want 2", code)
+			}
+			if !strings.Contains(stderr, tt.want) {
+				t.Errorf("stderr = %q, want it to contain %q", stderr, tt.want)
+			}
+		})
+	}
+}
+
+func TestRun_Check(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		args []string
+		want int
+	}{
+		{"business day", []string{"check", "--business-day", "2026-05-07"}, 0},
+		{"holiday is not a business day", []string{"check", "--business-day", "2026-05-06"}, 1},
+		{"weekend is not a business day", []string{"check", "2026-05-09", "--business-day"}, 1},
+		{"today", []string{"check", "--business-day"}, 0},
+		{"holiday", []string{"check", "--holiday", "2026-05-06"}, 0},
+		{"not a holiday", []string{"check", "--holiday", "2026-05-09"}, 1},
+		{"no mode", []string{"check", "2026-05-07"}, 2},
+		{"both modes", []string{"check", "--holiday", "--business-day"}, 2},
+		{"bad date", []string{"check", "--business-day", "soon"}, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			stdout, stderr, code := runCLI(t, tt.args...)
+			if code != tt.want {
+				t.Errorf("exit code = %d, want %d (stderr %q)", code, tt.want, stderr)
+			}
+			if stdout != "" {
+				t.Errorf("stdout = %q, want no output", stdout)
+			}
+			if tt.want < 2 && stderr != "" {
+				t.Errorf("stderr = %q, want no output", stderr)
+			}
+		})
+	}
+}
+
+func TestRun_Cal(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		args     []string
+		contains []string
+		absent   []string
+	}{
+		{"month", []string{"cal", "2026", "--month", "5"},
+			[]string{"      2026年5月\n", " 3* 4* 5* 6* 7  8  9\n", "  5/6  休日\n"}, []string{"\x1b["}},
+		{"year", []string{"cal", "2026"},
+			[]string{"2026年1月", "2026年12月", "11/23  勤労感謝の日\n"}, nil},
+		{"color", []string{"cal", "--color", "always", "2026", "--month=5"},
+			[]string{"\x1b[31m 3\x1b[0m"}, []string{"*"}},
+		{"json", []string{"cal", "2026", "--month", "5", "--format", "json"},
+			[]string{`"Name": "憲法記念日"`}, []string{"2026年5月"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			stdout, stderr, code := runCLI(t, tt.args...)
+			if code != 0 {
+				t.Fatalf("exit code = %d, stderr = %q", code, stderr)
+			}
+			for _, s := range tt.contains {
+				if !strings.Contains(stdout, s) {
+					t.Errorf("stdout should contain %q:\n%s", s, stdout)
+				}
+			}
+			for _, s := range tt.absent {
+				if strings.Contains(stdout, s) {
+					t.Errorf("stdout should not contain %q:\n%s", s, stdout)
+				}
+			}
+		})
+	}
+}
+
+func TestRun_CalErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"bad year", []string{"cal", "2026-05"}, "invalid year"},
+		{"bad month", []string{"cal", "2026", "--month", "13"}, "invalid month"},
+		{"bad color", []string{"cal", "2026", "--color", "rainbow"}, "invalid color mode"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, stderr, code := runCLI(t, tt.args...)
+			if code != 2 {
+				t.Errorf("exit code = %d, want 2", code)
+			}
+			if !strings.Contains(stderr, tt.want) {
+				t.Errorf("stderr = %q, want it to contain %q", stderr, tt.want)
+			}
+		})
+	}
+}
+
+func TestRun_ICS(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	config := filepath.Join(dir, "company.yaml")
+	yaml := "holidays:\n  - date: 2027-06-01\n    name: 創立記念日\n"
+	if err := os.WriteFile(config, []byte(yaml), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	out := filepath.Join(dir, "holidays.ics")
+
+	stdout, stderr, code := runCLI(t, "ics", "--from", "2026", "--to", "2028", "-o", out, "--config", config)
+	if code != 0 {
+		t.Fatalf("exit code = %d, stderr = %q", code, stderr)
+	}
+	if stdout != "" {
+		t.Errorf("stdout = %q, want nothing when writing to a file", stdout)
+	}
+	b, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := string(b)
+	for _, want := range []string{
+		"UID:20260101@jp-holidays\r\n",
+		"SUMMARY:創立記念日\r\n",
+		"UID:20271123@jp-holidays\r\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("output should contain %q", want)
+		}
+	}
+	if strings.Contains(got, "UID:2025") || strings.Contains(got, "UID:2029") {
+		t.Error("output should be limited to 2026-2028")
+	}
+}
+
+func TestRun_ICSDefaultsToThisYear(t *testing.T) {
+	t.Parallel()
+
+	stdout, stderr, code := runCLI(t, "ics")
+	if code != 0 {
+		t.Fatalf("exit code = %d, stderr = %q", code, stderr)
+	}
+	if n := strings.Count(stdout, "BEGIN:VEVENT"); n != len(jpholiday.New().HolidaysInYear(2026)) {
+		t.Errorf("got %d events, want the holidays of 2026", n)
+	}
+}
+
+func TestRun_ICSErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		args []string
+		code int
+		want string
+	}{
+		{"reversed range", []string{"ics", "--from", "2028", "--to", "2026"}, 2, "is before"},
+		{"bad year", []string{"ics", "--from", "20x6"}, 2, "invalid year"},
+		{"format", []string{"ics", "--format", "json"}, 2, "--format is not supported"},
+		{"missing config", []string{"ics", "--config", "no-such-file.yaml"}, 1, "no-such-file.yaml"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, stderr, code := runCLI(t, tt.args...)
+			if code != tt.code {
+				t.Errorf("exit code = %d, want %d", code, tt.code)
+			}
+			if !strings.Contains(stderr, tt.want) {
+				t.Errorf("stderr = %q, want it to contain %q", stderr, tt.want)
+			}
+		})
+	}
+}
+
+func TestRun_Config(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	files := map[string]string{
+		"company.yaml": "holidays:\n  - date: 2026-06-01\n    name: 創立記念日\nclosures:\n  - from: 2026-08-13\n    to: 2026-08-14\n",
+		"company.toml": "[[holidays]]\ndate = 2026-06-01\nname = \"創立記念日\"\n\n[[closures]]\nfrom = 2026-08-13\nto = 2026-08-14\n",
+		"company.json": `{"holidays": [{"date": "2026-06-01", "name": "創立記念日"}], "closures": [{"from": "2026-08-13", "to": "2026-08-14"}]}`,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for name := range files {
+		config := filepath.Join(dir, name)
+		tests := []struct {
+			args []string
+			want string
+		}{
+			{[]string{"is", "2026-06-01"}, "true\n"},
+			{[]string{"name", "2026-06-01"}, "創立記念日\n"},
+			{[]string{"next", "2026-05-06"}, "2026-06-01 (月) 創立記念日\n"},
+			{[]string{"workdays", "2026-08-10", "2026-08-14"}, "2\n"},
+			{[]string{"next-workday", "2026-08-12"}, "2026-08-17 (月)\n"},
+		}
+		for _, tt := range tests {
+			t.Run(name+"/"+tt.args[0], func(t *testing.T) {
+				t.Parallel()
+				stdout, stderr, code := runCLI(t, append(tt.args, "--config", config)...)
+				if code != 0 {
+					t.Fatalf("exit code = %d, stderr = %q", code, stderr)
+				}
+				if stdout != tt.want {
+					t.Errorf("stdout = %q, want %q", stdout, tt.want)
+				}
+			})
+		}
+	}
+}
+
+func TestRun_ConfigInvalid(t *testing.T) {
+	t.Parallel()
+
+	config := filepath.Join(t.TempDir(), "bad.yaml")
+	if err := os.WriteFile(config, []byte("holidayz: []\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	_, stderr, code := runCLI(t, "is", "2026-01-01", "--config", config)
+	if code != 1 {
+		t.Errorf("exit code = %d, want 1", code)
+	}
+	if !strings.Contains(stderr, "config:") {
+		t.Errorf("stderr = %q", stderr)
+	}
+}
+
+func TestRun_Lang(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	config := filepath.Join(dir, "en.yaml")
+	if err := os.WriteFile(config, []byte("locale: en\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"name", []string{"name", "2026-01-12", "--lang", "en"}, "Coming of Age Day\n"},
+		{"next", []string{"next", "2026-05-06", "--lang=en"}, "2026-07-20 (Mon) Marine Day\n"},
+		{"next-workday", []string{"next-workday", "2026-05-01", "--lang", "en"}, "2026-05-07 (Thu)\n"},
+		{"config locale", []string{"name", "2026-01-12", "--config", config}, "Coming of Age Day\n"},
+		{"flag overrides config", []string{"name", "2026-01-12", "--config", config, "--lang", "ja"}, "成人の日\n"},
+		{"list tsv", []string{"list", "2026-05", "--lang", "en", "--format", "tsv"}, "Date\tName\n" +
+			"2026-05-03\tConstitution Memorial Day\n" +
+			"2026-05-04\tGreenery Day\n" +
+			"2026-05-05\tChildren's Day\n" +
+			"2026-05-06\tSubstitute Holiday for Constitution Memorial Day\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			stdout, stderr, code := runCLI(t, tt.args...)
+			if code != 0 {
+				t.Fatalf("exit code = %d, stderr = %q", code, stderr)
+			}
+			if stdout != tt.want {
+				t.Errorf("stdout = %q, want %q", stdout, tt.want)
+			}
+		})
+	}
+
+	t.Run("cal", func(t *testing.T) {
+		t.Parallel()
+		stdout, _, code := runCLI(t, "cal", "2026", "--month", "5", "--lang", "en")
+		if code != 0 {
+			t.Fatalf("exit code = %d", code)
+		}
+		for _, want := range []string{"May 2026", "Su Mo Tu We Th Fr Sa", "5/4  Greenery Day"} {
+			if !strings.Contains(stdout, want) {
+				t.Errorf("stdout should contain %q:\n%s", want, stdout)
+			}
+		}
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		t.Parallel()
+		_, stderr, code := runCLI(t, "is", "2026-01-01", "--lang", "fr")
+		if code != 2 || !strings.Contains(stderr, "unsupported language") {
+			t.Errorf("exit code = %d, stderr = %q", code, stderr)
+		}
+	})
+}
+
+func TestRun_Upcoming(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"default", []string{"upcoming", "-n", "3"}, "2026-05-03 (日)  2日後  憲法記念日\n" +
+			"2026-05-04 (月)  3日後  みどりの日\n" +
+			"2026-05-05 (火)  4日後  こどもの日\n"},
+		{"english", []string{"upcoming", "-n", "2", "--lang", "en"}, "2026-05-03 (Sun)  in 2 days  Constitution Memorial Day\n" +
+			"2026-05-04 (Mon)  in 3 days  Greenery Day\n"},
+		{"tsv", []string{"upcoming", "-n=1", "--format", "tsv"}, "Date\tName\tDays\n2026-05-03\t憲法記念日\t2\n"},
+		{"json", []string{"upcoming", "-n", "1", "--format", "json"}, "[\n  {\n    \"Date\": \"2026-05-03T00:00:00Z\",\n    \"Name\": \"憲法記念日\",\n    \"Days\": 2\n  }\n]\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			stdout, stderr, code := runCLI(t, tt.args...)
+			if code != 0 {
+				t.Fatalf("exit code = %d, stderr = %q", code, stderr)
+			}
+			if stdout != tt.want {
+				t.Errorf("stdout = %q, want %q", stdout, tt.want)
+			}
+		})
+	}
+
+	t.Run("default count", func(t *testing.T) {
+		t.Parallel()
+		stdout, _, _ := runCLI(t, "upcoming")
+		if n := strings.Count(stdout, "\n"); n != 5 {
+			t.Errorf("got %d lines, want 5", n)
+		}
+	})
+
+	t.Run("invalid count", func(t *testing.T) {
+		t.Parallel()
+		_, stderr, code := runCLI(t, "upcoming", "-n", "0")
+		if code != 2 || !strings.Contains(stderr, "at least 1") {
+			t.Errorf("exit code = %d, stderr = %q", code, stderr)
+		}
+	})
+}
+
+func TestRelativeDays(t *testing.T) {
+	t.Parallel()
+
+	ja := &env{cal: jpholiday.New()}
+	en := &env{cal: jpholiday.New()}
+	if err := en.cal.SetLocale(jpholiday.LocaleEnglish); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		days   int
+		ja, en string
+	}{
+		{0, "今日", "today"},
+		{1, "明日", "tomorrow"},
+		{12, "12日後", "in 12 days"},
+	}
+	for _, tt := range tests {
+		if got := ja.relativeDays(tt.days); got != tt.ja {
+			t.Errorf("ja relativeDays(%d) = %q, want %q", tt.days, got, tt.ja)
+		}
+		if got := en.relativeDays(tt.days); got != tt.en {
+			t.Errorf("en relativeDays(%d) = %q, want %q", tt.days, got, tt.en)
+		}
+	}
+}
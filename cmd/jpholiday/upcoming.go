@@ -0,0 +1,87 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+)
+
+// upcomingHoliday is a holiday with its distance from today. The JSON
+// encoding extends jpholiday.Holiday's fields with Days.
+type upcomingHoliday struct {
+	jpholiday.Holiday
+	Days int // days from today; 0 means today
+}
+
+// upcomingFlags registers the flags of the upcoming command.
+func upcomingFlags(fs *flag.FlagSet) func(e *env, args []string) error {
+	n := fs.Int("n", 5, "number of holidays to show")
+	return func(e *env, args []string) error {
+		if *n < 1 {
+			return usagef("-n must be at least 1")
+		}
+		y, m, d := now().In(jst).Date()
+		today := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+
+		var list []upcomingHoliday
+		cur := today.AddDate(0, 0, -1) // today itself counts
+		for len(list) < *n {
+			h, ok := e.cal.NextHoliday(cur)
+			if !ok {
+				break
+			}
+			days := int(h.Date.Sub(today).Hours() / 24)
+			list = append(list, upcomingHoliday{Holiday: h, Days: days})
+			cur = h.Date
+		}
+		return writeUpcoming(e, list)
+	}
+}
+
+// relativeDays describes a distance in days, e.g. "in 12 days" or "12日後".
+func (e *env) relativeDays(days int) string {
+	english := e.cal.Locale() == jpholiday.LocaleEnglish
+	switch {
+	case days == 0 && english:
+		return "today"
+	case days == 0:
+		return "今日"
+	case days == 1 && english:
+		return "tomorrow"
+	case days == 1:
+		return "明日"
+	case english:
+		return fmt.Sprintf("in %d days", days)
+	default:
+		return fmt.Sprintf("%d日後", days)
+	}
+}
+
+func writeUpcoming(e *env, list []upcomingHoliday) error {
+	switch e.format {
+	case formatJSON:
+		if list == nil {
+			list = []upcomingHoliday{}
+		}
+		return writeJSON(e.stdout, list)
+	case formatTSV:
+		var b strings.Builder
+		b.WriteString("Date\tName\tDays\n")
+		for _, u := range list {
+			fmt.Fprintf(&b, "%s\t%s\t%d\n", u.Date.Format("2006-01-02"), tsvField.Replace(u.Name), u.Days)
+		}
+		_, err := io.WriteString(e.stdout, b.String())
+		return err
+	default:
+		tw := tabwriter.NewWriter(e.stdout, 0, 0, 2, ' ', 0)
+		for _, u := range list {
+			fmt.Fprintf(tw, "%s\t%s\t%s\n", e.formatDate(u.Date), e.relativeDays(u.Days), u.Name)
+		}
+		return tw.Flush()
+	}
+}
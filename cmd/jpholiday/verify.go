@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+)
+
+func verifyFlags(fs *flag.FlagSet) func(e *env, args []string) error {
+	url := fs.String("url", jpholiday.HolidaysJPURL, "JSON object of `date`-to-name holidays to compare with")
+	names := fs.Bool("names", false, "also report holidays whose names differ")
+	return func(e *env, args []string) error {
+		ds, err := e.cal.CrossCheck(context.Background(), jpholiday.CrossCheckOptions{URL: *url, Names: *names})
+		if err != nil {
+			return err
+		}
+		if err := writeDiscrepancies(e, ds); err != nil {
+			return err
+		}
+		if len(ds) > 0 {
+			return errCheckFailed
+		}
+		return nil
+	}
+}
+
+// writeDiscrepancies prints a cross-check in the selected format. The table
+// format follows diff, with the built-in data as the old side:
+//
+//	~ 2026-05-06 (水) 休日 -> 憲法記念日 振替休日
+//	+ 2026-06-01 (月) 謎の日
+//	- 2026-07-20 (月) 海の日
+func writeDiscrepancies(e *env, ds []jpholiday.Discrepancy) error {
+	var b strings.Builder
+	switch e.format {
+	case formatJSON:
+		if ds == nil {
+			ds = []jpholiday.Discrepancy{}
+		}
+		return writeJSON(e.stdout, ds)
+	case formatTSV:
+		b.WriteString("Date\tName\tOther\n")
+		for _, d := range ds {
+			fmt.Fprintf(&b, "%s\t%s\t%s\n", d.Date.Format("2006-01-02"), tsvField.Replace(d.Name), tsvField.Replace(d.Other))
+		}
+	default:
+		for _, d := range ds {
+			switch {
+			case d.Name == "":
+				fmt.Fprintf(&b, "+ %s %s\n", e.formatDate(d.Date), d.Other)
+			case d.Other == "":
+				fmt.Fprintf(&b, "- %s %s\n", e.formatDate(d.Date), d.Name)
+			default:
+				fmt.Fprintf(&b, "~ %s %s -> %s\n", e.formatDate(d.Date), d.Name, d.Other)
+			}
+		}
+		fmt.Fprintf(&b, "%d discrepancies\n", len(ds))
+	}
+	_, err := io.WriteString(e.stdout, b.String())
+	return err
+}
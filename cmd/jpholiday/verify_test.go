@@ -0,0 +1,63 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+)
+
+// holidaysJPServer serves the holidays of 2026 in the holidays-jp format,
+// edited by fn.
+func holidaysJPServer(t *testing.T, fn func(map[string]string)) *httptest.Server {
+	t.Helper()
+	m := make(map[string]string)
+	for _, h := range jpholiday.HolidaysInYear(2026) {
+		m[h.Date.Format(time.DateOnly)] = h.Name
+	}
+	fn(m)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(m)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestRun_Verify(t *testing.T) {
+	t.Parallel()
+
+	agree := holidaysJPServer(t, func(map[string]string) {})
+	stdout, stderr, code := runCLI(t, "verify", "--url", agree.URL)
+	if code != 0 || stdout != "0 discrepancies\n" {
+		t.Errorf("agreeing source: exit %d, stdout %q, stderr %q", code, stdout, stderr)
+	}
+
+	differ := holidaysJPServer(t, func(m map[string]string) {
+		delete(m, "2026-07-20")
+		m["2026-06-01"] = "謎の日"
+		m["2026-05-06"] = "憲法記念日 振替休日"
+	})
+	stdout, _, code = runCLI(t, "verify", "--url", differ.URL, "--names")
+	want := "" +
+		"~ 2026-05-06 (水) 休日 -> 憲法記念日 振替休日\n" +
+		"+ 2026-06-01 (月) 謎の日\n" +
+		"- 2026-07-20 (月) 海の日\n" +
+		"3 discrepancies\n"
+	if code != 1 || stdout != want {
+		t.Errorf("differing source: exit %d, stdout =\n%s", code, stdout)
+	}
+
+	stdout, _, code = runCLI(t, "verify", "--url", differ.URL, "--format", "tsv")
+	if code != 1 || stdout != "Date\tName\tOther\n2026-06-01\t\t謎の日\n2026-07-20\t海の日\t\n" {
+		t.Errorf("tsv (exit %d) =\n%s", code, stdout)
+	}
+
+	stdout, _, code = runCLI(t, "verify", "--url", agree.URL, "--format", "json")
+	if code != 0 || strings.TrimSpace(stdout) != "[]" {
+		t.Errorf("json (exit %d) = %s", code, stdout)
+	}
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+)
+
+// maxNonBusinessRun bounds the search for a business day so that a calendar
+// with no business days at all fails instead of looping forever.
+const maxNonBusinessRun = 366
+
+func runWorkdays(e *env, args []string) error {
+	from, err := parseDate(args[0])
+	if err != nil {
+		return err
+	}
+	to, err := parseDate(args[1])
+	if err != nil {
+		return err
+	}
+	if to.Before(from) {
+		return usagef("%s is before %s", args[1], args[0])
+	}
+	return writeInt(e, e.cal.BusinessDaysBetween(from, to))
+}
+
+func runAddWorkdays(e *env, args []string) error {
+	t, err := parseDate(args[0])
+	if err != nil {
+		return err
+	}
+	n, err := strconv.Atoi(args[1])
+	if err != nil {
+		return usagef("invalid number of days %q", args[1])
+	}
+	d, err := addWorkdays(e.cal, t, n)
+	if err != nil {
+		return err
+	}
+	return writeDate(e, d)
+}
+
+func runNextWorkday(e *env, args []string) error {
+	t, err := dateOrToday(args)
+	if err != nil {
+		return err
+	}
+	d, err := addWorkdays(e.cal, t, 1)
+	if err != nil {
+		return err
+	}
+	return writeDate(e, d)
+}
+
+// addWorkdays returns the date n business days after t, or before t when n is
+// negative. Non-business days are skipped; with n == 0 the result is t itself
+// if it is a business day, otherwise the next business day.
+func addWorkdays(cal *jpholiday.Calendar, t time.Time, n int) (time.Time, error) {
+	y, m, d := t.Date()
+	cur := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+	if n == 0 {
+		n, step = 1, 1
+		cur = cur.AddDate(0, 0, -1)
+	}
+	for run := 0; n > 0; {
+		cur = cur.AddDate(0, 0, step)
+		if !cal.IsBusinessDay(cur) {
+			if run++; run > maxNonBusinessRun {
+				return time.Time{}, fmt.Errorf("no business day within %d days of %s", maxNonBusinessRun, cur.Format("2006-01-02"))
+			}
+			continue
+		}
+		run = 0
+		n--
+	}
+	return cur, nil
+}
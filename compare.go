@@ -0,0 +1,80 @@
+package jpholiday
+
+import (
+	"slices"
+	"time"
+)
+
+// YearDiff describes how the holidays of one year differ from those of
+// another, as returned by [Calendar.CompareYears]. Each list is sorted by
+// date; holidays on the same month and day in both years are omitted.
+type YearDiff struct {
+	Moved   []HolidayMove // Holidays in both years on a different month or day.
+	Added   []Holiday     // Holidays only in the second year.
+	Removed []Holiday     // Holidays only in the first year.
+}
+
+// HolidayMove is a holiday that falls on a different month or day in the
+// second of two compared years.
+type HolidayMove struct {
+	Name string    // The holiday name.
+	From time.Time // The date in the first year (midnight UTC).
+	To   time.Time // The date in the second year (midnight UTC).
+}
+
+// CompareYears compares the holidays of year y2 with those of y1, showing
+// for example an equinox shifting by a day, a Happy Monday holiday moving
+// with the weekdays, or the holidays relocated for the Tokyo Olympics.
+//
+// Holidays are matched by name. A name that occurs several times in a year,
+// such as 休日, is first matched on the same month and day, and the
+// remaining occurrences are paired in date order; any left over are added
+// or removed.
+func (c *Calendar) CompareYears(y1, y2 int) YearDiff {
+	before := groupByName(c.HolidaysInYear(y1))
+	after := groupByName(c.HolidaysInYear(y2))
+
+	var diff YearDiff
+	for name, olds := range before {
+		news := after[name]
+		olds = slices.DeleteFunc(olds, func(o Holiday) bool {
+			i := slices.IndexFunc(news, func(n Holiday) bool { return sameMonthDay(o.Date, n.Date) })
+			if i < 0 {
+				return false
+			}
+			news = slices.Delete(news, i, i+1)
+			return true
+		})
+		n := min(len(olds), len(news))
+		for i := range n {
+			diff.Moved = append(diff.Moved, HolidayMove{Name: name, From: olds[i].Date, To: news[i].Date})
+		}
+		diff.Removed = append(diff.Removed, olds[n:]...)
+		after[name] = news[n:]
+	}
+	for _, news := range after {
+		diff.Added = append(diff.Added, news...)
+	}
+
+	byDate := func(a, b Holiday) int { return a.Date.Compare(b.Date) }
+	slices.SortFunc(diff.Added, byDate)
+	slices.SortFunc(diff.Removed, byDate)
+	slices.SortFunc(diff.Moved, func(a, b HolidayMove) int { return a.To.Compare(b.To) })
+	return diff
+}
+
+// groupByName groups holidays by name, keeping each group in date order.
+func groupByName(holidays []Holiday) map[string][]Holiday {
+	groups := make(map[string][]Holiday)
+	for _, h := range holidays {
+		groups[h.Name] = append(groups[h.Name], h)
+	}
+	return groups
+}
+
+func sameMonthDay(a, b time.Time) bool {
+	return a.Month() == b.Month() && a.Day() == b.Day()
+}
+
+// CompareYears compares the holidays of two years on the default calendar.
+func CompareYears(y1, y2 int) YearDiff { return defaultCal.CompareYears(y1, y2) }
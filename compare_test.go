@@ -0,0 +1,78 @@
+package jpholiday_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/rabitt1ove/jp-holidays"
+)
+
+func TestCompareYears_Olympics(t *testing.T) {
+	t.Parallel()
+
+	diff := CompareYears(2019, 2020)
+	moves := make(map[string]HolidayMove)
+	for _, m := range diff.Moved {
+		moves[m.Name] = m
+	}
+	if m, ok := moves["海の日"]; !ok || !m.From.Equal(d(2019, time.July, 15)) || !m.To.Equal(d(2020, time.July, 23)) {
+		t.Errorf("海の日 move = %+v, %v", m, ok)
+	}
+	if m, ok := moves["山の日"]; !ok || !m.To.Equal(d(2020, time.August, 10)) {
+		t.Errorf("山の日 move = %+v, %v", m, ok)
+	}
+	if _, ok := moves["元日"]; ok {
+		t.Error("元日 reported as moved")
+	}
+
+	added := make(map[string]bool)
+	for _, h := range diff.Added {
+		added[h.Name] = true
+	}
+	removed := make(map[string]bool)
+	for _, h := range diff.Removed {
+		removed[h.Name] = true
+	}
+	if !added["スポーツの日"] || !removed["体育の日（スポーツの日）"] || !removed["休日（祝日扱い）"] {
+		t.Errorf("Added = %v, Removed = %v", diff.Added, diff.Removed)
+	}
+	if !added["天皇誕生日"] {
+		t.Errorf("天皇誕生日 should be added in 2020: %v", diff.Added)
+	}
+}
+
+func TestCompareYears_Equinox(t *testing.T) {
+	t.Parallel()
+
+	// Both equinoxes fall a day earlier in 2024.
+	diff := CompareYears(2023, 2024)
+	want := map[string]HolidayMove{
+		"春分の日": {Name: "春分の日", From: d(2023, time.March, 21), To: d(2024, time.March, 20)},
+		"秋分の日": {Name: "秋分の日", From: d(2023, time.September, 23), To: d(2024, time.September, 22)},
+	}
+	for _, m := range diff.Moved {
+		if w, ok := want[m.Name]; ok {
+			if m != w {
+				t.Errorf("move = %+v, want %+v", m, w)
+			}
+			delete(want, m.Name)
+		}
+	}
+	if len(want) > 0 {
+		t.Errorf("moves not found: %v", want)
+	}
+	for i := 1; i < len(diff.Moved); i++ {
+		if diff.Moved[i].To.Before(diff.Moved[i-1].To) {
+			t.Errorf("Moved not sorted: %+v", diff.Moved)
+		}
+	}
+}
+
+func TestCompareYears_Same(t *testing.T) {
+	t.Parallel()
+
+	diff := CompareYears(2026, 2026)
+	if diff.Moved != nil || diff.Added != nil || diff.Removed != nil {
+		t.Errorf("CompareYears(2026, 2026) = %+v, want no changes", diff)
+	}
+}
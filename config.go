@@ -0,0 +1,512 @@
+package jpholiday
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// maxConfigRangeDays limits how many days a single configured range may span.
+const maxConfigRangeDays = 366
+
+// Config describes calendar customizations that can be kept in version
+// control and applied with [Calendar.LoadConfig] or [Calendar.ApplyConfig].
+//
+// The YAML schema accepted by LoadConfig mirrors the struct:
+//
+//	# Weekdays treated as non-business days. Omit to keep Saturday and Sunday;
+//	# use [] for none. Accepts English names ("Saturday", "sat") or 日〜土.
+//	weekend: [Saturday, Sunday]
+//
+//	# Single-day custom holidays.
+//	holidays:
+//	  - date: 2025-04-01
+//	    name: 創立記念日
+//
+//	# Consecutive custom holidays, both ends inclusive (at most 366 days).
+//	ranges:
+//	  - from: 2025-12-29
+//	    to: 2026-01-03
+//	    name: 年末年始休暇
+//
+//	# Holidays on the same month and day every year. from and to are
+//	# optional years and default to the years covered by the built-in dataset.
+//	recurring:
+//	  - month: 6
+//	    day: 1
+//	    name: 創立記念日
+//	    from: 2020
+//
+//	# Built-in holidays to suppress (see [Calendar.RemoveHoliday]).
+//	removals:
+//	  - 2025-11-24
+//
+//	# Non-business days that are not holidays (see [Calendar.AddClosure]).
+//	# to is optional and defaults to from.
+//	closures:
+//	  - from: 2025-08-13
+//	    to: 2025-08-15
+//
+//	# Language of built-in holiday names: ja (default) or en.
+//	locale: en
+//
+//	# Predefined closures to start from: national (default), bank, or
+//	# government.
+//	preset: bank
+//
+//	# Enable observances such as お盆 (see [Calendar.SetObservances]).
+//	observances: true
+//
+//	# Prefectural or municipal holidays (see [WithPrefecture]).
+//	regions: [tokyo]
+//
+//	# Newly announced national holidays that extend the dataset (see
+//	# [Calendar.ApplyPatch]).
+//	patch:
+//	  - date: 2028-01-01
+//	    name: 元日
+//
+// Dates use the YYYY-MM-DD format, or the wareki format accepted by
+// [ParseWareki], and are Japanese calendar dates.
+// [NewFromConfig] accepts the same schema as TOML or JSON.
+type Config struct {
+	Weekend     []string          `json:"weekend"`
+	Holidays    []ConfigHoliday   `json:"holidays"`
+	Ranges      []ConfigRange     `json:"ranges"`
+	Recurring   []ConfigRecurring `json:"recurring"`
+	Removals    []string          `json:"removals"`
+	Closures    []ConfigClosure   `json:"closures"`
+	Locale      string            `json:"locale"`
+	Preset      string            `json:"preset"`
+	Observances bool              `json:"observances"`
+	Regions     []string          `json:"regions"`
+	Patch       []ConfigHoliday   `json:"patch"`
+}
+
+// Presets accepted in [Config.Preset].
+const (
+	// PresetNational uses the national holidays only. It is the default.
+	PresetNational = "national"
+
+	// PresetBank adds the bank closures of December 31, January 2, and
+	// January 3 (銀行休業日) for the years covered by the dataset, including
+	// years added later.
+	// Banks are always closed on Saturdays and Sundays, so it also restores
+	// that weekend unless [Config.Weekend] is set. See [NewBankCalendar].
+	PresetBank = "bank"
+
+	// PresetGovernment adds closures for the year-end period of December 29
+	// through January 3 (年末年始, see [YearEndPeriod]) observed by
+	// government offices, for the years covered by the dataset, including
+	// years added later.
+	PresetGovernment = "government"
+)
+
+// ConfigHoliday is a single custom holiday in a [Config].
+type ConfigHoliday struct {
+	Date string `json:"date"` // YYYY-MM-DD
+	Name string `json:"name"`
+}
+
+// ConfigRange is a run of consecutive custom holidays in a [Config].
+type ConfigRange struct {
+	From string `json:"from"` // YYYY-MM-DD, inclusive
+	To   string `json:"to"`   // YYYY-MM-DD, inclusive
+	Name string `json:"name"`
+}
+
+// ConfigClosure is a run of consecutive closures in a [Config].
+type ConfigClosure struct {
+	From string `json:"from"`         // YYYY-MM-DD, inclusive
+	To   string `json:"to,omitempty"` // YYYY-MM-DD, inclusive; defaults to From
+}
+
+// ConfigRecurring is a custom holiday repeated every year in a [Config].
+// A February 29 rule only applies in leap years.
+type ConfigRecurring struct {
+	Month int    `json:"month"`
+	Day   int    `json:"day"`
+	Name  string `json:"name"`
+	From  int    `json:"from,omitempty"` // First year; 0 means the dataset's first year.
+	To    int    `json:"to,omitempty"`   // Last year; 0 means the dataset's last year.
+}
+
+// weekdaysByName maps lower-case English names, their three-letter
+// abbreviations, and Japanese single-character names to weekdays.
+var weekdaysByName = func() map[string]time.Weekday {
+	m := make(map[string]time.Weekday, 21)
+	for wd, ja := range weekdayLabels {
+		name := strings.ToLower(time.Weekday(wd).String())
+		m[name] = time.Weekday(wd)
+		m[name[:3]] = time.Weekday(wd)
+		m[ja] = time.Weekday(wd)
+	}
+	return m
+}()
+
+// LoadConfig reads a YAML calendar configuration (see [Config] for the
+// schema) and applies it with [Calendar.ApplyConfig], which lists the
+// supported subset of YAML. Unknown keys are rejected so that typos do not
+// go unnoticed.
+func (c *Calendar) LoadConfig(r io.Reader) error {
+	cfg, err := parseConfig(r)
+	if err != nil {
+		return err
+	}
+	return c.ApplyConfig(cfg)
+}
+
+// NewFromConfig creates a Calendar configured from a single TOML or JSON
+// document using the [Config] schema. A document whose first non-blank
+// character is '{' is read as JSON; anything else is read as TOML:
+//
+//	weekend = ["Saturday", "Sunday"]
+//	locale = "en"
+//	preset = "bank"
+//	removals = [2025-11-24]
+//
+//	[[holidays]]
+//	date = 2025-04-01
+//	name = "創立記念日"
+//
+//	[[closures]]
+//	from = 2025-08-13
+//	to = 2025-08-15
+//
+// Unknown keys are rejected. Only a subset of TOML is supported; see
+// [Calendar.ApplyConfig]. See [Calendar.LoadConfig] for YAML.
+func NewFromConfig(r io.Reader) (*Calendar, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("config: %w", err)
+	}
+
+	var cfg Config
+	if trimmed := bytes.TrimSpace(b); len(trimmed) > 0 && trimmed[0] == '{' {
+		cfg, err = decodeConfigJSON(trimmed)
+	} else {
+		var v map[string]any
+		if v, err = parseTOML(bytes.NewReader(b)); err != nil {
+			return nil, fmt.Errorf("config: %w", err)
+		}
+		cfg, err = decodeConfig(v)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	c := New()
+	if err := c.ApplyConfig(cfg); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// LoadConfigFile creates a Calendar configured from the file at path,
+// choosing the format by its extension: files ending in .toml or .json are
+// read with [NewFromConfig], and anything else as YAML with
+// [Calendar.LoadConfig].
+func LoadConfigFile(path string) (*Calendar, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".toml", ".json":
+		return NewFromConfig(f)
+	}
+	c := New()
+	if err := c.LoadConfig(f); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// errUnsupportedSyntax is wrapped by YAML and TOML errors for constructs
+// outside the subset documented on [Calendar.ApplyConfig].
+var errUnsupportedSyntax = errors.New("is not supported (see Calendar.ApplyConfig for the supported YAML and TOML)")
+
+// parseConfig decodes a YAML document into a Config.
+func parseConfig(r io.Reader) (Config, error) {
+	v, err := parseYAML(r)
+	if err != nil {
+		return Config{}, fmt.Errorf("config: %w", err)
+	}
+	if v == nil {
+		return Config{}, nil
+	}
+	if _, ok := v.(map[string]any); !ok {
+		return Config{}, fmt.Errorf("config: top level must be a mapping")
+	}
+	return decodeConfig(v)
+}
+
+// decodeConfig converts generic decoded values into a Config by way of its
+// JSON field names.
+func decodeConfig(v any) (Config, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return Config{}, fmt.Errorf("config: %w", err)
+	}
+	return decodeConfigJSON(b)
+}
+
+func decodeConfigJSON(b []byte) (Config, error) {
+	var cfg Config
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&cfg); err != nil {
+		return Config{}, fmt.Errorf("config: %w", err)
+	}
+	return cfg, nil
+}
+
+// ApplyConfig applies cfg to the calendar. Recurring rules are applied
+// first, then ranges, then single holidays, so more specific entries win on
+// the same date. Custom holidays, removals, closures, and the local holidays
+// of cfg.Regions (see [WithPrefecture]) are added to the calendar's existing
+// state; the weekend is replaced only if cfg.Weekend is non-nil (or reset by
+// [PresetBank]), the locale only if cfg.Locale is set, and observances are
+// enabled if cfg.Observances is set. cfg.Patch extends the dataset as
+// [Calendar.ApplyPatch] does, and recurring rules without years and local
+// holidays cover the extended dataset.
+//
+// [Calendar.LoadConfig], [NewFromConfig], and [LoadConfigFile] read a Config
+// from JSON, or from the subset of YAML and TOML that the schema needs,
+// which the package parses itself to stay free of dependencies:
+//
+//   - YAML: a single document of block mappings and block sequences, flow
+//     sequences of scalars such as [a, b], plain and quoted single-line
+//     scalars, and # comments. Flow mappings ({...}), multi-line scalars
+//     (| and >), anchors, aliases, and tags are rejected.
+//   - TOML: key/value pairs with bare, quoted, and dotted keys, [tables]
+//     and [[arrays of tables]], single-line basic and literal strings,
+//     integers, booleans, local dates, arrays, and inline tables.
+//     Multi-line strings, floats, and times are rejected.
+//
+// Rejected constructs are reported as not supported; write such a config
+// as JSON instead.
+//
+// The whole config is validated before anything is changed: on error the
+// calendar is left untouched.
+func (c *Calendar) ApplyConfig(cfg Config) error {
+	var weekend weekdaySet
+	for i, name := range cfg.Weekend {
+		wd, ok := weekdaysByName[strings.ToLower(strings.TrimSpace(name))]
+		if !ok {
+			return fmt.Errorf("config: weekend[%d]: unknown weekday %q", i, name)
+		}
+		weekend |= 1 << wd
+	}
+
+	regions := make([]yearlyRule, 0, len(cfg.Regions))
+	for i, region := range cfg.Regions {
+		rule, err := localHolidayRule(region)
+		if err != nil {
+			return fmt.Errorf("config: regions[%d]: unknown region %q", i, region)
+		}
+		regions = append(regions, rule)
+	}
+	// Ranges and single holidays are applied over the recurring rules,
+	// which are expanded once the patch has extended the dataset.
+	dated := make(map[date]string)
+	for i, rg := range cfg.Ranges {
+		if err := rg.expand(dated); err != nil {
+			return fmt.Errorf("config: ranges[%d]: %w", i, err)
+		}
+	}
+	for i, h := range cfg.Holidays {
+		d, err := parseConfigDate(h.Date)
+		if err != nil {
+			return fmt.Errorf("config: holidays[%d]: %w", i, err)
+		}
+		if h.Name == "" {
+			return fmt.Errorf("config: holidays[%d]: name is required", i)
+		}
+		dated[d] = h.Name
+	}
+
+	var (
+		closed       []date
+		preset       yearlyRule
+		resetWeekend bool
+	)
+	switch cfg.Preset {
+	case "", PresetNational:
+	case PresetBank:
+		preset = bankClosures
+		resetWeekend = true
+	case PresetGovernment:
+		preset = governmentClosures
+	default:
+		return fmt.Errorf("config: unknown preset %q", cfg.Preset)
+	}
+	for i, cl := range cfg.Closures {
+		to := cl.To
+		if to == "" {
+			to = cl.From
+		}
+		from, end, err := parseConfigSpan(cl.From, to)
+		if err != nil {
+			return fmt.Errorf("config: closures[%d]: %w", i, err)
+		}
+		for d := from; !end.before(d); d = d.addDays(1) {
+			closed = append(closed, d)
+		}
+	}
+
+	if cfg.Locale != "" && !supportedLocale(cfg.Locale) {
+		return fmt.Errorf("config: unsupported locale %q", cfg.Locale)
+	}
+
+	removed := make([]date, 0, len(cfg.Removals))
+	for i, s := range cfg.Removals {
+		d, err := parseConfigDate(s)
+		if err != nil {
+			return fmt.Errorf("config: removals[%d]: %w", i, err)
+		}
+		removed = append(removed, d)
+	}
+
+	return c.tryUpdate(func(s *snapshot) error {
+		// The patch is checked against the dataset under the lock, so that
+		// a concurrent refresh is neither undone nor lost.
+		if len(cfg.Patch) > 0 {
+			patched, err := patchData(s.data(), cfg.Patch)
+			if err != nil {
+				return fmt.Errorf("config: patch: %w", err)
+			}
+			if patched != nil {
+				s.setOfficial(patched)
+			}
+		}
+		if preset != nil {
+			s.addYearly(preset)
+		}
+		for _, rule := range regions {
+			s.addYearly(rule)
+		}
+		custom := make(map[date]string)
+		data := s.data()
+		for i, rec := range cfg.Recurring {
+			if err := rec.expand(custom, data.first.year(), data.last.year()); err != nil {
+				return fmt.Errorf("config: recurring[%d]: %w", i, err)
+			}
+		}
+		maps.Copy(custom, dated)
+		if cfg.Weekend != nil {
+			s.weekend = weekend
+		} else if resetWeekend {
+			s.weekend = defaultWeekend
+		}
+		for d, name := range custom {
+			s.setCustom(d, name)
+		}
+		for _, d := range removed {
+			s.removed[d] = true
+		}
+		for _, d := range closed {
+			s.closed[d] = true
+		}
+		if cfg.Locale != "" {
+			s.locale = cfg.Locale
+		}
+		if cfg.Observances {
+			s.observances = true
+		}
+		return nil
+	})
+}
+
+func (rg ConfigRange) expand(custom map[date]string) error {
+	from, to, err := parseConfigSpan(rg.From, rg.To)
+	if err != nil {
+		return err
+	}
+	if rg.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	for d := from; !to.before(d); d = d.addDays(1) {
+		custom[d] = rg.Name
+	}
+	return nil
+}
+
+// parseConfigSpan parses an inclusive date range of at most
+// maxConfigRangeDays days.
+func parseConfigSpan(fromStr, toStr string) (from, to date, err error) {
+	if from, err = parseConfigDate(fromStr); err != nil {
+		return 0, 0, fmt.Errorf("from: %w", err)
+	}
+	if to, err = parseConfigDate(toStr); err != nil {
+		return 0, 0, fmt.Errorf("to: %w", err)
+	}
+	if to.before(from) {
+		return 0, 0, fmt.Errorf("to %s is before from %s", toStr, fromStr)
+	}
+	if to.toTime().Sub(from.toTime()) >= maxConfigRangeDays*24*time.Hour {
+		return 0, 0, fmt.Errorf("range spans more than %d days", maxConfigRangeDays)
+	}
+	return from, to, nil
+}
+
+// expand adds the dates of rec to custom. A missing From or To defaults to
+// first or last, the years of the dataset.
+func (rec ConfigRecurring) expand(custom map[date]string, first, last int) error {
+	if rec.Month < 1 || rec.Month > 12 {
+		return fmt.Errorf("invalid month %d", rec.Month)
+	}
+	month := time.Month(rec.Month)
+	// Validate against a leap year so that February 29 is accepted.
+	if rec.Day < 1 || rec.Day > time.Date(2000, month+1, 0, 0, 0, 0, 0, time.UTC).Day() {
+		return fmt.Errorf("invalid day %d for %s", rec.Day, month)
+	}
+	if rec.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	from, to := rec.From, rec.To
+	if from == 0 {
+		from = first
+	}
+	if to == 0 {
+		to = last
+	}
+	if from < 1 || to > 9999 || to < from {
+		return fmt.Errorf("invalid year range %d-%d", from, to)
+	}
+	for year := from; year <= to; year++ {
+		d := newDate(year, month, rec.Day)
+		if d.toTime().Month() != month {
+			continue // February 29 in a non-leap year
+		}
+		custom[d] = rec.Name
+	}
+	return nil
+}
+
+// parseConfigDate parses a YYYY-MM-DD or wareki date.
+func parseConfigDate(s string) (date, error) {
+	t, err := time.Parse(time.DateOnly, s)
+	if err != nil {
+		if d, werr := parseWareki(s); werr == nil {
+			return d, nil
+		}
+		return 0, fmt.Errorf("invalid date %q (want YYYY-MM-DD)", s)
+	}
+	return newDate(t.Year(), t.Month(), t.Day()), nil
+}
+
+// LoadConfig reads a YAML calendar configuration into the default calendar.
+func LoadConfig(r io.Reader) error { return defaultCal.LoadConfig(r) }
+
+// ApplyConfig applies a calendar configuration to the default calendar.
+func ApplyConfig(cfg Config) error { return defaultCal.ApplyConfig(cfg) }
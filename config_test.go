@@ -0,0 +1,402 @@
+package jpholiday_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/rabitt1ove/jp-holidays"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	yaml := `# company calendar
+weekend: [Fri, 土]
+
+holidays:
+  - date: 2026-06-15
+    name: 創立記念日   # founded 1999
+  - date: "2026-08-13"
+    name: 'お盆休み'
+
+ranges:
+- from: 2026-12-29
+  to: 2027-01-03
+  name: 年末年始休暇
+
+recurring:
+  - month: 2
+    day: 29
+    name: 閏日
+    from: 2024
+    to: 2028
+  - month: 6
+    day: 15
+    name: 記念日
+
+removals:
+  - 2026-11-23
+`
+	cal := New()
+	if err := cal.LoadConfig(strings.NewReader(yaml)); err != nil {
+		t.Fatalf("LoadConfig error: %v", err)
+	}
+
+	tests := []struct {
+		date time.Time
+		want string
+	}{
+		{d(2026, time.June, 15), "創立記念日"}, // single holiday wins over recurring
+		{d(2027, time.June, 15), "記念日"},
+		{d(2026, time.August, 13), "お盆休み"},
+		{d(2026, time.December, 29), "年末年始休暇"},
+		{d(2027, time.January, 1), "年末年始休暇"}, // overrides 元日
+		{d(2027, time.January, 4), ""},
+		{d(2024, time.February, 29), "閏日"},
+		{d(2028, time.February, 29), "閏日"},
+		{d(2026, time.November, 23), ""}, // 勤労感謝の日 removed
+	}
+	for _, tt := range tests {
+		if got := cal.HolidayName(tt.date); got != tt.want {
+			t.Errorf("HolidayName(%s) = %q, want %q", tt.date.Format("2006-01-02"), got, tt.want)
+		}
+	}
+	if got := cal.HolidaysInMonth(2025, time.March); len(got) != 1 {
+		t.Errorf("no 閏日 expected in 2025, got %v", got)
+	}
+
+	if got := cal.Weekend(); len(got) != 2 || got[0] != time.Friday || got[1] != time.Saturday {
+		t.Errorf("Weekend() = %v, want [Friday Saturday]", got)
+	}
+}
+
+func TestLoadConfig_Empty(t *testing.T) {
+	t.Parallel()
+
+	cal := New()
+	if err := cal.LoadConfig(strings.NewReader("# nothing here\n")); err != nil {
+		t.Fatalf("LoadConfig error: %v", err)
+	}
+	if got := cal.Weekend(); len(got) != 2 {
+		t.Errorf("weekend should be unchanged, got %v", got)
+	}
+}
+
+func TestLoadConfig_EmptyWeekend(t *testing.T) {
+	t.Parallel()
+
+	cal := New()
+	if err := cal.LoadConfig(strings.NewReader("weekend: []\n")); err != nil {
+		t.Fatalf("LoadConfig error: %v", err)
+	}
+	if got := cal.Weekend(); len(got) != 0 {
+		t.Errorf("Weekend() = %v, want empty", got)
+	}
+}
+
+func TestLoadConfig_WarekiDates(t *testing.T) {
+	t.Parallel()
+
+	cal := New()
+	yaml := "holidays:\n  - date: 令和8年6月15日\n    name: 創立記念日\nremovals:\n  - R8.11.23\n"
+	if err := cal.LoadConfig(strings.NewReader(yaml)); err != nil {
+		t.Fatalf("LoadConfig error: %v", err)
+	}
+	if got := cal.HolidayName(d(2026, time.June, 15)); got != "創立記念日" {
+		t.Errorf("HolidayName(2026-06-15) = %q", got)
+	}
+	if cal.IsHoliday(d(2026, time.November, 23)) {
+		t.Error("2026-11-23 should be removed")
+	}
+}
+
+func TestLoadConfig_GovernmentPreset(t *testing.T) {
+	t.Parallel()
+
+	cal := New()
+	if err := cal.LoadConfig(strings.NewReader("preset: government\n")); err != nil {
+		t.Fatalf("LoadConfig error: %v", err)
+	}
+	for _, day := range []time.Time{d(2026, time.December, 29), d(2026, time.December, 31), d(2027, time.January, 3)} {
+		if !cal.IsClosure(day) {
+			t.Errorf("%s should be a closure", day.Format(time.DateOnly))
+		}
+	}
+	if cal.IsClosure(d(2026, time.December, 28)) || cal.IsClosure(d(2026, time.January, 4)) {
+		t.Error("days outside 年末年始 should not be closures")
+	}
+	first, _ := DatasetRange()
+	if cal.IsClosure(first.AddDate(0, 0, -1)) {
+		t.Error("closures should stay within the dataset")
+	}
+}
+
+func TestLoadConfig_Errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		yaml string
+		want string
+	}{
+		{"unknown key", "holiday:\n  - date: 2026-01-05\n", "unknown field"},
+		{"bad date", "holidays:\n  - date: 2026/01/05\n    name: x\n", "holidays[0]"},
+		{"missing name", "holidays:\n  - date: 2026-01-05\n", "name is required"},
+		{"bad weekday", "weekend: [Caturday]\n", "weekend[0]"},
+		{"reversed range", "ranges:\n  - from: 2026-01-05\n    to: 2026-01-01\n    name: x\n", "before"},
+		{"long range", "ranges:\n  - from: 2026-01-01\n    to: 2027-06-01\n    name: x\n", "366"},
+		{"bad month", "recurring:\n  - month: 13\n    day: 1\n    name: x\n", "invalid month"},
+		{"bad day", "recurring:\n  - month: 4\n    day: 31\n    name: x\n", "invalid day"},
+		{"bad years", "recurring:\n  - month: 4\n    day: 1\n    name: x\n    from: 2030\n    to: 2020\n", "year range"},
+		{"bad removal", "removals: [tomorrow]\n", "removals[0]"},
+		{"unknown region", "regions: [atlantis]\n", "regions[0]"},
+		{"patch conflict", "patch:\n  - date: 2026-01-01\n    name: x\n", "patch: holidays[0]"},
+		{"wrong type", "holidays:\n  - date: 2026-01-05\n    name: [a, b]\n", "config"},
+		{"top-level list", "- a\n- b\n", "mapping"},
+		{"bad indentation", "holidays:\n  - date: 2026-01-05\n      name: x\n", "line 3"},
+		{"flow mapping", "holidays: {}\n", "not supported"},
+		{"tab indentation", "holidays:\n\t- date: 2026-01-05\n", "tabs"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := New().LoadConfig(strings.NewReader(tt.yaml))
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("error = %v, want it to contain %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyConfig_Atomic(t *testing.T) {
+	t.Parallel()
+
+	cal := New()
+	err := cal.ApplyConfig(Config{
+		Weekend:  []string{"Monday"},
+		Holidays: []ConfigHoliday{{Date: "2026-06-15", Name: "創立記念日"}},
+		Removals: []string{"invalid"},
+	})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if cal.IsHoliday(d(2026, time.June, 15)) {
+		t.Error("no holidays should be added when the config is invalid")
+	}
+	if got := cal.Weekend(); len(got) != 2 {
+		t.Errorf("weekend should be unchanged, got %v", got)
+	}
+}
+
+func TestApplyConfig_RecurringDefaultsToDataset(t *testing.T) {
+	t.Parallel()
+
+	cal := New()
+	if err := cal.ApplyConfig(Config{
+		Recurring: []ConfigRecurring{{Month: 6, Day: 1, Name: "記念日"}},
+	}); err != nil {
+		t.Fatalf("ApplyConfig error: %v", err)
+	}
+
+	all := New().Holidays()
+	first, last := all[0].Date.Year(), all[len(all)-1].Date.Year()
+	if !cal.IsHoliday(d(first, time.June, 1)) || !cal.IsHoliday(d(last, time.June, 1)) {
+		t.Errorf("recurring holiday should cover %d-%d", first, last)
+	}
+	if cal.IsHoliday(d(first-1, time.June, 1)) || cal.IsHoliday(d(last+1, time.June, 1)) {
+		t.Errorf("recurring holiday should not extend beyond %d-%d", first, last)
+	}
+}
+
+func TestNewFromConfig_TOML(t *testing.T) {
+	t.Parallel()
+
+	src := `# company calendar
+weekend = ["Sat", "Sun"]
+locale = "en"
+preset = "bank"
+removals = [2026-11-23]
+recurring = [
+  { month = 8, day = 14, name = "お盆" },  # every year
+]
+
+[[holidays]]
+date = 2026-06-15
+name = "創立記念日"
+
+[[ranges]]
+from = "2026-12-29"
+to = 2026-12-30
+name = "年末休暇"
+
+[[closures]]
+from = 2026-08-13
+`
+	cal, err := NewFromConfig(strings.NewReader(src))
+	if err != nil {
+		t.Fatalf("NewFromConfig error: %v", err)
+	}
+
+	names := []struct {
+		date time.Time
+		want string
+	}{
+		{d(2026, time.January, 1), "New Year's Day"},
+		{d(2026, time.June, 15), "創立記念日"},
+		{d(2026, time.August, 14), "お盆"},
+		{d(2026, time.December, 30), "年末休暇"},
+		{d(2026, time.November, 23), ""},
+	}
+	for _, tt := range names {
+		if got := cal.HolidayName(tt.date); got != tt.want {
+			t.Errorf("HolidayName(%s) = %q, want %q", tt.date.Format("2006-01-02"), got, tt.want)
+		}
+	}
+
+	for _, closure := range []time.Time{d(2026, time.August, 13), d(2026, time.December, 31), d(2026, time.January, 2)} {
+		if !cal.IsClosure(closure) || cal.IsBusinessDay(closure) {
+			t.Errorf("%s should be a closure", closure.Format("2006-01-02"))
+		}
+		if cal.IsHoliday(closure) {
+			t.Errorf("closure %s should not be a holiday", closure.Format("2006-01-02"))
+		}
+	}
+	if cal.IsClosure(d(2026, time.August, 12)) {
+		t.Error("2026-08-12 should not be a closure")
+	}
+}
+
+func TestNewFromConfig_JSON(t *testing.T) {
+	t.Parallel()
+
+	src := `{
+  "weekend": ["Friday", "Saturday"],
+  "holidays": [{"date": "2026-06-15", "name": "創立記念日"}],
+  "closures": [{"from": "2026-08-13", "to": "2026-08-14"}]
+}`
+	cal, err := NewFromConfig(strings.NewReader(src))
+	if err != nil {
+		t.Fatalf("NewFromConfig error: %v", err)
+	}
+	if got := cal.HolidayName(d(2026, time.June, 15)); got != "創立記念日" {
+		t.Errorf("HolidayName = %q, want 創立記念日", got)
+	}
+	if got := cal.Weekend(); len(got) != 2 || got[0] != time.Friday {
+		t.Errorf("Weekend() = %v, want [Friday Saturday]", got)
+	}
+	if cal.IsBusinessDay(d(2026, time.August, 14)) {
+		t.Error("2026-08-14 should be closed")
+	}
+	if cal.Locale() != LocaleJapanese {
+		t.Errorf("Locale() = %q, want %q", cal.Locale(), LocaleJapanese)
+	}
+}
+
+func TestConfig_UnsupportedSyntax(t *testing.T) {
+	t.Parallel()
+
+	// Constructs outside the YAML and TOML subset point to the documentation.
+	yaml := []string{
+		"holidays: [{date: 2026-01-05, name: x}]\n",
+		"weekend: {saturday: true}\n",
+		"holidays:\n  - date: 2026-01-05\n    name: |\n      x\n",
+		"locale: &lang ja\n",
+		"locale: *lang\n",
+		"locale: !!str ja\n",
+	}
+	for _, src := range yaml {
+		err := New().LoadConfig(strings.NewReader(src))
+		if err == nil || !strings.Contains(err.Error(), "not supported") || !strings.Contains(err.Error(), "Calendar.ApplyConfig") {
+			t.Errorf("LoadConfig(%q) error = %v, want a pointer to Calendar.ApplyConfig", src, err)
+		}
+	}
+	toml := []string{
+		"locale = \"\"\"en\"\"\"\n",
+		"locale = '''en'''\n",
+		"preset = 1.5\n",
+		"[[holidays]]\ndate = 2026-01-05T09:00:00\nname = \"x\"\n",
+	}
+	for _, src := range toml {
+		_, err := NewFromConfig(strings.NewReader(src))
+		if err == nil || !strings.Contains(err.Error(), "not supported") || !strings.Contains(err.Error(), "Calendar.ApplyConfig") {
+			t.Errorf("NewFromConfig(%q) error = %v, want a pointer to Calendar.ApplyConfig", src, err)
+		}
+	}
+}
+
+func TestNewFromConfig_Errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{"json unknown field", `{"weekends": []}`, "unknown field"},
+		{"json syntax", `{"weekend": [}`, "config"},
+		{"toml unknown field", "colour = \"red\"\n", "unknown field"},
+		{"toml syntax", "weekend = [\"Sat\"\n", "line 1"},
+		{"toml float", "preset = 1.5\n", "not supported"},
+		{"toml duplicate key", "locale = \"ja\"\nlocale = \"en\"\n", "defined twice"},
+		{"toml duplicate table", "[a]\n[a]\n", "defined twice"},
+		{"toml multi-line string", "locale = \"\"\"en\"\"\"\n", "not supported"},
+		{"bad locale", "locale = \"fr\"\n", "unsupported locale"},
+		{"bad preset", "preset = \"school\"\n", "unknown preset"},
+		{"bad closure", "[[closures]]\nfrom = 2026-02-30\n", "closures[0]"},
+		{"reversed closure", "[[closures]]\nfrom = 2026-02-03\nto = 2026-02-01\n", "before"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := NewFromConfig(strings.NewReader(tt.src))
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("error = %v, want it to contain %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadConfigFile(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	files := map[string]string{
+		"calendar.yaml": "holidays:\n  - date: 2026-04-01\n    name: 創立記念日\n",
+		"calendar.toml": "[[holidays]]\ndate = 2026-04-01\nname = \"創立記念日\"\n",
+		"calendar.JSON": `{"holidays": [{"date": "2026-04-01", "name": "創立記念日"}]}`,
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		cal, err := LoadConfigFile(path)
+		if err != nil {
+			t.Errorf("LoadConfigFile(%s) error: %v", name, err)
+			continue
+		}
+		if got := cal.HolidayName(d(2026, time.April, 1)); got != "創立記念日" {
+			t.Errorf("LoadConfigFile(%s): HolidayName(2026-04-01) = %q, want 創立記念日", name, got)
+		}
+	}
+
+	if _, err := LoadConfigFile(filepath.Join(dir, "missing.yaml")); err == nil {
+		t.Error("LoadConfigFile(missing.yaml) = nil error, want error")
+	}
+	bad := filepath.Join(dir, "bad.toml")
+	if err := os.WriteFile(bad, []byte("unknown = 1\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadConfigFile(bad); err == nil {
+		t.Error("LoadConfigFile(bad.toml) = nil error, want error")
+	}
+}
@@ -0,0 +1,118 @@
+package jpholiday
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"time"
+)
+
+// HolidaysJPURL is the holidays-jp API (https://holidays-jp.github.io), a
+// community-maintained list of Japanese holidays, as a JSON object mapping
+// "YYYY-MM-DD" dates to names.
+const HolidaysJPURL = "https://holidays-jp.github.io/api/v1/date.json"
+
+// CrossCheckOptions configures [Calendar.CrossCheck].
+type CrossCheckOptions struct {
+	// URL is a JSON object mapping "YYYY-MM-DD" dates to holiday names,
+	// in the format of the holidays-jp API. If empty, HolidaysJPURL is used.
+	URL string
+
+	// Client sends the request. If nil, http.DefaultClient is used.
+	Client *http.Client
+
+	// Names also reports holidays on the same date with different names.
+	// It is off by default because other sources name some holidays
+	// differently, such as substitute holidays, which the Cabinet Office
+	// calls just 休日.
+	Names bool
+}
+
+// Discrepancy is a date on which the calendar's dataset and a secondary
+// source disagree.
+type Discrepancy struct {
+	Date  time.Time // Midnight UTC
+	Name  string    // Name in the dataset, or "" if not a holiday there
+	Other string    // Name in the secondary source, or "" if not a holiday there
+}
+
+// CrossCheck downloads holidays from a secondary public source and reports
+// where they differ from the calendar's dataset, in chronological order.
+// Only whole years covered by both are compared, and custom holidays,
+// removals, and sources are ignored, so the result reflects the national
+// holiday data alone. An empty result means the two agree.
+func (c *Calendar) CrossCheck(ctx context.Context, opts CrossCheckOptions) ([]Discrepancy, error) {
+	url := opts.URL
+	if url == "" {
+		url = HolidaysJPURL
+	}
+	client := opts.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("jpholiday: cross-check: %w", err)
+	}
+	req.Header.Set("User-Agent", "jp-holidays")
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("jpholiday: cross-check: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("jpholiday: cross-check: unexpected status %s", resp.Status)
+	}
+	var raw map[string]string
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxOfficialCSVSize)).Decode(&raw); err != nil {
+		return nil, fmt.Errorf("jpholiday: cross-check: %w", err)
+	}
+	other := make(map[date]string, len(raw))
+	for s, name := range raw {
+		t, err := time.Parse(time.DateOnly, s)
+		if err != nil {
+			return nil, fmt.Errorf("jpholiday: cross-check: invalid date %q", s)
+		}
+		other[newDate(t.Year(), t.Month(), t.Day())] = name
+	}
+	if len(other) == 0 {
+		return nil, fmt.Errorf("jpholiday: cross-check: no holidays from %s", url)
+	}
+	return c.load().data().crossCheck(other, opts.Names), nil
+}
+
+// crossCheck compares data with other over the whole years both cover.
+func (data *holidayData) crossCheck(other map[date]string, names bool) []Discrepancy {
+	otherDates := sortedDates(other)
+	from := max(data.first, newDate(otherDates[0].year(), time.January, 1))
+	to := min(data.last, newDate(otherDates[len(otherDates)-1].year(), time.December, 31))
+
+	var result []Discrepancy
+	add := func(d date, name, otherName string) {
+		result = append(result, Discrepancy{Date: d.toTime(), Name: name, Other: otherName})
+	}
+	for _, d := range data.dates[searchDates(data.dates, from):searchDatesAfter(data.dates, to)] {
+		switch otherName, ok := other[d]; {
+		case !ok:
+			add(d, data.names[d], "")
+		case names && otherName != data.names[d]:
+			add(d, data.names[d], otherName)
+		}
+	}
+	for _, d := range otherDates[searchDates(otherDates, from):searchDatesAfter(otherDates, to)] {
+		if _, ok := data.names[d]; !ok {
+			add(d, "", other[d])
+		}
+	}
+	slices.SortFunc(result, func(a, b Discrepancy) int { return a.Date.Compare(b.Date) })
+	return result
+}
+
+// CrossCheck compares the default calendar's dataset with a secondary public source.
+func CrossCheck(ctx context.Context, opts CrossCheckOptions) ([]Discrepancy, error) {
+	return defaultCal.CrossCheck(ctx, opts)
+}
@@ -0,0 +1,106 @@
+package jpholiday_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/rabitt1ove/jp-holidays"
+)
+
+// serveJSON serves holidays as a holidays-jp style date-to-name object.
+func serveJSON(t *testing.T, holidays []Holiday) *httptest.Server {
+	t.Helper()
+	m := make(map[string]string, len(holidays))
+	for _, h := range holidays {
+		m[h.Date.Format(time.DateOnly)] = h.Name
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(m)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestCrossCheck(t *testing.T) {
+	t.Parallel()
+
+	// The secondary source covers 2025-2026, misses 海の日 2026, has an
+	// extra day, and names the 2026 substitute holiday differently.
+	var other []Holiday
+	for _, h := range HolidaysBetween(d(2025, time.January, 1), d(2026, time.December, 31)) {
+		switch {
+		case h.Date.Equal(d(2026, time.July, 20)):
+			continue
+		case h.Date.Equal(d(2026, time.May, 6)):
+			h.Name = "憲法記念日 振替休日"
+		}
+		other = append(other, h)
+	}
+	other = append(other, Holiday{Date: d(2026, time.June, 1), Name: "謎の日"})
+	srv := serveJSON(t, other)
+
+	cal := New()
+	cal.AddCustomHoliday(d(2026, time.June, 15), "創立記念日")
+	got, err := cal.CrossCheck(context.Background(), CrossCheckOptions{URL: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Discrepancy{
+		{Date: d(2026, time.June, 1), Other: "謎の日"},
+		{Date: d(2026, time.July, 20), Name: "海の日"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("CrossCheck = %v, want %v", got, want)
+	}
+	for i := range want {
+		if !got[i].Date.Equal(want[i].Date) || got[i].Name != want[i].Name || got[i].Other != want[i].Other {
+			t.Errorf("CrossCheck[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+
+	got, err = cal.CrossCheck(context.Background(), CrossCheckOptions{URL: srv.URL, Names: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 3 || got[0].Other != "憲法記念日 振替休日" || got[0].Name != "休日" {
+		t.Errorf("CrossCheck with names = %+v", got)
+	}
+}
+
+func TestCrossCheck_Agree(t *testing.T) {
+	t.Parallel()
+
+	srv := serveJSON(t, HolidaysInYear(2026))
+	got, err := New().CrossCheck(context.Background(), CrossCheckOptions{URL: srv.URL, Names: true})
+	if err != nil || got != nil {
+		t.Errorf("CrossCheck = %v, %v; want no discrepancies", got, err)
+	}
+}
+
+func TestCrossCheck_Errors(t *testing.T) {
+	t.Parallel()
+
+	for name, body := range map[string]string{
+		"not json": "<html>",
+		"bad date": `{"2026/01/01": "元日"}`,
+		"empty":    `{}`,
+	} {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(body))
+		}))
+		if _, err := New().CrossCheck(context.Background(), CrossCheckOptions{URL: srv.URL}); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+		srv.Close()
+	}
+
+	srv := httptest.NewServer(http.NotFoundHandler())
+	defer srv.Close()
+	if _, err := New().CrossCheck(context.Background(), CrossCheckOptions{URL: srv.URL}); err == nil {
+		t.Error("404: expected error")
+	}
+}
@@ -0,0 +1,80 @@
+package jpholiday
+
+import (
+	"encoding/csv"
+	"io"
+	"time"
+)
+
+// csvHeader is the header row of the Cabinet Office holiday CSV.
+var csvHeader = []string{"国民の祝日・休日月日", "国民の祝日・休日名称"}
+
+// CSVOptions configures [Calendar.ExportCSV].
+type CSVOptions struct {
+	// From and To limit the export to holidays in [From, To] inclusive.
+	// A zero value leaves that end of the range open.
+	From, To time.Time
+
+	// Encode, if non-nil, wraps the destination to transcode the UTF-8
+	// output. The official CSV is Shift_JIS, which can be produced with
+	// golang.org/x/text:
+	//
+	//	opts.Encode = func(w io.Writer) io.Writer {
+	//		return transform.NewWriter(w, japanese.ShiftJIS.NewEncoder())
+	//	}
+	//
+	// If the returned writer implements io.Closer, it is closed after the
+	// last row is written.
+	Encode func(io.Writer) io.Writer
+}
+
+// ExportCSV writes the effective holiday set (built-in + custom, minus
+// removed) in the two-column format of the Cabinet Office syukujitsu.csv:
+// a header row followed by "YYYY/M/D,name" rows with CRLF line endings.
+func (c *Calendar) ExportCSV(w io.Writer, opts CSVOptions) (err error) {
+	holidays := c.exportRange(opts.From, opts.To)
+
+	out := w
+	if opts.Encode != nil {
+		out = opts.Encode(w)
+		if closer, ok := out.(io.Closer); ok {
+			defer func() {
+				if cerr := closer.Close(); err == nil {
+					err = cerr
+				}
+			}()
+		}
+	}
+
+	cw := csv.NewWriter(out)
+	cw.UseCRLF = true
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, h := range holidays {
+		if err := cw.Write([]string{h.Date.Format("2006/1/2"), h.Name}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// exportRange returns the effective holidays in [from, to] for export. A
+// zero time leaves that end of the range open.
+func (c *Calendar) exportRange(fromT, toT time.Time) []Holiday {
+	from, to := minDate, maxDate
+	if !fromT.IsZero() {
+		from = dateFromTime(fromT)
+	}
+	if !toT.IsZero() {
+		to = dateFromTime(toT)
+	}
+	if to.before(from) {
+		return nil
+	}
+	return c.holidaysInRange(from, to)
+}
+
+// ExportCSV writes the default calendar's holidays in the Cabinet Office CSV format.
+func ExportCSV(w io.Writer, opts CSVOptions) error { return defaultCal.ExportCSV(w, opts) }
@@ -0,0 +1,108 @@
+package jpholiday_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/rabitt1ove/jp-holidays"
+)
+
+func TestExportCSV_Range(t *testing.T) {
+	t.Parallel()
+
+	cal := New()
+	cal.AddCustomHoliday(d(2026, time.January, 5), "仕事始め休業")
+	cal.RemoveHoliday(d(2026, time.January, 12))
+
+	var b strings.Builder
+	err := cal.ExportCSV(&b, CSVOptions{From: d(2026, time.January, 1), To: d(2026, time.January, 31)})
+	if err != nil {
+		t.Fatalf("ExportCSV error: %v", err)
+	}
+
+	want := "国民の祝日・休日月日,国民の祝日・休日名称\r\n" +
+		"2026/1/1,元日\r\n" +
+		"2026/1/5,仕事始め休業\r\n"
+	if got := b.String(); got != want {
+		t.Errorf("ExportCSV =\n%q\nwant\n%q", got, want)
+	}
+}
+
+func TestExportCSV_All(t *testing.T) {
+	t.Parallel()
+
+	var b strings.Builder
+	if err := New().ExportCSV(&b, CSVOptions{}); err != nil {
+		t.Fatalf("ExportCSV error: %v", err)
+	}
+	lines := strings.Split(strings.TrimSuffix(b.String(), "\r\n"), "\r\n")
+	all := New().Holidays()
+	if got, want := len(lines)-1, len(all); got != want {
+		t.Errorf("exported %d rows, want %d", got, want)
+	}
+	if want := all[0].Date.Format("2006/1/2") + "," + all[0].Name; lines[1] != want {
+		t.Errorf("first row = %q, want %q", lines[1], want)
+	}
+}
+
+func TestExportCSV_OpenEndedAndReversedRange(t *testing.T) {
+	t.Parallel()
+
+	var b strings.Builder
+	if err := New().ExportCSV(&b, CSVOptions{From: d(2027, time.November, 1)}); err != nil {
+		t.Fatalf("ExportCSV error: %v", err)
+	}
+	if !strings.Contains(b.String(), "2027/11/23,勤労感謝の日") || strings.Contains(b.String(), "2027/10/11") {
+		t.Errorf("open-ended range output unexpected:\n%s", b.String())
+	}
+
+	b.Reset()
+	if err := New().ExportCSV(&b, CSVOptions{From: d(2027, time.January, 2), To: d(2027, time.January, 1)}); err != nil {
+		t.Fatalf("ExportCSV error: %v", err)
+	}
+	if got := strings.Count(b.String(), "\r\n"); got != 1 {
+		t.Errorf("reversed range should export only the header, got %d lines", got)
+	}
+}
+
+type upperCloser struct {
+	w      io.Writer
+	closed bool
+	err    error
+}
+
+func (u *upperCloser) Write(p []byte) (int, error) { return u.w.Write(bytes.ToUpper(p)) }
+func (u *upperCloser) Close() error                { u.closed = true; return u.err }
+
+func TestExportCSV_Encode(t *testing.T) {
+	t.Parallel()
+
+	cal := New()
+	cal.AddCustomHoliday(d(2026, time.June, 15), "founding day")
+
+	var b strings.Builder
+	enc := &upperCloser{w: &b}
+	opts := CSVOptions{
+		From:   d(2026, time.June, 1),
+		To:     d(2026, time.June, 30),
+		Encode: func(w io.Writer) io.Writer { return enc },
+	}
+	if err := cal.ExportCSV(&b, opts); err != nil {
+		t.Fatalf("ExportCSV error: %v", err)
+	}
+	if !enc.closed {
+		t.Error("encoder should be closed after export")
+	}
+	if !strings.Contains(b.String(), "2026/6/15,FOUNDING DAY") {
+		t.Errorf("output not passed through encoder:\n%s", b.String())
+	}
+
+	enc = &upperCloser{w: io.Discard, err: errors.New("flush failed")}
+	if err := cal.ExportCSV(io.Discard, opts); err == nil || err.Error() != "flush failed" {
+		t.Errorf("ExportCSV error = %v, want close error", err)
+	}
+}
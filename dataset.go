@@ -0,0 +1,89 @@
+package jpholiday
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+// DatasetMetadata identifies the revision of the built-in holiday dataset.
+type DatasetMetadata struct {
+	SourceURL string    // URL the Cabinet Office CSV was downloaded from.
+	FetchedAt time.Time // When the CSV was fetched (UTC). Zero if unknown.
+	SHA256    string    // Hex-encoded SHA-256 of the raw CSV bytes. Empty if unknown.
+	Rows      int       // Number of holiday rows in the dataset.
+}
+
+// DatasetInfo returns metadata describing the default calendar's holiday
+// dataset: the built-in one, as recorded by cmd/genholidays when the data
+// was generated, unless refreshed with [RefreshFromOfficialSource].
+func DatasetInfo() DatasetMetadata { return defaultCal.DatasetInfo() }
+
+// DatasetRange returns the first and last dates covered by the default
+// calendar's dataset: January 1 of its first year through December 31 of
+// its last, as the Cabinet Office publishes whole years. Outside this
+// range, dates are holidays only if added as custom holidays.
+//
+// Building with the jpholiday_recent tag compiles only the holidays from
+// 2000 onward, and the range starts there.
+func DatasetRange() (first, last time.Time) { return defaultCal.DatasetRange() }
+
+// VerifyDataIntegrity recomputes the SHA-256 checksum of the built-in
+// holiday table and compares it with the checksum recorded by
+// cmd/genholidays when the table was generated. It returns an error if the
+// table was modified after generation, for example by hand-editing
+// holidays_data.go, and can be called at startup where calendar data must
+// be shown to be unaltered. It checks the compiled-in data only, not data
+// loaded with [RefreshFromOfficialSource] or [ApplyPatch].
+func VerifyDataIntegrity() error {
+	names := builtin().names
+	if got := tableChecksum(names); got != builtinChecksum {
+		return fmt.Errorf("jpholiday: built-in holiday table checksum %s does not match generated checksum %s", got, builtinChecksum)
+	}
+	if len(names) != builtinDataset.Rows {
+		return fmt.Errorf("jpholiday: built-in holiday table has %d rows, generated with %d", len(names), builtinDataset.Rows)
+	}
+	return nil
+}
+
+// tableChecksum returns the hex-encoded SHA-256 of names, hashed as one
+// "YYYYMMDD\tname\n" line per holiday in chronological order, as written
+// by cmd/genholidays.
+func tableChecksum(names map[date]string) string {
+	h := sha256.New()
+	for _, d := range sortedDates(names) {
+		fmt.Fprintf(h, "%08d\t%s\n", int(d), names[d])
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// DatasetVersion identifies the revision of the calendar's holiday dataset
+// for logging and monitoring: the first 12 hex digits of its table
+// checksum, which for the compiled-in data is the one written by
+// cmd/genholidays (see [VerifyDataIntegrity]). It changes whenever a
+// holiday is added, removed, or renamed, including by
+// [Calendar.ApplyPatch] or [Calendar.RefreshFromOfficialSource], and not
+// when the same data is merely regenerated or downloaded again. The
+// jpholiday_csv build of the same data has the same version; the trimmed
+// jpholiday_recent build has its own.
+func (c *Calendar) DatasetVersion() string {
+	s := c.load()
+	if s.official == nil {
+		return builtinChecksum[:12]
+	}
+	return tableChecksum(s.official.names)[:12]
+}
+
+// DatasetVersion identifies the revision of the default calendar's holiday
+// dataset: the compiled-in data unless patched or refreshed.
+func DatasetVersion() string { return defaultCal.DatasetVersion() }
+
+// DatasetSourceURL returns the URL from which cmd/genholidays downloaded
+// the compiled-in holiday data.
+func DatasetSourceURL() string { return builtinDataset.SourceURL }
+
+// DatasetGeneratedAt returns when cmd/genholidays fetched the Cabinet Office
+// CSV and generated the compiled-in holiday data (UTC), or the zero time if
+// it was not recorded.
+func DatasetGeneratedAt() time.Time { return builtinDataset.FetchedAt }
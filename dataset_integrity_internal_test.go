@@ -0,0 +1,37 @@
+package jpholiday
+
+import (
+	"maps"
+	"testing"
+	"time"
+)
+
+func TestVerifyDataIntegrity(t *testing.T) {
+	if err := VerifyDataIntegrity(); err != nil {
+		t.Fatal(err)
+	}
+
+	d := newDate(2026, time.July, 20)
+	tampered := maps.Clone(builtin().names)
+	tampered[d] = "山の日"
+	if tableChecksum(tampered) == builtinChecksum {
+		t.Error("renaming a holiday should change the checksum")
+	}
+	delete(tampered, d)
+	if tableChecksum(tampered) == builtinChecksum {
+		t.Error("removing a holiday should change the checksum")
+	}
+}
+
+func TestTableChecksum(t *testing.T) {
+	// cmd/genholidays writes the same checksum for these holidays; see
+	// TestGenerate_Checksum there.
+	names := map[date]string{
+		newDate(2024, time.May, 3):     "憲法記念日",
+		newDate(2024, time.January, 1): "元日",
+	}
+	const want = "fca1e263bca2c140e8257c223a738360f6dd3066c7b8fb530ed6cb92a5ebfae6"
+	if got := tableChecksum(names); got != want {
+		t.Errorf("tableChecksum = %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,24 @@
+//go:build jpholiday_recent
+
+package jpholiday_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/rabitt1ove/jp-holidays"
+)
+
+func TestDatasetRange_Recent(t *testing.T) {
+	t.Parallel()
+
+	if first, _ := DatasetRange(); !first.Equal(d(2000, time.January, 1)) {
+		t.Errorf("DatasetRange() first = %v, want 2000-01-01", first)
+	}
+	if IsHoliday(d(1999, time.December, 23)) {
+		t.Error("1999-12-23 should not be a holiday in the trimmed dataset")
+	}
+	if name := HolidayName(d(2000, time.January, 10)); name != "成人の日" {
+		t.Errorf("HolidayName(2000-01-10) = %q, want 成人の日", name)
+	}
+}
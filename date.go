@@ -6,41 +6,106 @@ import "time"
 // times to the Japanese calendar date before holiday lookups.
 var jstZone = time.FixedZone("Asia/Tokyo", 9*60*60)
 
-// date is an internal comparable key for map lookups.
+// date is an internal comparable key for map lookups, packed as the decimal
+// number YYYYMMDD (e.g. 20260101). Packed keys hash and compare as plain
+// integers, so lookups and range checks need no field-by-field work, and
+// their numeric order is chronological.
 // Users work with time.Time; this type is not exported.
-type date struct {
-	year  int
-	month time.Month
-	day   int
+type date int
+
+// newDate packs a year, month, and day into a date. It does not normalize
+// out-of-range months or days.
+func newDate(year int, month time.Month, day int) date {
+	return date(year*10000 + int(month)*100 + day)
 }
 
 // dateFromTime converts a time.Time to a date by first normalizing to JST.
 // This ensures that a moment in time always maps to the correct Japanese
 // calendar date regardless of the input timezone.
 func dateFromTime(t time.Time) date {
-	jt := t.In(jstZone)
-	y, m, d := jt.Date()
-	return date{year: y, month: m, day: d}
+	y, m, d := t.In(jstZone).Date()
+	return newDate(y, m, d)
+}
+
+// year returns d's year.
+func (d date) year() int {
+	y, _ := d.split()
+	return y
+}
+
+// split returns d's year and its MMDD remainder. The division floors so
+// that years before 1 decode correctly.
+func (d date) split() (year, mmdd int) {
+	year, mmdd = int(d)/10000, int(d)%10000
+	if mmdd < 0 {
+		year, mmdd = year-1, mmdd+10000
+	}
+	return year, mmdd
+}
+
+// ymd returns d's year, month, and day.
+func (d date) ymd() (int, time.Month, int) {
+	y, mmdd := d.split()
+	return y, time.Month(mmdd / 100), mmdd % 100
 }
 
 func (d date) toTime() time.Time {
-	return time.Date(d.year, d.month, d.day, 0, 0, 0, 0, time.UTC)
+	y, m, day := d.ymd()
+	return time.Date(y, m, day, 0, 0, 0, 0, time.UTC)
 }
 
-func (d date) before(other date) bool {
-	if d.year != other.year {
-		return d.year < other.year
+// addDays returns the date n days after d (n may be negative).
+func (d date) addDays(n int) date {
+	y, m, day := d.toTime().AddDate(0, 0, n).Date()
+	return newDate(y, m, day)
+}
+
+// days returns the number of days from 1970-01-01 to d. It uses integer
+// civil-calendar arithmetic rather than time.Date, as it is on the hot path
+// of business day counting.
+func (d date) days() int {
+	y, m, day := d.ymd()
+	if m <= time.February {
+		y--
 	}
-	if d.month != other.month {
-		return d.month < other.month
+	era := floorDiv(y, 400)
+	yoe := y - era*400                         // [0, 399]
+	doy := (153*((int(m)+9)%12)+2)/5 + day - 1 // [0, 365], from March 1
+	doe := yoe*365 + yoe/4 - yoe/100 + doy     // [0, 146096]
+	return era*146097 + doe - 719468
+}
+
+// dateFromDays is the inverse of date.days.
+func dateFromDays(n int) date {
+	n += 719468
+	era := floorDiv(n, 146097)
+	doe := n - era*146097                                  // [0, 146096]
+	yoe := (doe - doe/1460 + doe/36524 - doe/146096) / 365 // [0, 399]
+	doy := doe - (365*yoe + yoe/4 - yoe/100)               // [0, 365]
+	mp := (5*doy + 2) / 153                                // [0, 11], from March
+	day := doy - (153*mp+2)/5 + 1
+	month := (mp+2)%12 + 1
+	y := yoe + era*400
+	if month <= 2 {
+		y++
 	}
-	return d.day < other.day
+	return newDate(y, time.Month(month), day)
 }
 
-func (d date) after(other date) bool {
-	return other.before(d)
+func floorDiv(a, b int) int {
+	q := a / b
+	if a%b < 0 {
+		q--
+	}
+	return q
 }
 
-func (d date) inRange(from, to date) bool {
-	return !d.before(from) && !to.before(d)
+func (d date) weekday() time.Weekday {
+	return time.Weekday((d.days()%7 + 11) % 7) // 1970-01-01 was a Thursday
 }
+
+func (d date) before(other date) bool { return d < other }
+
+func (d date) after(other date) bool { return d > other }
+
+func (d date) inRange(from, to date) bool { return from <= d && d <= to }
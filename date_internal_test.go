@@ -12,7 +12,7 @@ func d(year int, month time.Month, day int) time.Time {
 func TestDateBefore_EqualDates(t *testing.T) {
 	t.Parallel()
 
-	d1 := date{year: 2026, month: time.January, day: 1}
+	d1 := newDate(2026, time.January, 1)
 	if d1.before(d1) {
 		t.Error("equal dates: d.before(d) should be false")
 	}
@@ -24,8 +24,8 @@ func TestDateBefore_EqualDates(t *testing.T) {
 func TestDateBefore_SameYearSameMonth(t *testing.T) {
 	t.Parallel()
 
-	d1 := date{year: 2026, month: time.January, day: 1}
-	d2 := date{year: 2026, month: time.January, day: 15}
+	d1 := newDate(2026, time.January, 1)
+	d2 := newDate(2026, time.January, 15)
 	if !d1.before(d2) {
 		t.Error("Jan 1 should be before Jan 15")
 	}
@@ -37,8 +37,8 @@ func TestDateBefore_SameYearSameMonth(t *testing.T) {
 func TestDateBefore_SameYearDifferentMonth(t *testing.T) {
 	t.Parallel()
 
-	d1 := date{year: 2026, month: time.January, day: 31}
-	d2 := date{year: 2026, month: time.February, day: 1}
+	d1 := newDate(2026, time.January, 31)
+	d2 := newDate(2026, time.February, 1)
 	if !d1.before(d2) {
 		t.Error("Jan 31 should be before Feb 1")
 	}
@@ -47,8 +47,8 @@ func TestDateBefore_SameYearDifferentMonth(t *testing.T) {
 func TestDateBefore_DifferentYear(t *testing.T) {
 	t.Parallel()
 
-	d1 := date{year: 2025, month: time.December, day: 31}
-	d2 := date{year: 2026, month: time.January, day: 1}
+	d1 := newDate(2025, time.December, 31)
+	d2 := newDate(2026, time.January, 1)
 	if !d1.before(d2) {
 		t.Error("2025-12-31 should be before 2026-01-01")
 	}
@@ -57,8 +57,8 @@ func TestDateBefore_DifferentYear(t *testing.T) {
 func TestDateInRange_Boundaries(t *testing.T) {
 	t.Parallel()
 
-	from := date{year: 2026, month: time.January, day: 1}
-	to := date{year: 2026, month: time.January, day: 31}
+	from := newDate(2026, time.January, 1)
+	to := newDate(2026, time.January, 31)
 
 	if !from.inRange(from, to) {
 		t.Error("from date should be in range (inclusive)")
@@ -67,8 +67,8 @@ func TestDateInRange_Boundaries(t *testing.T) {
 		t.Error("to date should be in range (inclusive)")
 	}
 
-	beforeFrom := date{year: 2025, month: time.December, day: 31}
-	afterTo := date{year: 2026, month: time.February, day: 1}
+	beforeFrom := newDate(2025, time.December, 31)
+	afterTo := newDate(2026, time.February, 1)
 	if beforeFrom.inRange(from, to) {
 		t.Error("day before from should not be in range")
 	}
@@ -76,3 +76,45 @@ func TestDateInRange_Boundaries(t *testing.T) {
 		t.Error("day after to should not be in range")
 	}
 }
+
+func TestDateAddDays(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		from date
+		n    int
+		want date
+	}{
+		{newDate(2024, time.February, 28), 1, newDate(2024, time.February, 29)},
+		{newDate(2024, time.December, 31), 1, newDate(2025, time.January, 1)},
+		{newDate(2025, time.March, 1), -1, newDate(2025, time.February, 28)},
+		{newDate(2025, time.March, 1), 0, newDate(2025, time.March, 1)},
+	}
+	for _, tt := range tests {
+		if got := tt.from.addDays(tt.n); got != tt.want {
+			t.Errorf("%v.addDays(%d) = %v, want %v", tt.from, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestDateDays_MatchesTime(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(-1, time.January, 1, 0, 0, 0, 0, time.UTC)
+	for tm := start; tm.Year() <= 2400; tm = tm.AddDate(0, 0, 13) {
+		d := newDate(tm.Year(), tm.Month(), tm.Day())
+		n := int(tm.Unix() / (24 * 60 * 60))
+		if got := d.days(); got != n {
+			t.Fatalf("%v.days() = %d, want %d", d, got, n)
+		}
+		if got := dateFromDays(n); got != d {
+			t.Fatalf("dateFromDays(%d) = %v, want %v", n, got, d)
+		}
+		if got := d.weekday(); got != tm.Weekday() {
+			t.Fatalf("%v.weekday() = %v, want %v", d, got, tm.Weekday())
+		}
+		if got := d.toTime(); !got.Equal(tm) {
+			t.Fatalf("%v.toTime() = %v, want %v", d, got, tm)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package jpholiday
+
+import "time"
+
+// DayCount is a day-count convention for interest accrual, used by
+// [Calendar.AccrualDays] and [Calendar.YearFraction].
+type DayCount int
+
+// Day-count conventions.
+const (
+	// DayCountActual365 counts calendar days over a year of 365 days
+	// (Actual/365 Fixed), the convention for yen deposits and loans.
+	DayCountActual365 DayCount = iota
+
+	// DayCountActual360 counts calendar days over a year of 360 days
+	// (Actual/360), as for foreign-currency money markets.
+	DayCountActual360
+
+	// DayCountBusiness252 counts business days (営業日ベース) over a year of
+	// 252 business days (Business/252).
+	DayCountBusiness252
+)
+
+// basis returns the number of days in a year under dc.
+func (dc DayCount) basis() float64 {
+	switch dc {
+	case DayCountActual360:
+		return 360
+	case DayCountBusiness252:
+		return 252
+	default:
+		return 365
+	}
+}
+
+// AccrualDays returns the number of days that accrue interest from one date
+// to another (interpreted in JST) under dc. The period includes from and
+// excludes to, so consecutive periods never count a day twice. Under
+// [DayCountBusiness252] only the calendar's business days are counted. The
+// result is negative if to is before from.
+func (c *Calendar) AccrualDays(from, to time.Time, dc DayCount) int {
+	fromD, toD := dateFromTime(from), dateFromTime(to)
+	if toD.before(fromD) {
+		return -c.AccrualDays(to, from, dc)
+	}
+	if dc != DayCountBusiness252 {
+		return toD.days() - fromD.days()
+	}
+	if toD == fromD {
+		return 0
+	}
+	return c.BusinessDaysBetween(fromD.toTime(), toD.addDays(-1).toTime())
+}
+
+// YearFraction returns the length of the period from one date to another
+// (interpreted in JST) in years under dc: [Calendar.AccrualDays] divided by
+// the convention's days per year. Multiply by an annual rate and a
+// principal to get the interest accrued over the period.
+func (c *Calendar) YearFraction(from, to time.Time, dc DayCount) float64 {
+	return float64(c.AccrualDays(from, to, dc)) / dc.basis()
+}
+
+// AccrualDays returns the days accrued under dc on the default calendar.
+func AccrualDays(from, to time.Time, dc DayCount) int {
+	return defaultCal.AccrualDays(from, to, dc)
+}
+
+// YearFraction returns the period in years under dc on the default calendar.
+func YearFraction(from, to time.Time, dc DayCount) float64 {
+	return defaultCal.YearFraction(from, to, dc)
+}
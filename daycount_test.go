@@ -0,0 +1,63 @@
+package jpholiday_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/rabitt1ove/jp-holidays"
+)
+
+func TestAccrualDays(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		from, to time.Time
+		dc       DayCount
+		want     int
+	}{
+		{"act/365 half year", d(2026, time.April, 1), d(2026, time.October, 1), DayCountActual365, 183},
+		{"act/360 same days", d(2026, time.April, 1), d(2026, time.October, 1), DayCountActual360, 183},
+		{"same day", d(2026, time.April, 1), d(2026, time.April, 1), DayCountActual365, 0},
+		{"reversed", d(2026, time.October, 1), d(2026, time.April, 1), DayCountActual365, -183},
+		// 2026-04-27 (Mon) to 05-11 (Mon): 04-29 and 05-04..06 are holidays.
+		{"business Golden Week", d(2026, time.April, 27), d(2026, time.May, 11), DayCountBusiness252, 6},
+		{"business excludes end", d(2026, time.April, 27), d(2026, time.April, 28), DayCountBusiness252, 1},
+		{"business same day", d(2026, time.April, 27), d(2026, time.April, 27), DayCountBusiness252, 0},
+		{"business reversed", d(2026, time.May, 11), d(2026, time.April, 27), DayCountBusiness252, -6},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := AccrualDays(tt.from, tt.to, tt.dc); got != tt.want {
+				t.Errorf("AccrualDays(%s, %s) = %d, want %d", tt.from.Format(time.DateOnly), tt.to.Format(time.DateOnly), got, tt.want)
+			}
+		})
+	}
+}
+
+func TestYearFraction(t *testing.T) {
+	t.Parallel()
+
+	from, to := d(2026, time.April, 1), d(2026, time.October, 1)
+	if got, want := YearFraction(from, to, DayCountActual365), 183.0/365; got != want {
+		t.Errorf("YearFraction(Actual365) = %v, want %v", got, want)
+	}
+	if got, want := YearFraction(from, to, DayCountActual360), 183.0/360; got != want {
+		t.Errorf("YearFraction(Actual360) = %v, want %v", got, want)
+	}
+	bd := BusinessDaysBetween(from, to.AddDate(0, 0, -1))
+	if got, want := YearFraction(from, to, DayCountBusiness252), float64(bd)/252; got != want {
+		t.Errorf("YearFraction(Business252) = %v, want %v", got, want)
+	}
+
+	// Closures reduce business-day accrual but not calendar-day accrual.
+	cal := New()
+	cal.AddClosure(d(2026, time.April, 28))
+	if got := cal.AccrualDays(d(2026, time.April, 27), d(2026, time.May, 11), DayCountBusiness252); got != 5 {
+		t.Errorf("AccrualDays with closure = %d, want 5", got)
+	}
+	if got := cal.AccrualDays(d(2026, time.April, 27), d(2026, time.May, 11), DayCountActual365); got != 14 {
+		t.Errorf("AccrualDays(Actual365) with closure = %d, want 14", got)
+	}
+}
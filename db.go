@@ -0,0 +1,177 @@
+package jpholiday
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"sync"
+)
+
+// LoadCustomFromDB replaces the calendar's custom holidays with the rows of
+// query, so that the instances of a service can share one authoritative
+// table of custom holidays instead of each being configured separately.
+// Each row holds the date, as a DATE or as YYYY-MM-DD text (see
+// [Date.Scan]), and the name:
+//
+//	err := cal.LoadCustomFromDB(ctx, db, "SELECT date, name FROM custom_holidays")
+//
+// args are passed to the query. The result replaces all custom holidays,
+// including those added by other means, so that calling it again picks up
+// rows deleted from the table. Either all rows are loaded or, on an error,
+// none are. Pair it with [Calendar.SyncCustomToDB] to write changes back.
+func (c *Calendar) LoadCustomFromDB(ctx context.Context, db *sql.DB, query string, args ...any) error {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("jpholiday: load custom holidays: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	custom := make(map[date]string)
+	for n := 1; rows.Next(); n++ {
+		var (
+			day  Date
+			name string
+		)
+		if err := rows.Scan(&day, &name); err != nil {
+			return fmt.Errorf("jpholiday: load custom holidays: row %d: %w", n, err)
+		}
+		if name == "" {
+			return fmt.Errorf("jpholiday: load custom holidays: row %d: name is empty", n)
+		}
+		custom[newDate(day.Year, day.Month, day.Day)] = name
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("jpholiday: load custom holidays: %w", err)
+	}
+
+	c.update(func(s *snapshot) {
+		s.custom = make(map[date]string, len(custom))
+		s.customDates = nil
+		for d, name := range custom {
+			s.setCustom(d, name)
+		}
+	})
+	return nil
+}
+
+// DBSyncOptions configures [Calendar.SyncCustomToDB].
+type DBSyncOptions struct {
+	// Upsert stores a custom holiday, given its date (a [Date]) and name
+	// as arguments, for example in PostgreSQL:
+	//
+	//	INSERT INTO custom_holidays (date, name) VALUES ($1, $2)
+	//	ON CONFLICT (date) DO UPDATE SET name = excluded.name
+	//
+	// If empty, added custom holidays are not written.
+	Upsert string
+
+	// Delete deletes a custom holiday, given its date as the argument:
+	//
+	//	DELETE FROM custom_holidays WHERE date = $1
+	//
+	// If empty, removed custom holidays are not deleted.
+	Delete string
+
+	// ErrorLog receives errors from the statements. If nil, the standard
+	// logger is used.
+	ErrorLog *log.Logger
+}
+
+// SyncCustomToDB writes each later call to [Calendar.AddCustomHoliday] or
+// [Calendar.RemoveCustomHoliday] back to db with the statements in opts,
+// until ctx is done or the returned function is called. The changes are
+// queued by a hook registered with [Calendar.OnCustomHolidayChange] and
+// written in order by a separate goroutine, so a slow database holds off
+// neither the change methods nor other changes to the calendar; a failed
+// statement is logged and not retried, and the change stays in effect on
+// the calendar.
+//
+// stop waits for the changes made before it was called to be written.
+// Changes still queued when ctx is done are not written.
+func (c *Calendar) SyncCustomToDB(ctx context.Context, db *sql.DB, opts DBSyncOptions) (stop func()) {
+	logf := log.Printf
+	if opts.ErrorLog != nil {
+		logf = opts.ErrorLog.Printf
+	}
+	write := func(ch CustomHolidayChange) {
+		day := DateOf(ch.Date)
+		var err error
+		switch {
+		case ch.Removed && opts.Delete != "":
+			_, err = db.ExecContext(ctx, opts.Delete, day)
+		case !ch.Removed && opts.Upsert != "":
+			_, err = db.ExecContext(ctx, opts.Upsert, day, ch.Name)
+		}
+		if err != nil {
+			logf("jpholiday: sync custom holiday %s: %v", day, err)
+		}
+	}
+
+	var (
+		mu      sync.Mutex
+		pending []CustomHolidayChange // guarded by mu
+		wake    = make(chan struct{}, 1)
+		quit    = make(chan struct{})
+		done    = make(chan struct{})
+	)
+	remove := c.OnCustomHolidayChange(func(ch CustomHolidayChange) {
+		mu.Lock()
+		pending = append(pending, ch)
+		mu.Unlock()
+		select {
+		case wake <- struct{}{}:
+		default:
+		}
+	})
+	go func() {
+		defer close(done)
+		for {
+			var last bool
+			select {
+			case <-wake:
+			case <-quit:
+				last = true
+			}
+			mu.Lock()
+			batch := pending
+			pending = nil
+			mu.Unlock()
+			for _, ch := range batch {
+				if ctx.Err() != nil {
+					return
+				}
+				write(ch)
+			}
+			if last {
+				return
+			}
+		}
+	}()
+
+	// Once remove returns, no hook is running, so the final batch taken
+	// after quit is closed holds every change made before.
+	var once sync.Once
+	halt := func() {
+		once.Do(func() {
+			remove()
+			close(quit)
+		})
+	}
+	unwatch := context.AfterFunc(ctx, halt)
+	return func() {
+		unwatch()
+		halt()
+		<-done
+	}
+}
+
+// LoadCustomFromDB replaces the custom holidays of the default calendar with the rows of query.
+func LoadCustomFromDB(ctx context.Context, db *sql.DB, query string, args ...any) error {
+	return defaultCal.LoadCustomFromDB(ctx, db, query, args...)
+}
+
+// SyncCustomToDB writes later changes to the custom holidays of the default calendar back to db.
+func SyncCustomToDB(ctx context.Context, db *sql.DB, opts DBSyncOptions) (stop func()) {
+	return defaultCal.SyncCustomToDB(ctx, db, opts)
+}
@@ -0,0 +1,306 @@
+package jpholiday_test
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"log"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/rabitt1ove/jp-holidays"
+)
+
+// fakeDB is the table of a fake database/sql driver: custom holidays keyed
+// by YYYY-MM-DD. It understands the statements SELECT (all rows, by date),
+// UPSERT (date, name), and DELETE (date); NULLROW returns a NULL date,
+// BLOCK upserts once block is closed, and anything else fails.
+type fakeDB struct {
+	mu    sync.Mutex
+	rows  map[string]string
+	block chan struct{}
+}
+
+var (
+	fakeDBsMu sync.Mutex
+	fakeDBs   = map[string]*fakeDB{}
+)
+
+func init() { sql.Register("jpholiday-fake", fakeDriver{}) }
+
+// openFakeDB opens a fresh fake database holding rows.
+func openFakeDB(t *testing.T, rows map[string]string) (*sql.DB, *fakeDB) {
+	t.Helper()
+	f := &fakeDB{rows: maps.Clone(rows), block: make(chan struct{})}
+	if f.rows == nil {
+		f.rows = map[string]string{}
+	}
+	fakeDBsMu.Lock()
+	fakeDBs[t.Name()] = f
+	fakeDBsMu.Unlock()
+	db, err := sql.Open("jpholiday-fake", t.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+	return db, f
+}
+
+func (f *fakeDB) snapshot() map[string]string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return maps.Clone(f.rows)
+}
+
+type fakeDriver struct{}
+
+func (fakeDriver) Open(name string) (driver.Conn, error) {
+	fakeDBsMu.Lock()
+	defer fakeDBsMu.Unlock()
+	return &fakeConn{db: fakeDBs[name]}, nil
+}
+
+type fakeConn struct{ db *fakeDB }
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{db: c.db, query: query}, nil
+}
+func (c *fakeConn) Close() error              { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error) { return nil, errors.New("fake: no transactions") }
+
+type fakeStmt struct {
+	db    *fakeDB
+	query string
+}
+
+func (s *fakeStmt) Close() error  { return nil }
+func (s *fakeStmt) NumInput() int { return -1 }
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	query := s.query
+	if query == "BLOCK" {
+		<-s.db.block
+		query = "UPSERT"
+	}
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+	switch query {
+	case "UPSERT":
+		s.db.rows[args[0].(time.Time).Format(time.DateOnly)] = args[1].(string)
+	case "DELETE":
+		delete(s.db.rows, args[0].(time.Time).Format(time.DateOnly))
+	default:
+		return nil, errors.New("fake: exec failed")
+	}
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+	switch s.query {
+	case "SELECT":
+		var rows [][]driver.Value
+		for _, k := range slices.Sorted(maps.Keys(s.db.rows)) {
+			rows = append(rows, []driver.Value{k, s.db.rows[k]})
+		}
+		return &fakeRows{rows: rows}, nil
+	case "NULLROW":
+		return &fakeRows{rows: [][]driver.Value{{nil, "x"}}}, nil
+	}
+	return nil, errors.New("fake: query failed")
+}
+
+type fakeRows struct{ rows [][]driver.Value }
+
+func (r *fakeRows) Columns() []string { return []string{"date", "name"} }
+func (r *fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+	return nil
+}
+
+func TestLoadCustomFromDB(t *testing.T) {
+	t.Parallel()
+
+	db, _ := openFakeDB(t, map[string]string{
+		"2026-06-01": "創立記念日",
+		"2026-12-28": "年末休暇",
+	})
+	cal := New()
+	cal.AddCustomHoliday(d(2026, time.August, 14), "夏季休暇")
+	if err := cal.LoadCustomFromDB(context.Background(), db, "SELECT"); err != nil {
+		t.Fatal(err)
+	}
+	if got := cal.HolidayName(d(2026, time.June, 1)); got != "創立記念日" {
+		t.Errorf("HolidayName(2026-06-01) = %q, want 創立記念日", got)
+	}
+	if cal.IsHoliday(d(2026, time.August, 14)) {
+		t.Error("custom holiday not in the table survived LoadCustomFromDB")
+	}
+	if got := cal.Stats().CustomHolidays; got != 2 {
+		t.Errorf("custom holidays = %d, want 2", got)
+	}
+	if !cal.IsHoliday(d(2026, time.May, 5)) {
+		t.Error("built-in holiday lost after LoadCustomFromDB")
+	}
+}
+
+func TestLoadCustomFromDB_Error(t *testing.T) {
+	t.Parallel()
+
+	db, _ := openFakeDB(t, nil)
+	cal := New()
+	cal.AddCustomHoliday(d(2026, time.August, 14), "夏季休暇")
+	for _, query := range []string{"BROKEN", "NULLROW"} {
+		if err := cal.LoadCustomFromDB(context.Background(), db, query); err == nil {
+			t.Errorf("LoadCustomFromDB(%q) = nil, want error", query)
+		}
+	}
+	if !cal.IsHoliday(d(2026, time.August, 14)) {
+		t.Error("failed LoadCustomFromDB changed the custom holidays")
+	}
+}
+
+func TestSyncCustomToDB(t *testing.T) {
+	t.Parallel()
+
+	db, table := openFakeDB(t, map[string]string{"2026-06-01": "創立記念日"})
+	cal := New()
+	var logs bytes.Buffer
+	stop := cal.SyncCustomToDB(context.Background(), db, DBSyncOptions{
+		Upsert:   "UPSERT",
+		Delete:   "DELETE",
+		ErrorLog: log.New(&logs, "", 0),
+	})
+
+	// 23:00 UTC is the next day in JST.
+	cal.AddCustomHoliday(time.Date(2026, time.August, 13, 23, 0, 0, 0, time.UTC), "夏季休暇")
+	cal.RemoveCustomHoliday(d(2026, time.June, 1))
+	// stop waits for the queued changes.
+	stop()
+	want := map[string]string{"2026-08-14": "夏季休暇"}
+	if got := table.snapshot(); !maps.Equal(got, want) {
+		t.Errorf("table = %v, want %v", got, want)
+	}
+
+	cal.AddCustomHoliday(d(2026, time.August, 15), "夏季休暇")
+	if got := table.snapshot(); !maps.Equal(got, want) {
+		t.Errorf("table after stop = %v, want %v", got, want)
+	}
+	if logs.Len() != 0 {
+		t.Errorf("unexpected errors logged: %s", logs.String())
+	}
+
+	// Another instance loading the table sees the synced holidays.
+	other := New()
+	if err := other.LoadCustomFromDB(context.Background(), db, "SELECT"); err != nil {
+		t.Fatal(err)
+	}
+	if got := other.HolidayName(d(2026, time.August, 14)); got != "夏季休暇" {
+		t.Errorf("HolidayName(2026-08-14) on another calendar = %q, want 夏季休暇", got)
+	}
+}
+
+func TestSyncCustomToDB_Error(t *testing.T) {
+	t.Parallel()
+
+	db, _ := openFakeDB(t, nil)
+	cal := New()
+	var logs bytes.Buffer
+	stop := cal.SyncCustomToDB(context.Background(), db, DBSyncOptions{Upsert: "BROKEN", ErrorLog: log.New(&logs, "", 0)})
+
+	cal.AddCustomHoliday(d(2026, time.August, 14), "夏季休暇")
+	stop()
+	if !strings.Contains(logs.String(), "jpholiday: sync custom holiday 2026-08-14: fake: exec failed") {
+		t.Errorf("log = %q, want the failed statement", logs.String())
+	}
+	if !cal.IsHoliday(d(2026, time.August, 14)) {
+		t.Error("failed sync undid the change")
+	}
+}
+
+func TestSyncCustomToDB_Blocked(t *testing.T) {
+	t.Parallel()
+
+	db, table := openFakeDB(t, nil)
+	cal := New()
+	stop := cal.SyncCustomToDB(context.Background(), db, DBSyncOptions{Upsert: "BLOCK"})
+
+	// The calendar takes changes while the database is blocked.
+	changed := make(chan struct{})
+	go func() {
+		cal.AddCustomHoliday(d(2026, time.August, 14), "夏季休暇")
+		cal.AddCustomHoliday(d(2026, time.August, 15), "夏季休暇")
+		cal.AddClosure(d(2026, time.December, 29))
+		close(changed)
+	}()
+	select {
+	case <-changed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("changes to the calendar waited for the database")
+	}
+	if !cal.IsHoliday(d(2026, time.August, 15)) {
+		t.Error("change not in effect while the database is blocked")
+	}
+
+	close(table.block)
+	stop()
+	want := map[string]string{"2026-08-14": "夏季休暇", "2026-08-15": "夏季休暇"}
+	if got := table.snapshot(); !maps.Equal(got, want) {
+		t.Errorf("table = %v, want %v", got, want)
+	}
+}
+
+func TestSyncCustomToDB_Canceled(t *testing.T) {
+	t.Parallel()
+
+	db, table := openFakeDB(t, nil)
+	cal := New()
+	ctx, cancel := context.WithCancel(context.Background())
+	stop := cal.SyncCustomToDB(ctx, db, DBSyncOptions{Upsert: "UPSERT"})
+	cancel()
+	stop()
+	cal.AddCustomHoliday(d(2026, time.August, 14), "夏季休暇")
+	if got := table.snapshot(); len(got) != 0 {
+		t.Errorf("table after cancel = %v, want empty", got)
+	}
+}
+
+func TestOnCustomHolidayChange(t *testing.T) {
+	t.Parallel()
+
+	cal := New()
+	var got []CustomHolidayChange
+	remove := cal.OnCustomHolidayChange(func(ch CustomHolidayChange) {
+		if ch.Removed == cal.IsHoliday(ch.Date) {
+			t.Errorf("hook for %+v ran before the change", ch)
+		}
+		got = append(got, ch)
+	})
+	cal.AddCustomHoliday(d(2026, time.August, 14), "夏季休暇")
+	cal.RemoveCustomHoliday(d(2026, time.August, 14))
+	cal.AddClosure(d(2026, time.August, 13))
+	remove()
+	cal.AddCustomHoliday(d(2026, time.August, 15), "夏季休暇")
+
+	want := []CustomHolidayChange{
+		{Date: d(2026, time.August, 14), Name: "夏季休暇"},
+		{Date: d(2026, time.August, 14), Removed: true},
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("changes = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,89 @@
+package jpholiday
+
+import "time"
+
+// DeadlineUnit is the unit of a period counted by [Calendar.Deadline].
+type DeadlineUnit int
+
+// Period units.
+const (
+	DeadlineDays DeadlineUnit = iota
+	DeadlineWeeks
+	DeadlineMonths
+	DeadlineYears
+)
+
+// DeadlineOptions configures [Calendar.Deadline]. The zero value follows
+// the Civil Code (民法) rules for a period counted in days.
+type DeadlineOptions struct {
+	// Unit is the unit of the period. The zero value means days.
+	Unit DeadlineUnit
+
+	// IncludeFirstDay counts the starting day as the first day of the
+	// period (初日算入), as when the period starts at midnight or a statute
+	// says so. By default the starting day is not counted (初日不算入,
+	// 民法第140条).
+	IncludeFirstDay bool
+
+	// NoExtension keeps the last day of the period even if it is not a
+	// business day. By default such a deadline extends to the next business
+	// day (民法第142条).
+	NoExtension bool
+}
+
+// Deadline returns the last day of a period of n units that starts on the
+// given date (interpreted in JST), as used for statutory "within N days"
+// rules. The period is counted under the Civil Code: the starting day is
+// excluded (民法第140条); a period of weeks, months, or years ends on the
+// day before the day corresponding to its first day, or on the last day of
+// the month if there is no corresponding day (第143条); and a deadline that
+// is not a business day extends to the next business day (第142条). opts
+// can change the first and last of these rules.
+//
+// ok is false if n is less than 1, or if the deadline cannot be extended
+// because the calendar has no business day within maxSearchDays days.
+func (c *Calendar) Deadline(start time.Time, n int, opts DeadlineOptions) (deadline time.Time, ok bool) {
+	if n < 1 {
+		return time.Time{}, false
+	}
+	first := dateFromTime(start)
+	if !opts.IncludeFirstDay {
+		first = first.addDays(1)
+	}
+
+	var last date
+	switch opts.Unit {
+	case DeadlineWeeks:
+		last = first.addDays(7*n - 1)
+	case DeadlineMonths:
+		last = lastDayOfMonths(first, n)
+	case DeadlineYears:
+		last = lastDayOfMonths(first, 12*n)
+	default:
+		last = first.addDays(n - 1)
+	}
+
+	if !opts.NoExtension {
+		if last, ok = c.load().searchBusinessDay(last, 1); !ok {
+			return time.Time{}, false
+		}
+	}
+	return last.toTime(), true
+}
+
+// lastDayOfMonths returns the last day of a period of months months
+// starting on first: the day before the corresponding day in the final
+// month, or the last day of that month if it has no corresponding day.
+func lastDayOfMonths(first date, months int) date {
+	y, m, day := first.ymd()
+	end := time.Date(y, m+time.Month(months)+1, 0, 0, 0, 0, 0, time.UTC) // last day of the final month
+	if day > end.Day() {
+		return newDate(end.Year(), end.Month(), end.Day())
+	}
+	return newDate(end.Year(), end.Month(), day).addDays(-1)
+}
+
+// Deadline returns the last day of a period on the default calendar.
+func Deadline(start time.Time, n int, opts DeadlineOptions) (time.Time, bool) {
+	return defaultCal.Deadline(start, n, opts)
+}
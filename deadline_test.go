@@ -0,0 +1,47 @@
+package jpholiday_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/rabitt1ove/jp-holidays"
+)
+
+func TestDeadline(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		start time.Time
+		n     int
+		opts  DeadlineOptions
+		want  time.Time
+	}{
+		{"days", d(2026, time.June, 1), 14, DeadlineOptions{}, d(2026, time.June, 15)},
+		{"first day included", d(2026, time.June, 1), 14, DeadlineOptions{IncludeFirstDay: true}, d(2026, time.June, 15)}, // 06-14 is a Sunday
+		{"extended over a weekend", d(2026, time.June, 6), 7, DeadlineOptions{}, d(2026, time.June, 15)},
+		{"not extended", d(2026, time.June, 6), 7, DeadlineOptions{NoExtension: true}, d(2026, time.June, 13)},
+		{"extended over Golden Week", d(2026, time.April, 28), 5, DeadlineOptions{}, d(2026, time.May, 7)},
+		{"weeks", d(2026, time.June, 10), 2, DeadlineOptions{Unit: DeadlineWeeks}, d(2026, time.June, 24)},
+		{"months", d(2026, time.June, 10), 1, DeadlineOptions{Unit: DeadlineMonths}, d(2026, time.July, 10)},
+		{"months without corresponding day", d(2026, time.January, 30), 1, DeadlineOptions{Unit: DeadlineMonths, NoExtension: true}, d(2026, time.February, 28)},
+		{"months from month end", d(2026, time.January, 31), 1, DeadlineOptions{Unit: DeadlineMonths, NoExtension: true}, d(2026, time.February, 28)},
+		{"months across a year", d(2026, time.November, 15), 3, DeadlineOptions{Unit: DeadlineMonths}, d(2027, time.February, 15)},
+		{"years", d(2026, time.June, 10), 1, DeadlineOptions{Unit: DeadlineYears}, d(2027, time.June, 10)},
+		{"leap day", d(2028, time.February, 28), 1, DeadlineOptions{Unit: DeadlineYears, NoExtension: true}, d(2029, time.February, 28)},
+	}
+	for _, tt := range tests {
+		got, ok := Deadline(tt.start, tt.n, tt.opts)
+		if !ok || !got.Equal(tt.want) {
+			t.Errorf("%s: Deadline(%s, %d) = %s, %v, want %s", tt.name, tt.start.Format(time.DateOnly), tt.n, got.Format(time.DateOnly), ok, tt.want.Format(time.DateOnly))
+		}
+	}
+}
+
+func TestDeadline_Invalid(t *testing.T) {
+	t.Parallel()
+
+	if got, ok := Deadline(d(2026, time.June, 1), 0, DeadlineOptions{}); ok || !got.IsZero() {
+		t.Errorf("Deadline(n = 0) = %s, %v, want zero time and false", got, ok)
+	}
+}
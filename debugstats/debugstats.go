@@ -0,0 +1,89 @@
+// Package debugstats publishes what a running process believes about the
+// holiday calendar: the dataset range and version, the number of holidays in
+// each year, and the numbers of custom holidays, removed holidays, and
+// closures. Publish them under expvar, which serves them with the other
+// variables at /debug/vars:
+//
+//	debugstats.Publish("jpholiday", nil)
+//
+// or mount [Handler] on a debug server of its own:
+//
+//	mux.Handle("/debug/jpholiday", debugstats.Handler(nil))
+//
+// Both report the current state of the calendar on each request, as JSON
+// such as
+//
+//	{
+//	  "dataset_first": "1955-01-01",
+//	  "dataset_last": "2027-12-31",
+//	  "dataset_version": "8c6f05318f7f",
+//	  "holidays_per_year": {"2026": 18, "2027": 17},
+//	  "custom_holidays": 1,
+//	  "removed_holidays": 0,
+//	  "closures": 2
+//	}
+//
+// The counts of holidays include custom holidays and exclude removed ones.
+package debugstats
+
+import (
+	"encoding/json"
+	"expvar"
+	"net/http"
+	"time"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+)
+
+// Stats is the JSON form of [jpholiday.CalendarStats].
+type Stats struct {
+	DatasetFirst    string      `json:"dataset_first"`
+	DatasetLast     string      `json:"dataset_last"`
+	DatasetVersion  string      `json:"dataset_version"`
+	HolidaysPerYear map[int]int `json:"holidays_per_year"`
+	CustomHolidays  int         `json:"custom_holidays"`
+	RemovedHolidays int         `json:"removed_holidays"`
+	Closures        int         `json:"closures"`
+}
+
+// Collect returns the current statistics of cal. If cal is nil, the
+// package-level default calendar is used.
+func Collect(cal *jpholiday.Calendar) Stats {
+	if cal == nil {
+		cal = jpholiday.Default()
+	}
+	s := cal.Stats()
+	return Stats{
+		DatasetFirst:    s.DatasetFirst.Format(time.DateOnly),
+		DatasetLast:     s.DatasetLast.Format(time.DateOnly),
+		DatasetVersion:  cal.DatasetVersion(),
+		HolidaysPerYear: s.HolidaysPerYear,
+		CustomHolidays:  s.CustomHolidays,
+		RemovedHolidays: s.RemovedHolidays,
+		Closures:        s.Closures,
+	}
+}
+
+// Publish publishes the statistics of cal as the expvar variable name.
+// If cal is nil, the package-level default calendar is used. Like
+// expvar.Publish, it panics if name is already in use.
+func Publish(name string, cal *jpholiday.Calendar) {
+	expvar.Publish(name, expvar.Func(func() any { return Collect(cal) }))
+}
+
+// Handler returns a handler that serves the statistics of cal as JSON.
+// If cal is nil, the package-level default calendar is used.
+func Handler(cal *jpholiday.Calendar) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "no-store")
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		_ = enc.Encode(Collect(cal))
+	})
+}
@@ -0,0 +1,105 @@
+package debugstats_test
+
+import (
+	"encoding/json"
+	"expvar"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+	"github.com/rabitt1ove/jp-holidays/debugstats"
+)
+
+var jst = time.FixedZone("Asia/Tokyo", 9*60*60)
+
+func newCalendar() *jpholiday.Calendar {
+	cal := jpholiday.New()
+	cal.AddCustomHoliday(time.Date(2026, time.December, 28, 0, 0, 0, 0, jst), "創立記念日")
+	cal.AddClosure(time.Date(2026, time.December, 29, 0, 0, 0, 0, jst))
+	return cal
+}
+
+func check(t *testing.T, got debugstats.Stats) {
+	t.Helper()
+	first, last := jpholiday.DatasetRange()
+	if got.DatasetFirst != first.Format(time.DateOnly) || got.DatasetLast != last.Format(time.DateOnly) {
+		t.Errorf("dataset = %s to %s, want %v to %v", got.DatasetFirst, got.DatasetLast, first, last)
+	}
+	if got.DatasetVersion != jpholiday.DatasetVersion() {
+		t.Errorf("dataset_version = %q, want %q", got.DatasetVersion, jpholiday.DatasetVersion())
+	}
+	if got.HolidaysPerYear[2026] != 19 || got.CustomHolidays != 1 || got.RemovedHolidays != 0 || got.Closures != 1 {
+		t.Errorf("stats = %+v, want 19 holidays in 2026, 1 custom holiday, and 1 closure", got)
+	}
+}
+
+func TestPublish(t *testing.T) {
+	t.Parallel()
+
+	cal := newCalendar()
+	debugstats.Publish("jpholiday_test", cal)
+	var got debugstats.Stats
+	if err := json.Unmarshal([]byte(expvar.Get("jpholiday_test").String()), &got); err != nil {
+		t.Fatal(err)
+	}
+	check(t, got)
+
+	// The variable reflects later changes.
+	cal.AddClosure(time.Date(2026, time.December, 30, 0, 0, 0, 0, jst))
+	if err := json.Unmarshal([]byte(expvar.Get("jpholiday_test").String()), &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Closures != 2 {
+		t.Errorf("closures after AddClosure = %d, want 2", got.Closures)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	h := debugstats.Handler(newCalendar())
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/jpholiday", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", rec.Code)
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "application/json" {
+		t.Errorf("Content-Type = %q, want application/json", ct)
+	}
+	var got debugstats.Stats
+	if err := json.NewDecoder(rec.Body).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+	check(t, got)
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/debug/jpholiday", nil))
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("POST status = %d, want 405", rec.Code)
+	}
+}
+
+func TestCollect_Default(t *testing.T) {
+	t.Parallel()
+
+	if got := debugstats.Collect(nil); got.HolidaysPerYear[2026] != 18 {
+		t.Errorf("Collect(nil).HolidaysPerYear[2026] = %d, want 18", got.HolidaysPerYear[2026])
+	}
+}
+
+func TestCollect_Patched(t *testing.T) {
+	t.Parallel()
+
+	cal := jpholiday.New()
+	_, last := cal.DatasetRange()
+	next := time.Date(last.Year()+1, time.January, 1, 0, 0, 0, 0, time.UTC).Format(time.DateOnly)
+	if err := cal.ApplyPatch(jpholiday.DatasetPatch{Holidays: []jpholiday.ConfigHoliday{{Date: next, Name: "元日"}}}); err != nil {
+		t.Fatal(err)
+	}
+	got := debugstats.Collect(cal)
+	if got.DatasetVersion != cal.DatasetVersion() || got.DatasetVersion == jpholiday.DatasetVersion() {
+		t.Errorf("dataset_version = %q, want the patched calendar's %q", got.DatasetVersion, cal.DatasetVersion())
+	}
+}
@@ -0,0 +1,59 @@
+package jpholiday
+
+import "time"
+
+// substituteHoliday is the dataset's name for both substitute holidays
+// (振替休日) and citizens' holidays (国民の休日) between two holidays.
+const substituteHoliday = "休日"
+
+// English names for the two kinds of substituteHoliday, used with
+// [LocaleEnglish]. A substitute holiday is named after the holiday it
+// replaces, as in "Substitute Holiday for Constitution Memorial Day".
+const (
+	substituteHolidayPrefix = "Substitute Holiday for "
+	citizensHolidayEnglish  = "Citizens' Holiday"
+)
+
+// EnglishName returns the English name for a built-in Japanese holiday name
+// (e.g., "元日" → "New Year's Day"), or an empty string if no translation
+// is known. Translations are generated alongside the holiday dataset.
+//
+// Every name in the dataset has a translation. For 休日, which depends on
+// the date, EnglishName returns the generic "Holiday"; [Calendar.HolidayName]
+// under [LocaleEnglish] tells substitute holidays ("Substitute Holiday for
+// Constitution Memorial Day") from citizens' holidays ("Citizens' Holiday").
+func EnglishName(name string) string { return builtinEnglishNames[name] }
+
+// englishName returns the English name of the holiday on d, or "" if it is
+// not in data or has no translation.
+func (data *holidayData) englishName(d date) string {
+	name, ok := data.names[d]
+	if !ok {
+		return ""
+	}
+	if name != substituteHoliday {
+		return builtinEnglishNames[name]
+	}
+	if replaced, ok := data.substitutedHoliday(d); ok {
+		if en := builtinEnglishNames[replaced]; en != "" {
+			return substituteHolidayPrefix + en
+		}
+	}
+	return citizensHolidayEnglish
+}
+
+// substitutedHoliday returns the name of the holiday that the 休日 on d
+// substitutes for: the nearest holiday on a Sunday in the run of holidays
+// immediately before d. It reports false for citizens' holidays, which
+// follow no Sunday holiday.
+func (data *holidayData) substitutedHoliday(d date) (string, bool) {
+	for prev := d.addDays(-1); ; prev = prev.addDays(-1) {
+		name, ok := data.names[prev]
+		if !ok {
+			return "", false
+		}
+		if name != substituteHoliday && prev.weekday() == time.Sunday {
+			return name, true
+		}
+	}
+}
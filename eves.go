@@ -0,0 +1,43 @@
+package jpholiday
+
+import "time"
+
+// IsHolidayEve reports whether the day after the given date (interpreted in
+// JST) is a holiday, making the date a holiday eve (祝前日). The date may
+// itself be a holiday, as during Golden Week.
+func (c *Calendar) IsHolidayEve(t time.Time) bool {
+	_, ok := c.lookup(dateFromTime(t).addDays(1))
+	return ok
+}
+
+// IsDayAfterHoliday reports whether the day before the given date
+// (interpreted in JST) is a holiday.
+func (c *Calendar) IsDayAfterHoliday(t time.Time) bool {
+	_, ok := c.lookup(dateFromTime(t).addDays(-1))
+	return ok
+}
+
+// HolidayEves returns the holiday eves (祝前日) in the given year, sorted by
+// date: the day before each holiday, including December 31 when January 1
+// of the next year is a holiday. Dates are at midnight UTC, like
+// [Holiday.Date].
+func (c *Calendar) HolidayEves(year int) []time.Time {
+	holidays := c.holidaysInRange(newDate(year, time.January, 2), newDate(year+1, time.January, 1))
+	if holidays == nil {
+		return nil
+	}
+	eves := make([]time.Time, len(holidays))
+	for i, h := range holidays {
+		eves[i] = h.Date.AddDate(0, 0, -1)
+	}
+	return eves
+}
+
+// IsHolidayEve reports whether the day after the given date is a holiday on the default calendar.
+func IsHolidayEve(t time.Time) bool { return defaultCal.IsHolidayEve(t) }
+
+// IsDayAfterHoliday reports whether the day before the given date is a holiday on the default calendar.
+func IsDayAfterHoliday(t time.Time) bool { return defaultCal.IsDayAfterHoliday(t) }
+
+// HolidayEves returns the default calendar's holiday eves in the given year.
+func HolidayEves(year int) []time.Time { return defaultCal.HolidayEves(year) }
@@ -0,0 +1,56 @@
+package jpholiday_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/rabitt1ove/jp-holidays"
+)
+
+func TestIsHolidayEve(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		date       time.Time
+		eve, after bool
+	}{
+		{d(2026, time.May, 2), true, false}, // before 憲法記念日
+		{d(2026, time.May, 5), true, true},  // こどもの日, before 休日
+		{d(2026, time.May, 7), false, true}, // after 休日
+		{d(2026, time.June, 10), false, false},
+		{d(2026, time.December, 31), true, false}, // before 元日 2027
+		// 2026-01-11 15:00 UTC is 2026-01-12 (成人の日) in JST.
+		{time.Date(2026, time.January, 11, 15, 0, 0, 0, time.UTC), false, false},
+		{time.Date(2026, time.January, 11, 14, 59, 0, 0, time.UTC), true, false},
+	}
+	for _, tt := range tests {
+		if got := IsHolidayEve(tt.date); got != tt.eve {
+			t.Errorf("IsHolidayEve(%s) = %v, want %v", tt.date.Format(time.RFC3339), got, tt.eve)
+		}
+		if got := IsDayAfterHoliday(tt.date); got != tt.after {
+			t.Errorf("IsDayAfterHoliday(%s) = %v, want %v", tt.date.Format(time.RFC3339), got, tt.after)
+		}
+	}
+}
+
+func TestHolidayEves(t *testing.T) {
+	t.Parallel()
+
+	cal := New()
+	cal.AddCustomHoliday(d(2026, time.June, 15), "創立記念日")
+	eves := cal.HolidayEves(2026)
+	if len(eves) != len(cal.HolidaysInYear(2026)) {
+		t.Fatalf("len(HolidayEves(2026)) = %d, want one per holiday in 2026-01-02..2027-01-01", len(eves))
+	}
+	if !eves[0].Equal(d(2026, time.January, 11)) {
+		t.Errorf("first eve = %s, want 2026-01-11", eves[0].Format(time.DateOnly))
+	}
+	if last := eves[len(eves)-1]; !last.Equal(d(2026, time.December, 31)) {
+		t.Errorf("last eve = %s, want 2026-12-31", last.Format(time.DateOnly))
+	}
+	for _, e := range eves {
+		if !cal.IsHolidayEve(e) {
+			t.Errorf("IsHolidayEve(%s) = false", e.Format(time.DateOnly))
+		}
+	}
+}
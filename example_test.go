@@ -70,7 +70,7 @@ func ExampleNew() {
 func ExampleNextBusinessDay() {
 	// 土曜日 → 次の月曜日
 	sat := time.Date(2026, time.June, 6, 0, 0, 0, 0, jst)
-	next := jpholiday.NextBusinessDay(sat)
+	next, _ := jpholiday.NextBusinessDay(sat)
 	fmt.Println(next.Format("2006-01-02"))
 	// Output: 2026-06-08
 }
@@ -0,0 +1,39 @@
+package jpholiday
+
+import "time"
+
+// FiscalYear returns the Japanese fiscal year (年度) containing the given
+// date, interpreted in JST. Fiscal years run from April 1 to March 31 and
+// are named by the year they start in, so 2027-03-31 is in fiscal 2026.
+func FiscalYear(t time.Time) int {
+	y, m, _ := dateFromTime(t).ymd()
+	if m < time.April {
+		return y - 1
+	}
+	return y
+}
+
+// FiscalQuarter returns the quarter (1–4) of the Japanese fiscal year
+// containing the given date, interpreted in JST: April–June is the first
+// quarter and January–March the fourth.
+func FiscalQuarter(t time.Time) int {
+	_, m, _ := dateFromTime(t).ymd()
+	return (int(m)+8)%12/3 + 1
+}
+
+// FiscalYearRange returns the first and last days of the given fiscal year:
+// April 1 of year through March 31 of the next year, at midnight UTC like
+// [Holiday.Date].
+func FiscalYearRange(year int) (first, last time.Time) {
+	return newDate(year, time.April, 1).toTime(), newDate(year+1, time.March, 31).toTime()
+}
+
+// BusinessDaysInFiscalYear returns the number of business days in the given
+// fiscal year (see [Calendar.BusinessDaysBetween]).
+func (c *Calendar) BusinessDaysInFiscalYear(year int) int {
+	return c.BusinessDaysBetween(FiscalYearRange(year))
+}
+
+// BusinessDaysInFiscalYear returns the number of business days in the given
+// fiscal year on the default calendar.
+func BusinessDaysInFiscalYear(year int) int { return defaultCal.BusinessDaysInFiscalYear(year) }
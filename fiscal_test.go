@@ -0,0 +1,61 @@
+package jpholiday_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/rabitt1ove/jp-holidays"
+)
+
+func TestFiscalYearAndQuarter(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		date    time.Time
+		year    int
+		quarter int
+	}{
+		{d(2026, time.April, 1), 2026, 1},
+		{d(2026, time.June, 30), 2026, 1},
+		{d(2026, time.July, 1), 2026, 2},
+		{d(2026, time.October, 1), 2026, 3},
+		{d(2026, time.December, 31), 2026, 3},
+		{d(2027, time.January, 1), 2026, 4},
+		{d(2027, time.March, 31), 2026, 4},
+		// 2026-03-31 15:00 UTC is 2026-04-01 in JST.
+		{time.Date(2026, time.March, 31, 15, 0, 0, 0, time.UTC), 2026, 1},
+	}
+	for _, tt := range tests {
+		if got := FiscalYear(tt.date); got != tt.year {
+			t.Errorf("FiscalYear(%s) = %d, want %d", tt.date.Format(time.RFC3339), got, tt.year)
+		}
+		if got := FiscalQuarter(tt.date); got != tt.quarter {
+			t.Errorf("FiscalQuarter(%s) = %d, want %d", tt.date.Format(time.RFC3339), got, tt.quarter)
+		}
+	}
+}
+
+func TestFiscalYearRange(t *testing.T) {
+	t.Parallel()
+
+	first, last := FiscalYearRange(2026)
+	if !first.Equal(d(2026, time.April, 1)) || !last.Equal(d(2027, time.March, 31)) {
+		t.Errorf("FiscalYearRange(2026) = %s, %s", first.Format(time.DateOnly), last.Format(time.DateOnly))
+	}
+}
+
+func TestBusinessDaysInFiscalYear(t *testing.T) {
+	t.Parallel()
+
+	cal := New()
+	first, last := FiscalYearRange(2025)
+	want := cal.BusinessDaysBetween(first, last)
+	if got := cal.BusinessDaysInFiscalYear(2025); got != want || got < 240 || got > 250 {
+		t.Errorf("BusinessDaysInFiscalYear(2025) = %d, want %d", got, want)
+	}
+
+	cal.AddClosure(d(2025, time.April, 1))
+	if got := cal.BusinessDaysInFiscalYear(2025); got != want-1 {
+		t.Errorf("BusinessDaysInFiscalYear(2025) with a closure = %d, want %d", got, want-1)
+	}
+}
@@ -0,0 +1,80 @@
+package jpholiday
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// weekdayLabels are the Japanese single-character weekday names, indexed by time.Weekday.
+var weekdayLabels = [7]string{"日", "月", "火", "水", "木", "金", "土"}
+
+// WeekdayLabel returns the Japanese single-character name of wd, such as 木
+// for Thursday, as written after dates in 1月1日(木).
+func WeekdayLabel(wd time.Weekday) string { return weekdayLabels[wd] }
+
+// Format returns the holiday formatted according to layout, in which the
+// following tokens are replaced and other text is copied as is:
+//
+//	{date}     2026-01-01
+//	{year}     2026
+//	{month}    1
+//	{day}      1
+//	{wareki}   令和8年1月1日, as by [FormatWareki]
+//	{era}      令和
+//	{eraYear}  8, or 元 for the first year of an era
+//	{weekday}  木, or Thu under LocaleEnglish
+//	{name}     the holiday name in locale
+//
+// For example, h.Format("{wareki}({weekday}) {name}", LocaleJapanese)
+// returns "令和8年1月1日(木) 元日". The wareki tokens are empty for dates
+// before 1873.
+//
+// A built-in holiday is named in locale whatever the locale of the
+// calendar it came from, as [Calendar.SetLocale] would name it; names the
+// default calendar's dataset does not have, such as custom holidays, are
+// used as they are.
+func (h Holiday) Format(layout, locale string) string {
+	d := dateFromTime(h.Date)
+	year, month, day := d.ymd()
+	weekday := weekdayLabels[d.weekday()]
+	if locale == LocaleEnglish {
+		weekday = d.weekday().String()[:3]
+	}
+	era, eraYear, ok := EraYear(h.Date)
+	y := ""
+	switch {
+	case eraYear == 1:
+		y = "元"
+	case ok:
+		y = strconv.Itoa(eraYear)
+	}
+
+	r := strings.NewReplacer(
+		"{date}", d.toTime().Format(time.DateOnly),
+		"{year}", strconv.Itoa(year),
+		"{month}", strconv.Itoa(int(month)),
+		"{day}", strconv.Itoa(day),
+		"{wareki}", FormatWareki(h.Date),
+		"{era}", era,
+		"{eraYear}", y,
+		"{weekday}", weekday,
+		"{name}", defaultCal.load().data().holidayName(d, h.Name, locale),
+	)
+	return r.Replace(layout)
+}
+
+// holidayName returns name, the name of a holiday on d in any locale, in
+// locale if it is the dataset's holiday on d, and unchanged otherwise.
+func (data *holidayData) holidayName(d date, name, locale string) string {
+	ja, ok := data.names[d]
+	if !ok {
+		return name
+	}
+	english := func(string) string { return data.englishName(d) }
+	if name != ja && !slices.ContainsFunc(Locales(), func(l string) bool { return translate(l, ja, english) == name }) {
+		return name
+	}
+	return translate(locale, ja, english)
+}
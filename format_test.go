@@ -0,0 +1,63 @@
+package jpholiday_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/rabitt1ove/jp-holidays"
+)
+
+func TestHolidayFormat(t *testing.T) {
+	t.Parallel()
+
+	newYear := Holiday{Date: d(2026, time.January, 1), Name: "元日"}
+	tests := []struct {
+		holiday Holiday
+		layout  string
+		locale  string
+		want    string
+	}{
+		{newYear, "{wareki}({weekday}) {name}", LocaleJapanese, "令和8年1月1日(木) 元日"},
+		{newYear, "{date} ({weekday}) {name}", LocaleEnglish, "2026-01-01 (Thu) New Year's Day"},
+		{newYear, "{era}{eraYear}年 {year}/{month}/{day}", LocaleJapanese, "令和8年 2026/1/1"},
+		{newYear, "{unknown} {name}", LocaleJapanese, "{unknown} 元日"},
+		{Holiday{Date: d(2019, time.May, 1), Name: "休日（祝日扱い）"}, "{era}{eraYear}年", LocaleJapanese, "令和元年"},
+		// Names from an English calendar are localized back.
+		{Holiday{Date: d(2026, time.May, 6), Name: "Substitute Holiday for Constitution Memorial Day"}, "{name}", LocaleJapanese, "休日"},
+		{Holiday{Date: d(2026, time.May, 6), Name: "休日"}, "{name}", LocaleEnglish, "Substitute Holiday for Constitution Memorial Day"},
+		// Custom names are kept.
+		{Holiday{Date: d(2026, time.January, 1), Name: "年始休業"}, "{name}", LocaleEnglish, "年始休業"},
+		{Holiday{Date: d(2026, time.June, 15), Name: "創立記念日"}, "{month}月{day}日 {name}", LocaleEnglish, "6月15日 創立記念日"},
+		{Holiday{Date: d(1872, time.January, 1), Name: "x"}, "[{wareki}{era}{eraYear}]", LocaleJapanese, "[]"},
+	}
+	for _, tt := range tests {
+		if got := tt.holiday.Format(tt.layout, tt.locale); got != tt.want {
+			t.Errorf("%v.Format(%q, %q) = %q, want %q", tt.holiday, tt.layout, tt.locale, got, tt.want)
+		}
+	}
+}
+
+func TestHolidayFormat_CalendarNames(t *testing.T) {
+	t.Parallel()
+
+	cal := New()
+	if err := cal.SetLocale(LocaleEnglish); err != nil {
+		t.Fatal(err)
+	}
+	for _, h := range cal.HolidaysInYear(2026) {
+		if got, want := h.Format("{name}", LocaleJapanese), New().HolidayName(h.Date); got != want {
+			t.Errorf("%v.Format({name}, ja) = %q, want %q", h, got, want)
+		}
+	}
+}
+
+func TestWeekdayLabel(t *testing.T) {
+	t.Parallel()
+
+	if got := WeekdayLabel(time.Thursday); got != "木" {
+		t.Errorf("WeekdayLabel(Thursday) = %q, want 木", got)
+	}
+	if got := WeekdayLabel(time.Sunday); got != "日" {
+		t.Errorf("WeekdayLabel(Sunday) = %q, want 日", got)
+	}
+}
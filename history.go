@@ -0,0 +1,109 @@
+package jpholiday
+
+import (
+	"strings"
+	"time"
+)
+
+// HolidayChange is one entry in the history of a national holiday: the
+// name and date rule in effect from a given day. See [HolidayHistory].
+type HolidayChange struct {
+	Since time.Time // The first holiday under this name and rule (midnight UTC).
+	Name  string    // The Japanese name of the holiday (e.g., "スポーツの日").
+	Rule  string    // When the holiday falls, in Japanese (e.g., "10月の第2月曜日").
+	Note  string    // Why the name or rule changed, in Japanese.
+}
+
+// Lineages of holidays whose name or date has changed. An entry may belong
+// to several: April 29 was 天皇誕生日 before it became みどりの日, which
+// later moved to May 4.
+const (
+	lineageSports = 1 << iota
+	lineageEmperor
+	lineageShowa
+	lineageGreenery
+)
+
+// holidayChanges is the rename and move history of national holidays,
+// sorted by the first day of each entry.
+var holidayChanges = []struct {
+	since            date
+	name, rule, note string
+	lineages         int
+}{
+	{19480429, "天皇誕生日", "4月29日", "昭和天皇の誕生日", lineageEmperor | lineageShowa},
+	{19661010, "体育の日", "10月10日", "東京オリンピックの開会式を記念して制定", lineageSports},
+	{19890429, "みどりの日", "4月29日", "昭和天皇の崩御に伴い天皇誕生日から改称", lineageShowa | lineageGreenery},
+	{19891223, "天皇誕生日", "12月23日", "明仁天皇（上皇）の誕生日に変更", lineageEmperor},
+	{20001009, "体育の日", "10月の第2月曜日", "ハッピーマンデー制度により月曜日に移動", lineageSports},
+	{20070429, "昭和の日", "4月29日", "みどりの日から改称", lineageShowa},
+	{20070504, "みどりの日", "5月4日", "昭和の日の制定に伴い国民の休日だった5月4日へ移動", lineageGreenery},
+	{20200223, "天皇誕生日", "2月23日", "今上天皇の誕生日に変更（2019年は天皇誕生日なし）", lineageEmperor},
+	{20200724, "スポーツの日", "10月の第2月曜日", "体育の日から改称（2020年は7月24日、2021年は7月23日に移動）", lineageSports},
+}
+
+// holidayAliases are groups of names for the same holiday under different
+// names, which name lookups treat as equivalent.
+var holidayAliases = [][]string{
+	{"体育の日", "体育の日（スポーツの日）", "スポーツの日"},
+}
+
+// HolidayHistory returns the history of the national holiday with the given
+// Japanese or English name, oldest first: each name and date rule it has
+// had, including the holidays it was renamed from or into. For example,
+// the history of スポーツの日 starts with 体育の日 in 1966, and that of
+// 天皇誕生日 explains its moves between April 29, December 23, and February
+// 23. It returns nil for holidays whose name and date have never changed.
+func HolidayHistory(name string) []HolidayChange {
+	names := aliasesOf(name)
+	lineages := 0
+	for _, h := range holidayChanges {
+		if names[h.name] {
+			lineages |= h.lineages
+		}
+	}
+	var history []HolidayChange
+	for _, h := range holidayChanges {
+		if h.lineages&lineages != 0 {
+			history = append(history, HolidayChange{Since: h.since.toTime(), Name: h.name, Rule: h.rule, Note: h.note})
+		}
+	}
+	return history
+}
+
+// aliasesOf returns the set of names equivalent to name: name itself and,
+// if it is in [holidayAliases], the other names of its group, each in
+// Japanese and English. Names given in English also match their Japanese
+// form, and the English names of substitute and citizens' holidays match
+// every 休日.
+func aliasesOf(name string) map[string]bool {
+	names := map[string]bool{name: true}
+	for ja, en := range builtinEnglishNames {
+		if en == name {
+			names[ja] = true
+		}
+	}
+	if name == citizensHolidayEnglish || strings.HasPrefix(name, substituteHolidayPrefix) {
+		names[substituteHoliday] = true
+	}
+	if names[substituteHoliday] {
+		// Under LocaleEnglish, 休日 is named by kind; match all of them.
+		names[citizensHolidayEnglish] = true
+		for _, en := range builtinEnglishNames {
+			names[substituteHolidayPrefix+en] = true
+		}
+	}
+	for _, group := range holidayAliases {
+		for _, alias := range group {
+			if names[alias] {
+				for _, n := range group {
+					names[n] = true
+					names[builtinEnglishNames[n]] = true
+				}
+				break
+			}
+		}
+	}
+	delete(names, "")
+	return names
+}
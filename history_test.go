@@ -0,0 +1,84 @@
+package jpholiday_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/rabitt1ove/jp-holidays"
+)
+
+func TestHolidayHistory(t *testing.T) {
+	t.Parallel()
+
+	names := func(hs []HolidayChange) []string {
+		var ns []string
+		for _, h := range hs {
+			ns = append(ns, h.Since.Format(time.DateOnly)+" "+h.Name)
+		}
+		return ns
+	}
+	tests := []struct {
+		name string
+		want []string
+	}{
+		{"スポーツの日", []string{"1966-10-10 体育の日", "2000-10-09 体育の日", "2020-07-24 スポーツの日"}},
+		{"体育の日", []string{"1966-10-10 体育の日", "2000-10-09 体育の日", "2020-07-24 スポーツの日"}},
+		{"Sports Day", []string{"1966-10-10 体育の日", "2000-10-09 体育の日", "2020-07-24 スポーツの日"}},
+		{"昭和の日", []string{"1948-04-29 天皇誕生日", "1989-04-29 みどりの日", "2007-04-29 昭和の日"}},
+		{"天皇誕生日", []string{"1948-04-29 天皇誕生日", "1989-04-29 みどりの日", "1989-12-23 天皇誕生日", "2007-04-29 昭和の日", "2020-02-23 天皇誕生日"}},
+		{"みどりの日", []string{"1948-04-29 天皇誕生日", "1989-04-29 みどりの日", "2007-04-29 昭和の日", "2007-05-04 みどりの日"}},
+		{"元日", nil},
+	}
+	for _, tt := range tests {
+		got := names(HolidayHistory(tt.name))
+		if len(got) != len(tt.want) {
+			t.Errorf("HolidayHistory(%q) = %v, want %v", tt.name, got, tt.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("HolidayHistory(%q) = %v, want %v", tt.name, got, tt.want)
+				break
+			}
+		}
+	}
+}
+
+// TestHolidayHistory_Dataset checks each entry within the dataset against
+// the built-in holidays.
+func TestHolidayHistory_Dataset(t *testing.T) {
+	t.Parallel()
+
+	first, _ := DatasetRange()
+	for _, name := range []string{"スポーツの日", "天皇誕生日", "みどりの日"} {
+		for _, h := range HolidayHistory(name) {
+			if h.Since.Before(first) {
+				continue
+			}
+			if got := HolidayName(h.Since); got != h.Name {
+				t.Errorf("HolidayName(%s) = %q, want %q", h.Since.Format(time.DateOnly), got, h.Name)
+			}
+		}
+	}
+}
+
+func TestDatesOfHoliday_Aliases(t *testing.T) {
+	t.Parallel()
+
+	got, ok := DateOfHolidayInYear(2025, "体育の日")
+	if !ok || !got.Equal(d(2025, time.October, 13)) {
+		t.Errorf("DateOfHolidayInYear(2025, 体育の日) = %s, %v; want 2025-10-13", got.Format(time.DateOnly), ok)
+	}
+	got, ok = DateOfHolidayInYear(2019, "スポーツの日")
+	if !ok || !got.Equal(d(2019, time.October, 14)) {
+		t.Errorf("DateOfHolidayInYear(2019, スポーツの日) = %s, %v; want 2019-10-14", got.Format(time.DateOnly), ok)
+	}
+
+	sports, health := DatesOfHoliday("スポーツの日"), DatesOfHoliday("体育の日")
+	if len(sports) == 0 || len(sports) != len(health) {
+		t.Errorf("DatesOfHoliday: スポーツの日 %d dates, 体育の日 %d dates; want equal", len(sports), len(health))
+	}
+	if n := len(DatesOfHoliday("Health and Sports Day")); n != len(sports) {
+		t.Errorf("DatesOfHoliday(English) = %d dates, want %d", n, len(sports))
+	}
+}
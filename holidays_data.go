@@ -1,1220 +1,1257 @@
 // Code generated by cmd/genholidays; DO NOT EDIT.
 
+//go:build !jpholiday_recent && !jpholiday_csv
+
 package jpholiday
 
 import "time"
 
+var builtinDataset = DatasetMetadata{
+	SourceURL: "https://www8.cao.go.jp/chosei/shukujitsu/syukujitsu.csv",
+	FetchedAt: time.Date(2026, time.October, 16, 3, 24, 34, 0, time.UTC),
+	SHA256:    "cec37a743c96995cdb9cb52b685c9003634682a9b0e1a640a6b9b96881fe964a",
+	Rows:      1067,
+}
+
+const builtinChecksum = "8c6f05318f7ff762665028b170a29b51007d2e6ab453232bff4ee175f6300297"
+
+var builtinEnglishNames = map[string]string{
+	"こどもの日":        "Children's Day",
+	"みどりの日":        "Greenery Day",
+	"スポーツの日":       "Sports Day",
+	"休日":           "Holiday",
+	"休日（祝日扱い）":     "Holiday (treated as a national holiday)",
+	"体育の日":         "Health and Sports Day",
+	"体育の日（スポーツの日）": "Health and Sports Day (Sports Day)",
+	"元日":           "New Year's Day",
+	"勤労感謝の日":       "Labor Thanksgiving Day",
+	"即位礼正殿の儀":      "Enthronement Ceremony",
+	"大喪の礼":         "Funeral Ceremony of Emperor Showa",
+	"天皇誕生日":        "The Emperor's Birthday",
+	"山の日":          "Mountain Day",
+	"建国記念の日":       "National Foundation Day",
+	"憲法記念日":        "Constitution Memorial Day",
+	"成人の日":         "Coming of Age Day",
+	"敬老の日":         "Respect for the Aged Day",
+	"文化の日":         "Culture Day",
+	"春分の日":         "Vernal Equinox Day",
+	"昭和の日":         "Showa Day",
+	"海の日":          "Marine Day",
+	"秋分の日":         "Autumnal Equinox Day",
+	"結婚の儀":         "Imperial Wedding Ceremony",
+}
+
 var builtinHolidays = map[date]string{
 	// 1955
-	{1955, time.January, 1}:    "元日",
-	{1955, time.January, 15}:   "成人の日",
-	{1955, time.March, 21}:     "春分の日",
-	{1955, time.April, 29}:     "天皇誕生日",
-	{1955, time.May, 3}:        "憲法記念日",
-	{1955, time.May, 5}:        "こどもの日",
-	{1955, time.September, 24}: "秋分の日",
-	{1955, time.November, 3}:   "文化の日",
-	{1955, time.November, 23}:  "勤労感謝の日",
+	19550101: "元日",
+	19550115: "成人の日",
+	19550321: "春分の日",
+	19550429: "天皇誕生日",
+	19550503: "憲法記念日",
+	19550505: "こどもの日",
+	19550924: "秋分の日",
+	19551103: "文化の日",
+	19551123: "勤労感謝の日",
 
 	// 1956
-	{1956, time.January, 1}:    "元日",
-	{1956, time.January, 15}:   "成人の日",
-	{1956, time.March, 21}:     "春分の日",
-	{1956, time.April, 29}:     "天皇誕生日",
-	{1956, time.May, 3}:        "憲法記念日",
-	{1956, time.May, 5}:        "こどもの日",
-	{1956, time.September, 23}: "秋分の日",
-	{1956, time.November, 3}:   "文化の日",
-	{1956, time.November, 23}:  "勤労感謝の日",
+	19560101: "元日",
+	19560115: "成人の日",
+	19560321: "春分の日",
+	19560429: "天皇誕生日",
+	19560503: "憲法記念日",
+	19560505: "こどもの日",
+	19560923: "秋分の日",
+	19561103: "文化の日",
+	19561123: "勤労感謝の日",
 
 	// 1957
-	{1957, time.January, 1}:    "元日",
-	{1957, time.January, 15}:   "成人の日",
-	{1957, time.March, 21}:     "春分の日",
-	{1957, time.April, 29}:     "天皇誕生日",
-	{1957, time.May, 3}:        "憲法記念日",
-	{1957, time.May, 5}:        "こどもの日",
-	{1957, time.September, 23}: "秋分の日",
-	{1957, time.November, 3}:   "文化の日",
-	{1957, time.November, 23}:  "勤労感謝の日",
+	19570101: "元日",
+	19570115: "成人の日",
+	19570321: "春分の日",
+	19570429: "天皇誕生日",
+	19570503: "憲法記念日",
+	19570505: "こどもの日",
+	19570923: "秋分の日",
+	19571103: "文化の日",
+	19571123: "勤労感謝の日",
 
 	// 1958
-	{1958, time.January, 1}:    "元日",
-	{1958, time.January, 15}:   "成人の日",
-	{1958, time.March, 21}:     "春分の日",
-	{1958, time.April, 29}:     "天皇誕生日",
-	{1958, time.May, 3}:        "憲法記念日",
-	{1958, time.May, 5}:        "こどもの日",
-	{1958, time.September, 23}: "秋分の日",
-	{1958, time.November, 3}:   "文化の日",
-	{1958, time.November, 23}:  "勤労感謝の日",
+	19580101: "元日",
+	19580115: "成人の日",
+	19580321: "春分の日",
+	19580429: "天皇誕生日",
+	19580503: "憲法記念日",
+	19580505: "こどもの日",
+	19580923: "秋分の日",
+	19581103: "文化の日",
+	19581123: "勤労感謝の日",
 
 	// 1959
-	{1959, time.January, 1}:    "元日",
-	{1959, time.January, 15}:   "成人の日",
-	{1959, time.March, 21}:     "春分の日",
-	{1959, time.April, 10}:     "結婚の儀",
-	{1959, time.April, 29}:     "天皇誕生日",
-	{1959, time.May, 3}:        "憲法記念日",
-	{1959, time.May, 5}:        "こどもの日",
-	{1959, time.September, 24}: "秋分の日",
-	{1959, time.November, 3}:   "文化の日",
-	{1959, time.November, 23}:  "勤労感謝の日",
+	19590101: "元日",
+	19590115: "成人の日",
+	19590321: "春分の日",
+	19590410: "結婚の儀",
+	19590429: "天皇誕生日",
+	19590503: "憲法記念日",
+	19590505: "こどもの日",
+	19590924: "秋分の日",
+	19591103: "文化の日",
+	19591123: "勤労感謝の日",
 
 	// 1960
-	{1960, time.January, 1}:    "元日",
-	{1960, time.January, 15}:   "成人の日",
-	{1960, time.March, 20}:     "春分の日",
-	{1960, time.April, 29}:     "天皇誕生日",
-	{1960, time.May, 3}:        "憲法記念日",
-	{1960, time.May, 5}:        "こどもの日",
-	{1960, time.September, 23}: "秋分の日",
-	{1960, time.November, 3}:   "文化の日",
-	{1960, time.November, 23}:  "勤労感謝の日",
+	19600101: "元日",
+	19600115: "成人の日",
+	19600320: "春分の日",
+	19600429: "天皇誕生日",
+	19600503: "憲法記念日",
+	19600505: "こどもの日",
+	19600923: "秋分の日",
+	19601103: "文化の日",
+	19601123: "勤労感謝の日",
 
 	// 1961
-	{1961, time.January, 1}:    "元日",
-	{1961, time.January, 15}:   "成人の日",
-	{1961, time.March, 21}:     "春分の日",
-	{1961, time.April, 29}:     "天皇誕生日",
-	{1961, time.May, 3}:        "憲法記念日",
-	{1961, time.May, 5}:        "こどもの日",
-	{1961, time.September, 23}: "秋分の日",
-	{1961, time.November, 3}:   "文化の日",
-	{1961, time.November, 23}:  "勤労感謝の日",
+	19610101: "元日",
+	19610115: "成人の日",
+	19610321: "春分の日",
+	19610429: "天皇誕生日",
+	19610503: "憲法記念日",
+	19610505: "こどもの日",
+	19610923: "秋分の日",
+	19611103: "文化の日",
+	19611123: "勤労感謝の日",
 
 	// 1962
-	{1962, time.January, 1}:    "元日",
-	{1962, time.January, 15}:   "成人の日",
-	{1962, time.March, 21}:     "春分の日",
-	{1962, time.April, 29}:     "天皇誕生日",
-	{1962, time.May, 3}:        "憲法記念日",
-	{1962, time.May, 5}:        "こどもの日",
-	{1962, time.September, 23}: "秋分の日",
-	{1962, time.November, 3}:   "文化の日",
-	{1962, time.November, 23}:  "勤労感謝の日",
+	19620101: "元日",
+	19620115: "成人の日",
+	19620321: "春分の日",
+	19620429: "天皇誕生日",
+	19620503: "憲法記念日",
+	19620505: "こどもの日",
+	19620923: "秋分の日",
+	19621103: "文化の日",
+	19621123: "勤労感謝の日",
 
 	// 1963
-	{1963, time.January, 1}:    "元日",
-	{1963, time.January, 15}:   "成人の日",
-	{1963, time.March, 21}:     "春分の日",
-	{1963, time.April, 29}:     "天皇誕生日",
-	{1963, time.May, 3}:        "憲法記念日",
-	{1963, time.May, 5}:        "こどもの日",
-	{1963, time.September, 24}: "秋分の日",
-	{1963, time.November, 3}:   "文化の日",
-	{1963, time.November, 23}:  "勤労感謝の日",
+	19630101: "元日",
+	19630115: "成人の日",
+	19630321: "春分の日",
+	19630429: "天皇誕生日",
+	19630503: "憲法記念日",
+	19630505: "こどもの日",
+	19630924: "秋分の日",
+	19631103: "文化の日",
+	19631123: "勤労感謝の日",
 
 	// 1964
-	{1964, time.January, 1}:    "元日",
-	{1964, time.January, 15}:   "成人の日",
-	{1964, time.March, 20}:     "春分の日",
-	{1964, time.April, 29}:     "天皇誕生日",
-	{1964, time.May, 3}:        "憲法記念日",
-	{1964, time.May, 5}:        "こどもの日",
-	{1964, time.September, 23}: "秋分の日",
-	{1964, time.November, 3}:   "文化の日",
-	{1964, time.November, 23}:  "勤労感謝の日",
+	19640101: "元日",
+	19640115: "成人の日",
+	19640320: "春分の日",
+	19640429: "天皇誕生日",
+	19640503: "憲法記念日",
+	19640505: "こどもの日",
+	19640923: "秋分の日",
+	19641103: "文化の日",
+	19641123: "勤労感謝の日",
 
 	// 1965
-	{1965, time.January, 1}:    "元日",
-	{1965, time.January, 15}:   "成人の日",
-	{1965, time.March, 21}:     "春分の日",
-	{1965, time.April, 29}:     "天皇誕生日",
-	{1965, time.May, 3}:        "憲法記念日",
-	{1965, time.May, 5}:        "こどもの日",
-	{1965, time.September, 23}: "秋分の日",
-	{1965, time.November, 3}:   "文化の日",
-	{1965, time.November, 23}:  "勤労感謝の日",
+	19650101: "元日",
+	19650115: "成人の日",
+	19650321: "春分の日",
+	19650429: "天皇誕生日",
+	19650503: "憲法記念日",
+	19650505: "こどもの日",
+	19650923: "秋分の日",
+	19651103: "文化の日",
+	19651123: "勤労感謝の日",
 
 	// 1966
-	{1966, time.January, 1}:    "元日",
-	{1966, time.January, 15}:   "成人の日",
-	{1966, time.March, 21}:     "春分の日",
-	{1966, time.April, 29}:     "天皇誕生日",
-	{1966, time.May, 3}:        "憲法記念日",
-	{1966, time.May, 5}:        "こどもの日",
-	{1966, time.September, 15}: "敬老の日",
-	{1966, time.September, 23}: "秋分の日",
-	{1966, time.October, 10}:   "体育の日",
-	{1966, time.November, 3}:   "文化の日",
-	{1966, time.November, 23}:  "勤労感謝の日",
+	19660101: "元日",
+	19660115: "成人の日",
+	19660321: "春分の日",
+	19660429: "天皇誕生日",
+	19660503: "憲法記念日",
+	19660505: "こどもの日",
+	19660915: "敬老の日",
+	19660923: "秋分の日",
+	19661010: "体育の日",
+	19661103: "文化の日",
+	19661123: "勤労感謝の日",
 
 	// 1967
-	{1967, time.January, 1}:    "元日",
-	{1967, time.January, 15}:   "成人の日",
-	{1967, time.February, 11}:  "建国記念の日",
-	{1967, time.March, 21}:     "春分の日",
-	{1967, time.April, 29}:     "天皇誕生日",
-	{1967, time.May, 3}:        "憲法記念日",
-	{1967, time.May, 5}:        "こどもの日",
-	{1967, time.September, 15}: "敬老の日",
-	{1967, time.September, 24}: "秋分の日",
-	{1967, time.October, 10}:   "体育の日",
-	{1967, time.November, 3}:   "文化の日",
-	{1967, time.November, 23}:  "勤労感謝の日",
+	19670101: "元日",
+	19670115: "成人の日",
+	19670211: "建国記念の日",
+	19670321: "春分の日",
+	19670429: "天皇誕生日",
+	19670503: "憲法記念日",
+	19670505: "こどもの日",
+	19670915: "敬老の日",
+	19670924: "秋分の日",
+	19671010: "体育の日",
+	19671103: "文化の日",
+	19671123: "勤労感謝の日",
 
 	// 1968
-	{1968, time.January, 1}:    "元日",
-	{1968, time.January, 15}:   "成人の日",
-	{1968, time.February, 11}:  "建国記念の日",
-	{1968, time.March, 20}:     "春分の日",
-	{1968, time.April, 29}:     "天皇誕生日",
-	{1968, time.May, 3}:        "憲法記念日",
-	{1968, time.May, 5}:        "こどもの日",
-	{1968, time.September, 15}: "敬老の日",
-	{1968, time.September, 23}: "秋分の日",
-	{1968, time.October, 10}:   "体育の日",
-	{1968, time.November, 3}:   "文化の日",
-	{1968, time.November, 23}:  "勤労感謝の日",
+	19680101: "元日",
+	19680115: "成人の日",
+	19680211: "建国記念の日",
+	19680320: "春分の日",
+	19680429: "天皇誕生日",
+	19680503: "憲法記念日",
+	19680505: "こどもの日",
+	19680915: "敬老の日",
+	19680923: "秋分の日",
+	19681010: "体育の日",
+	19681103: "文化の日",
+	19681123: "勤労感謝の日",
 
 	// 1969
-	{1969, time.January, 1}:    "元日",
-	{1969, time.January, 15}:   "成人の日",
-	{1969, time.February, 11}:  "建国記念の日",
-	{1969, time.March, 21}:     "春分の日",
-	{1969, time.April, 29}:     "天皇誕生日",
-	{1969, time.May, 3}:        "憲法記念日",
-	{1969, time.May, 5}:        "こどもの日",
-	{1969, time.September, 15}: "敬老の日",
-	{1969, time.September, 23}: "秋分の日",
-	{1969, time.October, 10}:   "体育の日",
-	{1969, time.November, 3}:   "文化の日",
-	{1969, time.November, 23}:  "勤労感謝の日",
+	19690101: "元日",
+	19690115: "成人の日",
+	19690211: "建国記念の日",
+	19690321: "春分の日",
+	19690429: "天皇誕生日",
+	19690503: "憲法記念日",
+	19690505: "こどもの日",
+	19690915: "敬老の日",
+	19690923: "秋分の日",
+	19691010: "体育の日",
+	19691103: "文化の日",
+	19691123: "勤労感謝の日",
 
 	// 1970
-	{1970, time.January, 1}:    "元日",
-	{1970, time.January, 15}:   "成人の日",
-	{1970, time.February, 11}:  "建国記念の日",
-	{1970, time.March, 21}:     "春分の日",
-	{1970, time.April, 29}:     "天皇誕生日",
-	{1970, time.May, 3}:        "憲法記念日",
-	{1970, time.May, 5}:        "こどもの日",
-	{1970, time.September, 15}: "敬老の日",
-	{1970, time.September, 23}: "秋分の日",
-	{1970, time.October, 10}:   "体育の日",
-	{1970, time.November, 3}:   "文化の日",
-	{1970, time.November, 23}:  "勤労感謝の日",
+	19700101: "元日",
+	19700115: "成人の日",
+	19700211: "建国記念の日",
+	19700321: "春分の日",
+	19700429: "天皇誕生日",
+	19700503: "憲法記念日",
+	19700505: "こどもの日",
+	19700915: "敬老の日",
+	19700923: "秋分の日",
+	19701010: "体育の日",
+	19701103: "文化の日",
+	19701123: "勤労感謝の日",
 
 	// 1971
-	{1971, time.January, 1}:    "元日",
-	{1971, time.January, 15}:   "成人の日",
-	{1971, time.February, 11}:  "建国記念の日",
-	{1971, time.March, 21}:     "春分の日",
-	{1971, time.April, 29}:     "天皇誕生日",
-	{1971, time.May, 3}:        "憲法記念日",
-	{1971, time.May, 5}:        "こどもの日",
-	{1971, time.September, 15}: "敬老の日",
-	{1971, time.September, 24}: "秋分の日",
-	{1971, time.October, 10}:   "体育の日",
-	{1971, time.November, 3}:   "文化の日",
-	{1971, time.November, 23}:  "勤労感謝の日",
+	19710101: "元日",
+	19710115: "成人の日",
+	19710211: "建国記念の日",
+	19710321: "春分の日",
+	19710429: "天皇誕生日",
+	19710503: "憲法記念日",
+	19710505: "こどもの日",
+	19710915: "敬老の日",
+	19710924: "秋分の日",
+	19711010: "体育の日",
+	19711103: "文化の日",
+	19711123: "勤労感謝の日",
 
 	// 1972
-	{1972, time.January, 1}:    "元日",
-	{1972, time.January, 15}:   "成人の日",
-	{1972, time.February, 11}:  "建国記念の日",
-	{1972, time.March, 20}:     "春分の日",
-	{1972, time.April, 29}:     "天皇誕生日",
-	{1972, time.May, 3}:        "憲法記念日",
-	{1972, time.May, 5}:        "こどもの日",
-	{1972, time.September, 15}: "敬老の日",
-	{1972, time.September, 23}: "秋分の日",
-	{1972, time.October, 10}:   "体育の日",
-	{1972, time.November, 3}:   "文化の日",
-	{1972, time.November, 23}:  "勤労感謝の日",
+	19720101: "元日",
+	19720115: "成人の日",
+	19720211: "建国記念の日",
+	19720320: "春分の日",
+	19720429: "天皇誕生日",
+	19720503: "憲法記念日",
+	19720505: "こどもの日",
+	19720915: "敬老の日",
+	19720923: "秋分の日",
+	19721010: "体育の日",
+	19721103: "文化の日",
+	19721123: "勤労感謝の日",
 
 	// 1973
-	{1973, time.January, 1}:    "元日",
-	{1973, time.January, 15}:   "成人の日",
-	{1973, time.February, 11}:  "建国記念の日",
-	{1973, time.March, 21}:     "春分の日",
-	{1973, time.April, 29}:     "天皇誕生日",
-	{1973, time.April, 30}:     "休日",
-	{1973, time.May, 3}:        "憲法記念日",
-	{1973, time.May, 5}:        "こどもの日",
-	{1973, time.September, 15}: "敬老の日",
-	{1973, time.September, 23}: "秋分の日",
-	{1973, time.September, 24}: "休日",
-	{1973, time.October, 10}:   "体育の日",
-	{1973, time.November, 3}:   "文化の日",
-	{1973, time.November, 23}:  "勤労感謝の日",
+	19730101: "元日",
+	19730115: "成人の日",
+	19730211: "建国記念の日",
+	19730321: "春分の日",
+	19730429: "天皇誕生日",
+	19730430: "休日",
+	19730503: "憲法記念日",
+	19730505: "こどもの日",
+	19730915: "敬老の日",
+	19730923: "秋分の日",
+	19730924: "休日",
+	19731010: "体育の日",
+	19731103: "文化の日",
+	19731123: "勤労感謝の日",
 
 	// 1974
-	{1974, time.January, 1}:    "元日",
-	{1974, time.January, 15}:   "成人の日",
-	{1974, time.February, 11}:  "建国記念の日",
-	{1974, time.March, 21}:     "春分の日",
-	{1974, time.April, 29}:     "天皇誕生日",
-	{1974, time.May, 3}:        "憲法記念日",
-	{1974, time.May, 5}:        "こどもの日",
-	{1974, time.May, 6}:        "休日",
-	{1974, time.September, 15}: "敬老の日",
-	{1974, time.September, 16}: "休日",
-	{1974, time.September, 23}: "秋分の日",
-	{1974, time.October, 10}:   "体育の日",
-	{1974, time.November, 3}:   "文化の日",
-	{1974, time.November, 4}:   "休日",
-	{1974, time.November, 23}:  "勤労感謝の日",
+	19740101: "元日",
+	19740115: "成人の日",
+	19740211: "建国記念の日",
+	19740321: "春分の日",
+	19740429: "天皇誕生日",
+	19740503: "憲法記念日",
+	19740505: "こどもの日",
+	19740506: "休日",
+	19740915: "敬老の日",
+	19740916: "休日",
+	19740923: "秋分の日",
+	19741010: "体育の日",
+	19741103: "文化の日",
+	19741104: "休日",
+	19741123: "勤労感謝の日",
 
 	// 1975
-	{1975, time.January, 1}:    "元日",
-	{1975, time.January, 15}:   "成人の日",
-	{1975, time.February, 11}:  "建国記念の日",
-	{1975, time.March, 21}:     "春分の日",
-	{1975, time.April, 29}:     "天皇誕生日",
-	{1975, time.May, 3}:        "憲法記念日",
-	{1975, time.May, 5}:        "こどもの日",
-	{1975, time.September, 15}: "敬老の日",
-	{1975, time.September, 24}: "秋分の日",
-	{1975, time.October, 10}:   "体育の日",
-	{1975, time.November, 3}:   "文化の日",
-	{1975, time.November, 23}:  "勤労感謝の日",
-	{1975, time.November, 24}:  "休日",
+	19750101: "元日",
+	19750115: "成人の日",
+	19750211: "建国記念の日",
+	19750321: "春分の日",
+	19750429: "天皇誕生日",
+	19750503: "憲法記念日",
+	19750505: "こどもの日",
+	19750915: "敬老の日",
+	19750924: "秋分の日",
+	19751010: "体育の日",
+	19751103: "文化の日",
+	19751123: "勤労感謝の日",
+	19751124: "休日",
 
 	// 1976
-	{1976, time.January, 1}:    "元日",
-	{1976, time.January, 15}:   "成人の日",
-	{1976, time.February, 11}:  "建国記念の日",
-	{1976, time.March, 20}:     "春分の日",
-	{1976, time.April, 29}:     "天皇誕生日",
-	{1976, time.May, 3}:        "憲法記念日",
-	{1976, time.May, 5}:        "こどもの日",
-	{1976, time.September, 15}: "敬老の日",
-	{1976, time.September, 23}: "秋分の日",
-	{1976, time.October, 10}:   "体育の日",
-	{1976, time.October, 11}:   "休日",
-	{1976, time.November, 3}:   "文化の日",
-	{1976, time.November, 23}:  "勤労感謝の日",
+	19760101: "元日",
+	19760115: "成人の日",
+	19760211: "建国記念の日",
+	19760320: "春分の日",
+	19760429: "天皇誕生日",
+	19760503: "憲法記念日",
+	19760505: "こどもの日",
+	19760915: "敬老の日",
+	19760923: "秋分の日",
+	19761010: "体育の日",
+	19761011: "休日",
+	19761103: "文化の日",
+	19761123: "勤労感謝の日",
 
 	// 1977
-	{1977, time.January, 1}:    "元日",
-	{1977, time.January, 15}:   "成人の日",
-	{1977, time.February, 11}:  "建国記念の日",
-	{1977, time.March, 21}:     "春分の日",
-	{1977, time.April, 29}:     "天皇誕生日",
-	{1977, time.May, 3}:        "憲法記念日",
-	{1977, time.May, 5}:        "こどもの日",
-	{1977, time.September, 15}: "敬老の日",
-	{1977, time.September, 23}: "秋分の日",
-	{1977, time.October, 10}:   "体育の日",
-	{1977, time.November, 3}:   "文化の日",
-	{1977, time.November, 23}:  "勤労感謝の日",
+	19770101: "元日",
+	19770115: "成人の日",
+	19770211: "建国記念の日",
+	19770321: "春分の日",
+	19770429: "天皇誕生日",
+	19770503: "憲法記念日",
+	19770505: "こどもの日",
+	19770915: "敬老の日",
+	19770923: "秋分の日",
+	19771010: "体育の日",
+	19771103: "文化の日",
+	19771123: "勤労感謝の日",
 
 	// 1978
-	{1978, time.January, 1}:    "元日",
-	{1978, time.January, 2}:    "休日",
-	{1978, time.January, 15}:   "成人の日",
-	{1978, time.January, 16}:   "休日",
-	{1978, time.February, 11}:  "建国記念の日",
-	{1978, time.March, 21}:     "春分の日",
-	{1978, time.April, 29}:     "天皇誕生日",
-	{1978, time.May, 3}:        "憲法記念日",
-	{1978, time.May, 5}:        "こどもの日",
-	{1978, time.September, 15}: "敬老の日",
-	{1978, time.September, 23}: "秋分の日",
-	{1978, time.October, 10}:   "体育の日",
-	{1978, time.November, 3}:   "文化の日",
-	{1978, time.November, 23}:  "勤労感謝の日",
+	19780101: "元日",
+	19780102: "休日",
+	19780115: "成人の日",
+	19780116: "休日",
+	19780211: "建国記念の日",
+	19780321: "春分の日",
+	19780429: "天皇誕生日",
+	19780503: "憲法記念日",
+	19780505: "こどもの日",
+	19780915: "敬老の日",
+	19780923: "秋分の日",
+	19781010: "体育の日",
+	19781103: "文化の日",
+	19781123: "勤労感謝の日",
 
 	// 1979
-	{1979, time.January, 1}:    "元日",
-	{1979, time.January, 15}:   "成人の日",
-	{1979, time.February, 11}:  "建国記念の日",
-	{1979, time.February, 12}:  "休日",
-	{1979, time.March, 21}:     "春分の日",
-	{1979, time.April, 29}:     "天皇誕生日",
-	{1979, time.April, 30}:     "休日",
-	{1979, time.May, 3}:        "憲法記念日",
-	{1979, time.May, 5}:        "こどもの日",
-	{1979, time.September, 15}: "敬老の日",
-	{1979, time.September, 24}: "秋分の日",
-	{1979, time.October, 10}:   "体育の日",
-	{1979, time.November, 3}:   "文化の日",
-	{1979, time.November, 23}:  "勤労感謝の日",
+	19790101: "元日",
+	19790115: "成人の日",
+	19790211: "建国記念の日",
+	19790212: "休日",
+	19790321: "春分の日",
+	19790429: "天皇誕生日",
+	19790430: "休日",
+	19790503: "憲法記念日",
+	19790505: "こどもの日",
+	19790915: "敬老の日",
+	19790924: "秋分の日",
+	19791010: "体育の日",
+	19791103: "文化の日",
+	19791123: "勤労感謝の日",
 
 	// 1980
-	{1980, time.January, 1}:    "元日",
-	{1980, time.January, 15}:   "成人の日",
-	{1980, time.February, 11}:  "建国記念の日",
-	{1980, time.March, 20}:     "春分の日",
-	{1980, time.April, 29}:     "天皇誕生日",
-	{1980, time.May, 3}:        "憲法記念日",
-	{1980, time.May, 5}:        "こどもの日",
-	{1980, time.September, 15}: "敬老の日",
-	{1980, time.September, 23}: "秋分の日",
-	{1980, time.October, 10}:   "体育の日",
-	{1980, time.November, 3}:   "文化の日",
-	{1980, time.November, 23}:  "勤労感謝の日",
-	{1980, time.November, 24}:  "休日",
+	19800101: "元日",
+	19800115: "成人の日",
+	19800211: "建国記念の日",
+	19800320: "春分の日",
+	19800429: "天皇誕生日",
+	19800503: "憲法記念日",
+	19800505: "こどもの日",
+	19800915: "敬老の日",
+	19800923: "秋分の日",
+	19801010: "体育の日",
+	19801103: "文化の日",
+	19801123: "勤労感謝の日",
+	19801124: "休日",
 
 	// 1981
-	{1981, time.January, 1}:    "元日",
-	{1981, time.January, 15}:   "成人の日",
-	{1981, time.February, 11}:  "建国記念の日",
-	{1981, time.March, 21}:     "春分の日",
-	{1981, time.April, 29}:     "天皇誕生日",
-	{1981, time.May, 3}:        "憲法記念日",
-	{1981, time.May, 4}:        "休日",
-	{1981, time.May, 5}:        "こどもの日",
-	{1981, time.September, 15}: "敬老の日",
-	{1981, time.September, 23}: "秋分の日",
-	{1981, time.October, 10}:   "体育の日",
-	{1981, time.November, 3}:   "文化の日",
-	{1981, time.November, 23}:  "勤労感謝の日",
+	19810101: "元日",
+	19810115: "成人の日",
+	19810211: "建国記念の日",
+	19810321: "春分の日",
+	19810429: "天皇誕生日",
+	19810503: "憲法記念日",
+	19810504: "休日",
+	19810505: "こどもの日",
+	19810915: "敬老の日",
+	19810923: "秋分の日",
+	19811010: "体育の日",
+	19811103: "文化の日",
+	19811123: "勤労感謝の日",
 
 	// 1982
-	{1982, time.January, 1}:    "元日",
-	{1982, time.January, 15}:   "成人の日",
-	{1982, time.February, 11}:  "建国記念の日",
-	{1982, time.March, 21}:     "春分の日",
-	{1982, time.March, 22}:     "休日",
-	{1982, time.April, 29}:     "天皇誕生日",
-	{1982, time.May, 3}:        "憲法記念日",
-	{1982, time.May, 5}:        "こどもの日",
-	{1982, time.September, 15}: "敬老の日",
-	{1982, time.September, 23}: "秋分の日",
-	{1982, time.October, 10}:   "体育の日",
-	{1982, time.October, 11}:   "休日",
-	{1982, time.November, 3}:   "文化の日",
-	{1982, time.November, 23}:  "勤労感謝の日",
+	19820101: "元日",
+	19820115: "成人の日",
+	19820211: "建国記念の日",
+	19820321: "春分の日",
+	19820322: "休日",
+	19820429: "天皇誕生日",
+	19820503: "憲法記念日",
+	19820505: "こどもの日",
+	19820915: "敬老の日",
+	19820923: "秋分の日",
+	19821010: "体育の日",
+	19821011: "休日",
+	19821103: "文化の日",
+	19821123: "勤労感謝の日",
 
 	// 1983
-	{1983, time.January, 1}:    "元日",
-	{1983, time.January, 15}:   "成人の日",
-	{1983, time.February, 11}:  "建国記念の日",
-	{1983, time.March, 21}:     "春分の日",
-	{1983, time.April, 29}:     "天皇誕生日",
-	{1983, time.May, 3}:        "憲法記念日",
-	{1983, time.May, 5}:        "こどもの日",
-	{1983, time.September, 15}: "敬老の日",
-	{1983, time.September, 23}: "秋分の日",
-	{1983, time.October, 10}:   "体育の日",
-	{1983, time.November, 3}:   "文化の日",
-	{1983, time.November, 23}:  "勤労感謝の日",
+	19830101: "元日",
+	19830115: "成人の日",
+	19830211: "建国記念の日",
+	19830321: "春分の日",
+	19830429: "天皇誕生日",
+	19830503: "憲法記念日",
+	19830505: "こどもの日",
+	19830915: "敬老の日",
+	19830923: "秋分の日",
+	19831010: "体育の日",
+	19831103: "文化の日",
+	19831123: "勤労感謝の日",
 
 	// 1984
-	{1984, time.January, 1}:    "元日",
-	{1984, time.January, 2}:    "休日",
-	{1984, time.January, 15}:   "成人の日",
-	{1984, time.January, 16}:   "休日",
-	{1984, time.February, 11}:  "建国記念の日",
-	{1984, time.March, 20}:     "春分の日",
-	{1984, time.April, 29}:     "天皇誕生日",
-	{1984, time.April, 30}:     "休日",
-	{1984, time.May, 3}:        "憲法記念日",
-	{1984, time.May, 5}:        "こどもの日",
-	{1984, time.September, 15}: "敬老の日",
-	{1984, time.September, 23}: "秋分の日",
-	{1984, time.September, 24}: "休日",
-	{1984, time.October, 10}:   "体育の日",
-	{1984, time.November, 3}:   "文化の日",
-	{1984, time.November, 23}:  "勤労感謝の日",
+	19840101: "元日",
+	19840102: "休日",
+	19840115: "成人の日",
+	19840116: "休日",
+	19840211: "建国記念の日",
+	19840320: "春分の日",
+	19840429: "天皇誕生日",
+	19840430: "休日",
+	19840503: "憲法記念日",
+	19840505: "こどもの日",
+	19840915: "敬老の日",
+	19840923: "秋分の日",
+	19840924: "休日",
+	19841010: "体育の日",
+	19841103: "文化の日",
+	19841123: "勤労感謝の日",
 
 	// 1985
-	{1985, time.January, 1}:    "元日",
-	{1985, time.January, 15}:   "成人の日",
-	{1985, time.February, 11}:  "建国記念の日",
-	{1985, time.March, 21}:     "春分の日",
-	{1985, time.April, 29}:     "天皇誕生日",
-	{1985, time.May, 3}:        "憲法記念日",
-	{1985, time.May, 5}:        "こどもの日",
-	{1985, time.May, 6}:        "休日",
-	{1985, time.September, 15}: "敬老の日",
-	{1985, time.September, 16}: "休日",
-	{1985, time.September, 23}: "秋分の日",
-	{1985, time.October, 10}:   "体育の日",
-	{1985, time.November, 3}:   "文化の日",
-	{1985, time.November, 4}:   "休日",
-	{1985, time.November, 23}:  "勤労感謝の日",
+	19850101: "元日",
+	19850115: "成人の日",
+	19850211: "建国記念の日",
+	19850321: "春分の日",
+	19850429: "天皇誕生日",
+	19850503: "憲法記念日",
+	19850505: "こどもの日",
+	19850506: "休日",
+	19850915: "敬老の日",
+	19850916: "休日",
+	19850923: "秋分の日",
+	19851010: "体育の日",
+	19851103: "文化の日",
+	19851104: "休日",
+	19851123: "勤労感謝の日",
 
 	// 1986
-	{1986, time.January, 1}:    "元日",
-	{1986, time.January, 15}:   "成人の日",
-	{1986, time.February, 11}:  "建国記念の日",
-	{1986, time.March, 21}:     "春分の日",
-	{1986, time.April, 29}:     "天皇誕生日",
-	{1986, time.May, 3}:        "憲法記念日",
-	{1986, time.May, 5}:        "こどもの日",
-	{1986, time.September, 15}: "敬老の日",
-	{1986, time.September, 23}: "秋分の日",
-	{1986, time.October, 10}:   "体育の日",
-	{1986, time.November, 3}:   "文化の日",
-	{1986, time.November, 23}:  "勤労感謝の日",
-	{1986, time.November, 24}:  "休日",
+	19860101: "元日",
+	19860115: "成人の日",
+	19860211: "建国記念の日",
+	19860321: "春分の日",
+	19860429: "天皇誕生日",
+	19860503: "憲法記念日",
+	19860505: "こどもの日",
+	19860915: "敬老の日",
+	19860923: "秋分の日",
+	19861010: "体育の日",
+	19861103: "文化の日",
+	19861123: "勤労感謝の日",
+	19861124: "休日",
 
 	// 1987
-	{1987, time.January, 1}:    "元日",
-	{1987, time.January, 15}:   "成人の日",
-	{1987, time.February, 11}:  "建国記念の日",
-	{1987, time.March, 21}:     "春分の日",
-	{1987, time.April, 29}:     "天皇誕生日",
-	{1987, time.May, 3}:        "憲法記念日",
-	{1987, time.May, 4}:        "休日",
-	{1987, time.May, 5}:        "こどもの日",
-	{1987, time.September, 15}: "敬老の日",
-	{1987, time.September, 23}: "秋分の日",
-	{1987, time.October, 10}:   "体育の日",
-	{1987, time.November, 3}:   "文化の日",
-	{1987, time.November, 23}:  "勤労感謝の日",
+	19870101: "元日",
+	19870115: "成人の日",
+	19870211: "建国記念の日",
+	19870321: "春分の日",
+	19870429: "天皇誕生日",
+	19870503: "憲法記念日",
+	19870504: "休日",
+	19870505: "こどもの日",
+	19870915: "敬老の日",
+	19870923: "秋分の日",
+	19871010: "体育の日",
+	19871103: "文化の日",
+	19871123: "勤労感謝の日",
 
 	// 1988
-	{1988, time.January, 1}:    "元日",
-	{1988, time.January, 15}:   "成人の日",
-	{1988, time.February, 11}:  "建国記念の日",
-	{1988, time.March, 20}:     "春分の日",
-	{1988, time.March, 21}:     "休日",
-	{1988, time.April, 29}:     "天皇誕生日",
-	{1988, time.May, 3}:        "憲法記念日",
-	{1988, time.May, 4}:        "休日",
-	{1988, time.May, 5}:        "こどもの日",
-	{1988, time.September, 15}: "敬老の日",
-	{1988, time.September, 23}: "秋分の日",
-	{1988, time.October, 10}:   "体育の日",
-	{1988, time.November, 3}:   "文化の日",
-	{1988, time.November, 23}:  "勤労感謝の日",
+	19880101: "元日",
+	19880115: "成人の日",
+	19880211: "建国記念の日",
+	19880320: "春分の日",
+	19880321: "休日",
+	19880429: "天皇誕生日",
+	19880503: "憲法記念日",
+	19880504: "休日",
+	19880505: "こどもの日",
+	19880915: "敬老の日",
+	19880923: "秋分の日",
+	19881010: "体育の日",
+	19881103: "文化の日",
+	19881123: "勤労感謝の日",
 
 	// 1989
-	{1989, time.January, 1}:    "元日",
-	{1989, time.January, 2}:    "休日",
-	{1989, time.January, 15}:   "成人の日",
-	{1989, time.January, 16}:   "休日",
-	{1989, time.February, 11}:  "建国記念の日",
-	{1989, time.February, 24}:  "大喪の礼",
-	{1989, time.March, 21}:     "春分の日",
-	{1989, time.April, 29}:     "みどりの日",
-	{1989, time.May, 3}:        "憲法記念日",
-	{1989, time.May, 4}:        "休日",
-	{1989, time.May, 5}:        "こどもの日",
-	{1989, time.September, 15}: "敬老の日",
-	{1989, time.September, 23}: "秋分の日",
-	{1989, time.October, 10}:   "体育の日",
-	{1989, time.November, 3}:   "文化の日",
-	{1989, time.November, 23}:  "勤労感謝の日",
-	{1989, time.December, 23}:  "天皇誕生日",
+	19890101: "元日",
+	19890102: "休日",
+	19890115: "成人の日",
+	19890116: "休日",
+	19890211: "建国記念の日",
+	19890224: "大喪の礼",
+	19890321: "春分の日",
+	19890429: "みどりの日",
+	19890503: "憲法記念日",
+	19890504: "休日",
+	19890505: "こどもの日",
+	19890915: "敬老の日",
+	19890923: "秋分の日",
+	19891010: "体育の日",
+	19891103: "文化の日",
+	19891123: "勤労感謝の日",
+	19891223: "天皇誕生日",
 
 	// 1990
-	{1990, time.January, 1}:    "元日",
-	{1990, time.January, 15}:   "成人の日",
-	{1990, time.February, 11}:  "建国記念の日",
-	{1990, time.February, 12}:  "休日",
-	{1990, time.March, 21}:     "春分の日",
-	{1990, time.April, 29}:     "みどりの日",
-	{1990, time.April, 30}:     "休日",
-	{1990, time.May, 3}:        "憲法記念日",
-	{1990, time.May, 4}:        "休日",
-	{1990, time.May, 5}:        "こどもの日",
-	{1990, time.September, 15}: "敬老の日",
-	{1990, time.September, 23}: "秋分の日",
-	{1990, time.September, 24}: "休日",
-	{1990, time.October, 10}:   "体育の日",
-	{1990, time.November, 3}:   "文化の日",
-	{1990, time.November, 12}:  "即位礼正殿の儀",
-	{1990, time.November, 23}:  "勤労感謝の日",
-	{1990, time.December, 23}:  "天皇誕生日",
-	{1990, time.December, 24}:  "休日",
+	19900101: "元日",
+	19900115: "成人の日",
+	19900211: "建国記念の日",
+	19900212: "休日",
+	19900321: "春分の日",
+	19900429: "みどりの日",
+	19900430: "休日",
+	19900503: "憲法記念日",
+	19900504: "休日",
+	19900505: "こどもの日",
+	19900915: "敬老の日",
+	19900923: "秋分の日",
+	19900924: "休日",
+	19901010: "体育の日",
+	19901103: "文化の日",
+	19901112: "即位礼正殿の儀",
+	19901123: "勤労感謝の日",
+	19901223: "天皇誕生日",
+	19901224: "休日",
 
 	// 1991
-	{1991, time.January, 1}:    "元日",
-	{1991, time.January, 15}:   "成人の日",
-	{1991, time.February, 11}:  "建国記念の日",
-	{1991, time.March, 21}:     "春分の日",
-	{1991, time.April, 29}:     "みどりの日",
-	{1991, time.May, 3}:        "憲法記念日",
-	{1991, time.May, 4}:        "休日",
-	{1991, time.May, 5}:        "こどもの日",
-	{1991, time.May, 6}:        "休日",
-	{1991, time.September, 15}: "敬老の日",
-	{1991, time.September, 16}: "休日",
-	{1991, time.September, 23}: "秋分の日",
-	{1991, time.October, 10}:   "体育の日",
-	{1991, time.November, 3}:   "文化の日",
-	{1991, time.November, 4}:   "休日",
-	{1991, time.November, 23}:  "勤労感謝の日",
-	{1991, time.December, 23}:  "天皇誕生日",
+	19910101: "元日",
+	19910115: "成人の日",
+	19910211: "建国記念の日",
+	19910321: "春分の日",
+	19910429: "みどりの日",
+	19910503: "憲法記念日",
+	19910504: "休日",
+	19910505: "こどもの日",
+	19910506: "休日",
+	19910915: "敬老の日",
+	19910916: "休日",
+	19910923: "秋分の日",
+	19911010: "体育の日",
+	19911103: "文化の日",
+	19911104: "休日",
+	19911123: "勤労感謝の日",
+	19911223: "天皇誕生日",
 
 	// 1992
-	{1992, time.January, 1}:    "元日",
-	{1992, time.January, 15}:   "成人の日",
-	{1992, time.February, 11}:  "建国記念の日",
-	{1992, time.March, 20}:     "春分の日",
-	{1992, time.April, 29}:     "みどりの日",
-	{1992, time.May, 3}:        "憲法記念日",
-	{1992, time.May, 4}:        "休日",
-	{1992, time.May, 5}:        "こどもの日",
-	{1992, time.September, 15}: "敬老の日",
-	{1992, time.September, 23}: "秋分の日",
-	{1992, time.October, 10}:   "体育の日",
-	{1992, time.November, 3}:   "文化の日",
-	{1992, time.November, 23}:  "勤労感謝の日",
-	{1992, time.December, 23}:  "天皇誕生日",
+	19920101: "元日",
+	19920115: "成人の日",
+	19920211: "建国記念の日",
+	19920320: "春分の日",
+	19920429: "みどりの日",
+	19920503: "憲法記念日",
+	19920504: "休日",
+	19920505: "こどもの日",
+	19920915: "敬老の日",
+	19920923: "秋分の日",
+	19921010: "体育の日",
+	19921103: "文化の日",
+	19921123: "勤労感謝の日",
+	19921223: "天皇誕生日",
 
 	// 1993
-	{1993, time.January, 1}:    "元日",
-	{1993, time.January, 15}:   "成人の日",
-	{1993, time.February, 11}:  "建国記念の日",
-	{1993, time.March, 20}:     "春分の日",
-	{1993, time.April, 29}:     "みどりの日",
-	{1993, time.May, 3}:        "憲法記念日",
-	{1993, time.May, 4}:        "休日",
-	{1993, time.May, 5}:        "こどもの日",
-	{1993, time.June, 9}:       "結婚の儀",
-	{1993, time.September, 15}: "敬老の日",
-	{1993, time.September, 23}: "秋分の日",
-	{1993, time.October, 10}:   "体育の日",
-	{1993, time.October, 11}:   "休日",
-	{1993, time.November, 3}:   "文化の日",
-	{1993, time.November, 23}:  "勤労感謝の日",
-	{1993, time.December, 23}:  "天皇誕生日",
+	19930101: "元日",
+	19930115: "成人の日",
+	19930211: "建国記念の日",
+	19930320: "春分の日",
+	19930429: "みどりの日",
+	19930503: "憲法記念日",
+	19930504: "休日",
+	19930505: "こどもの日",
+	19930609: "結婚の儀",
+	19930915: "敬老の日",
+	19930923: "秋分の日",
+	19931010: "体育の日",
+	19931011: "休日",
+	19931103: "文化の日",
+	19931123: "勤労感謝の日",
+	19931223: "天皇誕生日",
 
 	// 1994
-	{1994, time.January, 1}:    "元日",
-	{1994, time.January, 15}:   "成人の日",
-	{1994, time.February, 11}:  "建国記念の日",
-	{1994, time.March, 21}:     "春分の日",
-	{1994, time.April, 29}:     "みどりの日",
-	{1994, time.May, 3}:        "憲法記念日",
-	{1994, time.May, 4}:        "休日",
-	{1994, time.May, 5}:        "こどもの日",
-	{1994, time.September, 15}: "敬老の日",
-	{1994, time.September, 23}: "秋分の日",
-	{1994, time.October, 10}:   "体育の日",
-	{1994, time.November, 3}:   "文化の日",
-	{1994, time.November, 23}:  "勤労感謝の日",
-	{1994, time.December, 23}:  "天皇誕生日",
+	19940101: "元日",
+	19940115: "成人の日",
+	19940211: "建国記念の日",
+	19940321: "春分の日",
+	19940429: "みどりの日",
+	19940503: "憲法記念日",
+	19940504: "休日",
+	19940505: "こどもの日",
+	19940915: "敬老の日",
+	19940923: "秋分の日",
+	19941010: "体育の日",
+	19941103: "文化の日",
+	19941123: "勤労感謝の日",
+	19941223: "天皇誕生日",
 
 	// 1995
-	{1995, time.January, 1}:    "元日",
-	{1995, time.January, 2}:    "休日",
-	{1995, time.January, 15}:   "成人の日",
-	{1995, time.January, 16}:   "休日",
-	{1995, time.February, 11}:  "建国記念の日",
-	{1995, time.March, 21}:     "春分の日",
-	{1995, time.April, 29}:     "みどりの日",
-	{1995, time.May, 3}:        "憲法記念日",
-	{1995, time.May, 4}:        "休日",
-	{1995, time.May, 5}:        "こどもの日",
-	{1995, time.September, 15}: "敬老の日",
-	{1995, time.September, 23}: "秋分の日",
-	{1995, time.October, 10}:   "体育の日",
-	{1995, time.November, 3}:   "文化の日",
-	{1995, time.November, 23}:  "勤労感謝の日",
-	{1995, time.December, 23}:  "天皇誕生日",
+	19950101: "元日",
+	19950102: "休日",
+	19950115: "成人の日",
+	19950116: "休日",
+	19950211: "建国記念の日",
+	19950321: "春分の日",
+	19950429: "みどりの日",
+	19950503: "憲法記念日",
+	19950504: "休日",
+	19950505: "こどもの日",
+	19950915: "敬老の日",
+	19950923: "秋分の日",
+	19951010: "体育の日",
+	19951103: "文化の日",
+	19951123: "勤労感謝の日",
+	19951223: "天皇誕生日",
 
 	// 1996
-	{1996, time.January, 1}:    "元日",
-	{1996, time.January, 15}:   "成人の日",
-	{1996, time.February, 11}:  "建国記念の日",
-	{1996, time.February, 12}:  "休日",
-	{1996, time.March, 20}:     "春分の日",
-	{1996, time.April, 29}:     "みどりの日",
-	{1996, time.May, 3}:        "憲法記念日",
-	{1996, time.May, 4}:        "休日",
-	{1996, time.May, 5}:        "こどもの日",
-	{1996, time.May, 6}:        "休日",
-	{1996, time.July, 20}:      "海の日",
-	{1996, time.September, 15}: "敬老の日",
-	{1996, time.September, 16}: "休日",
-	{1996, time.September, 23}: "秋分の日",
-	{1996, time.October, 10}:   "体育の日",
-	{1996, time.November, 3}:   "文化の日",
-	{1996, time.November, 4}:   "休日",
-	{1996, time.November, 23}:  "勤労感謝の日",
-	{1996, time.December, 23}:  "天皇誕生日",
+	19960101: "元日",
+	19960115: "成人の日",
+	19960211: "建国記念の日",
+	19960212: "休日",
+	19960320: "春分の日",
+	19960429: "みどりの日",
+	19960503: "憲法記念日",
+	19960504: "休日",
+	19960505: "こどもの日",
+	19960506: "休日",
+	19960720: "海の日",
+	19960915: "敬老の日",
+	19960916: "休日",
+	19960923: "秋分の日",
+	19961010: "体育の日",
+	19961103: "文化の日",
+	19961104: "休日",
+	19961123: "勤労感謝の日",
+	19961223: "天皇誕生日",
 
 	// 1997
-	{1997, time.January, 1}:    "元日",
-	{1997, time.January, 15}:   "成人の日",
-	{1997, time.February, 11}:  "建国記念の日",
-	{1997, time.March, 20}:     "春分の日",
-	{1997, time.April, 29}:     "みどりの日",
-	{1997, time.May, 3}:        "憲法記念日",
-	{1997, time.May, 5}:        "こどもの日",
-	{1997, time.July, 20}:      "海の日",
-	{1997, time.July, 21}:      "休日",
-	{1997, time.September, 15}: "敬老の日",
-	{1997, time.September, 23}: "秋分の日",
-	{1997, time.October, 10}:   "体育の日",
-	{1997, time.November, 3}:   "文化の日",
-	{1997, time.November, 23}:  "勤労感謝の日",
-	{1997, time.November, 24}:  "休日",
-	{1997, time.December, 23}:  "天皇誕生日",
+	19970101: "元日",
+	19970115: "成人の日",
+	19970211: "建国記念の日",
+	19970320: "春分の日",
+	19970429: "みどりの日",
+	19970503: "憲法記念日",
+	19970505: "こどもの日",
+	19970720: "海の日",
+	19970721: "休日",
+	19970915: "敬老の日",
+	19970923: "秋分の日",
+	19971010: "体育の日",
+	19971103: "文化の日",
+	19971123: "勤労感謝の日",
+	19971124: "休日",
+	19971223: "天皇誕生日",
 
 	// 1998
-	{1998, time.January, 1}:    "元日",
-	{1998, time.January, 15}:   "成人の日",
-	{1998, time.February, 11}:  "建国記念の日",
-	{1998, time.March, 21}:     "春分の日",
-	{1998, time.April, 29}:     "みどりの日",
-	{1998, time.May, 3}:        "憲法記念日",
-	{1998, time.May, 4}:        "休日",
-	{1998, time.May, 5}:        "こどもの日",
-	{1998, time.July, 20}:      "海の日",
-	{1998, time.September, 15}: "敬老の日",
-	{1998, time.September, 23}: "秋分の日",
-	{1998, time.October, 10}:   "体育の日",
-	{1998, time.November, 3}:   "文化の日",
-	{1998, time.November, 23}:  "勤労感謝の日",
-	{1998, time.December, 23}:  "天皇誕生日",
+	19980101: "元日",
+	19980115: "成人の日",
+	19980211: "建国記念の日",
+	19980321: "春分の日",
+	19980429: "みどりの日",
+	19980503: "憲法記念日",
+	19980504: "休日",
+	19980505: "こどもの日",
+	19980720: "海の日",
+	19980915: "敬老の日",
+	19980923: "秋分の日",
+	19981010: "体育の日",
+	19981103: "文化の日",
+	19981123: "勤労感謝の日",
+	19981223: "天皇誕生日",
 
 	// 1999
-	{1999, time.January, 1}:    "元日",
-	{1999, time.January, 15}:   "成人の日",
-	{1999, time.February, 11}:  "建国記念の日",
-	{1999, time.March, 21}:     "春分の日",
-	{1999, time.March, 22}:     "休日",
-	{1999, time.April, 29}:     "みどりの日",
-	{1999, time.May, 3}:        "憲法記念日",
-	{1999, time.May, 4}:        "休日",
-	{1999, time.May, 5}:        "こどもの日",
-	{1999, time.July, 20}:      "海の日",
-	{1999, time.September, 15}: "敬老の日",
-	{1999, time.September, 23}: "秋分の日",
-	{1999, time.October, 10}:   "体育の日",
-	{1999, time.October, 11}:   "休日",
-	{1999, time.November, 3}:   "文化の日",
-	{1999, time.November, 23}:  "勤労感謝の日",
-	{1999, time.December, 23}:  "天皇誕生日",
+	19990101: "元日",
+	19990115: "成人の日",
+	19990211: "建国記念の日",
+	19990321: "春分の日",
+	19990322: "休日",
+	19990429: "みどりの日",
+	19990503: "憲法記念日",
+	19990504: "休日",
+	19990505: "こどもの日",
+	19990720: "海の日",
+	19990915: "敬老の日",
+	19990923: "秋分の日",
+	19991010: "体育の日",
+	19991011: "休日",
+	19991103: "文化の日",
+	19991123: "勤労感謝の日",
+	19991223: "天皇誕生日",
 
 	// 2000
-	{2000, time.January, 1}:    "元日",
-	{2000, time.January, 10}:   "成人の日",
-	{2000, time.February, 11}:  "建国記念の日",
-	{2000, time.March, 20}:     "春分の日",
-	{2000, time.April, 29}:     "みどりの日",
-	{2000, time.May, 3}:        "憲法記念日",
-	{2000, time.May, 4}:        "休日",
-	{2000, time.May, 5}:        "こどもの日",
-	{2000, time.July, 20}:      "海の日",
-	{2000, time.September, 15}: "敬老の日",
-	{2000, time.September, 23}: "秋分の日",
-	{2000, time.October, 9}:    "体育の日",
-	{2000, time.November, 3}:   "文化の日",
-	{2000, time.November, 23}:  "勤労感謝の日",
-	{2000, time.December, 23}:  "天皇誕生日",
+	20000101: "元日",
+	20000110: "成人の日",
+	20000211: "建国記念の日",
+	20000320: "春分の日",
+	20000429: "みどりの日",
+	20000503: "憲法記念日",
+	20000504: "休日",
+	20000505: "こどもの日",
+	20000720: "海の日",
+	20000915: "敬老の日",
+	20000923: "秋分の日",
+	20001009: "体育の日",
+	20001103: "文化の日",
+	20001123: "勤労感謝の日",
+	20001223: "天皇誕生日",
 
 	// 2001
-	{2001, time.January, 1}:    "元日",
-	{2001, time.January, 8}:    "成人の日",
-	{2001, time.February, 11}:  "建国記念の日",
-	{2001, time.February, 12}:  "休日",
-	{2001, time.March, 20}:     "春分の日",
-	{2001, time.April, 29}:     "みどりの日",
-	{2001, time.April, 30}:     "休日",
-	{2001, time.May, 3}:        "憲法記念日",
-	{2001, time.May, 4}:        "休日",
-	{2001, time.May, 5}:        "こどもの日",
-	{2001, time.July, 20}:      "海の日",
-	{2001, time.September, 15}: "敬老の日",
-	{2001, time.September, 23}: "秋分の日",
-	{2001, time.September, 24}: "休日",
-	{2001, time.October, 8}:    "体育の日",
-	{2001, time.November, 3}:   "文化の日",
-	{2001, time.November, 23}:  "勤労感謝の日",
-	{2001, time.December, 23}:  "天皇誕生日",
-	{2001, time.December, 24}:  "休日",
+	20010101: "元日",
+	20010108: "成人の日",
+	20010211: "建国記念の日",
+	20010212: "休日",
+	20010320: "春分の日",
+	20010429: "みどりの日",
+	20010430: "休日",
+	20010503: "憲法記念日",
+	20010504: "休日",
+	20010505: "こどもの日",
+	20010720: "海の日",
+	20010915: "敬老の日",
+	20010923: "秋分の日",
+	20010924: "休日",
+	20011008: "体育の日",
+	20011103: "文化の日",
+	20011123: "勤労感謝の日",
+	20011223: "天皇誕生日",
+	20011224: "休日",
 
 	// 2002
-	{2002, time.January, 1}:    "元日",
-	{2002, time.January, 14}:   "成人の日",
-	{2002, time.February, 11}:  "建国記念の日",
-	{2002, time.March, 21}:     "春分の日",
-	{2002, time.April, 29}:     "みどりの日",
-	{2002, time.May, 3}:        "憲法記念日",
-	{2002, time.May, 4}:        "休日",
-	{2002, time.May, 5}:        "こどもの日",
-	{2002, time.May, 6}:        "休日",
-	{2002, time.July, 20}:      "海の日",
-	{2002, time.September, 15}: "敬老の日",
-	{2002, time.September, 16}: "休日",
-	{2002, time.September, 23}: "秋分の日",
-	{2002, time.October, 14}:   "体育の日",
-	{2002, time.November, 3}:   "文化の日",
-	{2002, time.November, 4}:   "休日",
-	{2002, time.November, 23}:  "勤労感謝の日",
-	{2002, time.December, 23}:  "天皇誕生日",
+	20020101: "元日",
+	20020114: "成人の日",
+	20020211: "建国記念の日",
+	20020321: "春分の日",
+	20020429: "みどりの日",
+	20020503: "憲法記念日",
+	20020504: "休日",
+	20020505: "こどもの日",
+	20020506: "休日",
+	20020720: "海の日",
+	20020915: "敬老の日",
+	20020916: "休日",
+	20020923: "秋分の日",
+	20021014: "体育の日",
+	20021103: "文化の日",
+	20021104: "休日",
+	20021123: "勤労感謝の日",
+	20021223: "天皇誕生日",
 
 	// 2003
-	{2003, time.January, 1}:    "元日",
-	{2003, time.January, 13}:   "成人の日",
-	{2003, time.February, 11}:  "建国記念の日",
-	{2003, time.March, 21}:     "春分の日",
-	{2003, time.April, 29}:     "みどりの日",
-	{2003, time.May, 3}:        "憲法記念日",
-	{2003, time.May, 5}:        "こどもの日",
-	{2003, time.July, 21}:      "海の日",
-	{2003, time.September, 15}: "敬老の日",
-	{2003, time.September, 23}: "秋分の日",
-	{2003, time.October, 13}:   "体育の日",
-	{2003, time.November, 3}:   "文化の日",
-	{2003, time.November, 23}:  "勤労感謝の日",
-	{2003, time.November, 24}:  "休日",
-	{2003, time.December, 23}:  "天皇誕生日",
+	20030101: "元日",
+	20030113: "成人の日",
+	20030211: "建国記念の日",
+	20030321: "春分の日",
+	20030429: "みどりの日",
+	20030503: "憲法記念日",
+	20030505: "こどもの日",
+	20030721: "海の日",
+	20030915: "敬老の日",
+	20030923: "秋分の日",
+	20031013: "体育の日",
+	20031103: "文化の日",
+	20031123: "勤労感謝の日",
+	20031124: "休日",
+	20031223: "天皇誕生日",
 
 	// 2004
-	{2004, time.January, 1}:    "元日",
-	{2004, time.January, 12}:   "成人の日",
-	{2004, time.February, 11}:  "建国記念の日",
-	{2004, time.March, 20}:     "春分の日",
-	{2004, time.April, 29}:     "みどりの日",
-	{2004, time.May, 3}:        "憲法記念日",
-	{2004, time.May, 4}:        "休日",
-	{2004, time.May, 5}:        "こどもの日",
-	{2004, time.July, 19}:      "海の日",
-	{2004, time.September, 20}: "敬老の日",
-	{2004, time.September, 23}: "秋分の日",
-	{2004, time.October, 11}:   "体育の日",
-	{2004, time.November, 3}:   "文化の日",
-	{2004, time.November, 23}:  "勤労感謝の日",
-	{2004, time.December, 23}:  "天皇誕生日",
+	20040101: "元日",
+	20040112: "成人の日",
+	20040211: "建国記念の日",
+	20040320: "春分の日",
+	20040429: "みどりの日",
+	20040503: "憲法記念日",
+	20040504: "休日",
+	20040505: "こどもの日",
+	20040719: "海の日",
+	20040920: "敬老の日",
+	20040923: "秋分の日",
+	20041011: "体育の日",
+	20041103: "文化の日",
+	20041123: "勤労感謝の日",
+	20041223: "天皇誕生日",
 
 	// 2005
-	{2005, time.January, 1}:    "元日",
-	{2005, time.January, 10}:   "成人の日",
-	{2005, time.February, 11}:  "建国記念の日",
-	{2005, time.March, 20}:     "春分の日",
-	{2005, time.March, 21}:     "休日",
-	{2005, time.April, 29}:     "みどりの日",
-	{2005, time.May, 3}:        "憲法記念日",
-	{2005, time.May, 4}:        "休日",
-	{2005, time.May, 5}:        "こどもの日",
-	{2005, time.July, 18}:      "海の日",
-	{2005, time.September, 19}: "敬老の日",
-	{2005, time.September, 23}: "秋分の日",
-	{2005, time.October, 10}:   "体育の日",
-	{2005, time.November, 3}:   "文化の日",
-	{2005, time.November, 23}:  "勤労感謝の日",
-	{2005, time.December, 23}:  "天皇誕生日",
+	20050101: "元日",
+	20050110: "成人の日",
+	20050211: "建国記念の日",
+	20050320: "春分の日",
+	20050321: "休日",
+	20050429: "みどりの日",
+	20050503: "憲法記念日",
+	20050504: "休日",
+	20050505: "こどもの日",
+	20050718: "海の日",
+	20050919: "敬老の日",
+	20050923: "秋分の日",
+	20051010: "体育の日",
+	20051103: "文化の日",
+	20051123: "勤労感謝の日",
+	20051223: "天皇誕生日",
 
 	// 2006
-	{2006, time.January, 1}:    "元日",
-	{2006, time.January, 2}:    "休日",
-	{2006, time.January, 9}:    "成人の日",
-	{2006, time.February, 11}:  "建国記念の日",
-	{2006, time.March, 21}:     "春分の日",
-	{2006, time.April, 29}:     "みどりの日",
-	{2006, time.May, 3}:        "憲法記念日",
-	{2006, time.May, 4}:        "休日",
-	{2006, time.May, 5}:        "こどもの日",
-	{2006, time.July, 17}:      "海の日",
-	{2006, time.September, 18}: "敬老の日",
-	{2006, time.September, 23}: "秋分の日",
-	{2006, time.October, 9}:    "体育の日",
-	{2006, time.November, 3}:   "文化の日",
-	{2006, time.November, 23}:  "勤労感謝の日",
-	{2006, time.December, 23}:  "天皇誕生日",
+	20060101: "元日",
+	20060102: "休日",
+	20060109: "成人の日",
+	20060211: "建国記念の日",
+	20060321: "春分の日",
+	20060429: "みどりの日",
+	20060503: "憲法記念日",
+	20060504: "休日",
+	20060505: "こどもの日",
+	20060717: "海の日",
+	20060918: "敬老の日",
+	20060923: "秋分の日",
+	20061009: "体育の日",
+	20061103: "文化の日",
+	20061123: "勤労感謝の日",
+	20061223: "天皇誕生日",
 
 	// 2007
-	{2007, time.January, 1}:    "元日",
-	{2007, time.January, 8}:    "成人の日",
-	{2007, time.February, 11}:  "建国記念の日",
-	{2007, time.February, 12}:  "休日",
-	{2007, time.March, 21}:     "春分の日",
-	{2007, time.April, 29}:     "昭和の日",
-	{2007, time.April, 30}:     "休日",
-	{2007, time.May, 3}:        "憲法記念日",
-	{2007, time.May, 4}:        "みどりの日",
-	{2007, time.May, 5}:        "こどもの日",
-	{2007, time.July, 16}:      "海の日",
-	{2007, time.September, 17}: "敬老の日",
-	{2007, time.September, 23}: "秋分の日",
-	{2007, time.September, 24}: "休日",
-	{2007, time.October, 8}:    "体育の日",
-	{2007, time.November, 3}:   "文化の日",
-	{2007, time.November, 23}:  "勤労感謝の日",
-	{2007, time.December, 23}:  "天皇誕生日",
-	{2007, time.December, 24}:  "休日",
+	20070101: "元日",
+	20070108: "成人の日",
+	20070211: "建国記念の日",
+	20070212: "休日",
+	20070321: "春分の日",
+	20070429: "昭和の日",
+	20070430: "休日",
+	20070503: "憲法記念日",
+	20070504: "みどりの日",
+	20070505: "こどもの日",
+	20070716: "海の日",
+	20070917: "敬老の日",
+	20070923: "秋分の日",
+	20070924: "休日",
+	20071008: "体育の日",
+	20071103: "文化の日",
+	20071123: "勤労感謝の日",
+	20071223: "天皇誕生日",
+	20071224: "休日",
 
 	// 2008
-	{2008, time.January, 1}:    "元日",
-	{2008, time.January, 14}:   "成人の日",
-	{2008, time.February, 11}:  "建国記念の日",
-	{2008, time.March, 20}:     "春分の日",
-	{2008, time.April, 29}:     "昭和の日",
-	{2008, time.May, 3}:        "憲法記念日",
-	{2008, time.May, 4}:        "みどりの日",
-	{2008, time.May, 5}:        "こどもの日",
-	{2008, time.May, 6}:        "休日",
-	{2008, time.July, 21}:      "海の日",
-	{2008, time.September, 15}: "敬老の日",
-	{2008, time.September, 23}: "秋分の日",
-	{2008, time.October, 13}:   "体育の日",
-	{2008, time.November, 3}:   "文化の日",
-	{2008, time.November, 23}:  "勤労感謝の日",
-	{2008, time.November, 24}:  "休日",
-	{2008, time.December, 23}:  "天皇誕生日",
+	20080101: "元日",
+	20080114: "成人の日",
+	20080211: "建国記念の日",
+	20080320: "春分の日",
+	20080429: "昭和の日",
+	20080503: "憲法記念日",
+	20080504: "みどりの日",
+	20080505: "こどもの日",
+	20080506: "休日",
+	20080721: "海の日",
+	20080915: "敬老の日",
+	20080923: "秋分の日",
+	20081013: "体育の日",
+	20081103: "文化の日",
+	20081123: "勤労感謝の日",
+	20081124: "休日",
+	20081223: "天皇誕生日",
 
 	// 2009
-	{2009, time.January, 1}:    "元日",
-	{2009, time.January, 12}:   "成人の日",
-	{2009, time.February, 11}:  "建国記念の日",
-	{2009, time.March, 20}:     "春分の日",
-	{2009, time.April, 29}:     "昭和の日",
-	{2009, time.May, 3}:        "憲法記念日",
-	{2009, time.May, 4}:        "みどりの日",
-	{2009, time.May, 5}:        "こどもの日",
-	{2009, time.May, 6}:        "休日",
-	{2009, time.July, 20}:      "海の日",
-	{2009, time.September, 21}: "敬老の日",
-	{2009, time.September, 22}: "休日",
-	{2009, time.September, 23}: "秋分の日",
-	{2009, time.October, 12}:   "体育の日",
-	{2009, time.November, 3}:   "文化の日",
-	{2009, time.November, 23}:  "勤労感謝の日",
-	{2009, time.December, 23}:  "天皇誕生日",
+	20090101: "元日",
+	20090112: "成人の日",
+	20090211: "建国記念の日",
+	20090320: "春分の日",
+	20090429: "昭和の日",
+	20090503: "憲法記念日",
+	20090504: "みどりの日",
+	20090505: "こどもの日",
+	20090506: "休日",
+	20090720: "海の日",
+	20090921: "敬老の日",
+	20090922: "休日",
+	20090923: "秋分の日",
+	20091012: "体育の日",
+	20091103: "文化の日",
+	20091123: "勤労感謝の日",
+	20091223: "天皇誕生日",
 
 	// 2010
-	{2010, time.January, 1}:    "元日",
-	{2010, time.January, 11}:   "成人の日",
-	{2010, time.February, 11}:  "建国記念の日",
-	{2010, time.March, 21}:     "春分の日",
-	{2010, time.March, 22}:     "休日",
-	{2010, time.April, 29}:     "昭和の日",
-	{2010, time.May, 3}:        "憲法記念日",
-	{2010, time.May, 4}:        "みどりの日",
-	{2010, time.May, 5}:        "こどもの日",
-	{2010, time.July, 19}:      "海の日",
-	{2010, time.September, 20}: "敬老の日",
-	{2010, time.September, 23}: "秋分の日",
-	{2010, time.October, 11}:   "体育の日",
-	{2010, time.November, 3}:   "文化の日",
-	{2010, time.November, 23}:  "勤労感謝の日",
-	{2010, time.December, 23}:  "天皇誕生日",
+	20100101: "元日",
+	20100111: "成人の日",
+	20100211: "建国記念の日",
+	20100321: "春分の日",
+	20100322: "休日",
+	20100429: "昭和の日",
+	20100503: "憲法記念日",
+	20100504: "みどりの日",
+	20100505: "こどもの日",
+	20100719: "海の日",
+	20100920: "敬老の日",
+	20100923: "秋分の日",
+	20101011: "体育の日",
+	20101103: "文化の日",
+	20101123: "勤労感謝の日",
+	20101223: "天皇誕生日",
 
 	// 2011
-	{2011, time.January, 1}:    "元日",
-	{2011, time.January, 10}:   "成人の日",
-	{2011, time.February, 11}:  "建国記念の日",
-	{2011, time.March, 21}:     "春分の日",
-	{2011, time.April, 29}:     "昭和の日",
-	{2011, time.May, 3}:        "憲法記念日",
-	{2011, time.May, 4}:        "みどりの日",
-	{2011, time.May, 5}:        "こどもの日",
-	{2011, time.July, 18}:      "海の日",
-	{2011, time.September, 19}: "敬老の日",
-	{2011, time.September, 23}: "秋分の日",
-	{2011, time.October, 10}:   "体育の日",
-	{2011, time.November, 3}:   "文化の日",
-	{2011, time.November, 23}:  "勤労感謝の日",
-	{2011, time.December, 23}:  "天皇誕生日",
+	20110101: "元日",
+	20110110: "成人の日",
+	20110211: "建国記念の日",
+	20110321: "春分の日",
+	20110429: "昭和の日",
+	20110503: "憲法記念日",
+	20110504: "みどりの日",
+	20110505: "こどもの日",
+	20110718: "海の日",
+	20110919: "敬老の日",
+	20110923: "秋分の日",
+	20111010: "体育の日",
+	20111103: "文化の日",
+	20111123: "勤労感謝の日",
+	20111223: "天皇誕生日",
 
 	// 2012
-	{2012, time.January, 1}:    "元日",
-	{2012, time.January, 2}:    "休日",
-	{2012, time.January, 9}:    "成人の日",
-	{2012, time.February, 11}:  "建国記念の日",
-	{2012, time.March, 20}:     "春分の日",
-	{2012, time.April, 29}:     "昭和の日",
-	{2012, time.April, 30}:     "休日",
-	{2012, time.May, 3}:        "憲法記念日",
-	{2012, time.May, 4}:        "みどりの日",
-	{2012, time.May, 5}:        "こどもの日",
-	{2012, time.July, 16}:      "海の日",
-	{2012, time.September, 17}: "敬老の日",
-	{2012, time.September, 22}: "秋分の日",
-	{2012, time.October, 8}:    "体育の日",
-	{2012, time.November, 3}:   "文化の日",
-	{2012, time.November, 23}:  "勤労感謝の日",
-	{2012, time.December, 23}:  "天皇誕生日",
-	{2012, time.December, 24}:  "休日",
+	20120101: "元日",
+	20120102: "休日",
+	20120109: "成人の日",
+	20120211: "建国記念の日",
+	20120320: "春分の日",
+	20120429: "昭和の日",
+	20120430: "休日",
+	20120503: "憲法記念日",
+	20120504: "みどりの日",
+	20120505: "こどもの日",
+	20120716: "海の日",
+	20120917: "敬老の日",
+	20120922: "秋分の日",
+	20121008: "体育の日",
+	20121103: "文化の日",
+	20121123: "勤労感謝の日",
+	20121223: "天皇誕生日",
+	20121224: "休日",
 
 	// 2013
-	{2013, time.January, 1}:    "元日",
-	{2013, time.January, 14}:   "成人の日",
-	{2013, time.February, 11}:  "建国記念の日",
-	{2013, time.March, 20}:     "春分の日",
-	{2013, time.April, 29}:     "昭和の日",
-	{2013, time.May, 3}:        "憲法記念日",
-	{2013, time.May, 4}:        "みどりの日",
-	{2013, time.May, 5}:        "こどもの日",
-	{2013, time.May, 6}:        "休日",
-	{2013, time.July, 15}:      "海の日",
-	{2013, time.September, 16}: "敬老の日",
-	{2013, time.September, 23}: "秋分の日",
-	{2013, time.October, 14}:   "体育の日",
-	{2013, time.November, 3}:   "文化の日",
-	{2013, time.November, 4}:   "休日",
-	{2013, time.November, 23}:  "勤労感謝の日",
-	{2013, time.December, 23}:  "天皇誕生日",
+	20130101: "元日",
+	20130114: "成人の日",
+	20130211: "建国記念の日",
+	20130320: "春分の日",
+	20130429: "昭和の日",
+	20130503: "憲法記念日",
+	20130504: "みどりの日",
+	20130505: "こどもの日",
+	20130506: "休日",
+	20130715: "海の日",
+	20130916: "敬老の日",
+	20130923: "秋分の日",
+	20131014: "体育の日",
+	20131103: "文化の日",
+	20131104: "休日",
+	20131123: "勤労感謝の日",
+	20131223: "天皇誕生日",
 
 	// 2014
-	{2014, time.January, 1}:    "元日",
-	{2014, time.January, 13}:   "成人の日",
-	{2014, time.February, 11}:  "建国記念の日",
-	{2014, time.March, 21}:     "春分の日",
-	{2014, time.April, 29}:     "昭和の日",
-	{2014, time.May, 3}:        "憲法記念日",
-	{2014, time.May, 4}:        "みどりの日",
-	{2014, time.May, 5}:        "こどもの日",
-	{2014, time.May, 6}:        "休日",
-	{2014, time.July, 21}:      "海の日",
-	{2014, time.September, 15}: "敬老の日",
-	{2014, time.September, 23}: "秋分の日",
-	{2014, time.October, 13}:   "体育の日",
-	{2014, time.November, 3}:   "文化の日",
-	{2014, time.November, 23}:  "勤労感謝の日",
-	{2014, time.November, 24}:  "休日",
-	{2014, time.December, 23}:  "天皇誕生日",
+	20140101: "元日",
+	20140113: "成人の日",
+	20140211: "建国記念の日",
+	20140321: "春分の日",
+	20140429: "昭和の日",
+	20140503: "憲法記念日",
+	20140504: "みどりの日",
+	20140505: "こどもの日",
+	20140506: "休日",
+	20140721: "海の日",
+	20140915: "敬老の日",
+	20140923: "秋分の日",
+	20141013: "体育の日",
+	20141103: "文化の日",
+	20141123: "勤労感謝の日",
+	20141124: "休日",
+	20141223: "天皇誕生日",
 
 	// 2015
-	{2015, time.January, 1}:    "元日",
-	{2015, time.January, 12}:   "成人の日",
-	{2015, time.February, 11}:  "建国記念の日",
-	{2015, time.March, 21}:     "春分の日",
-	{2015, time.April, 29}:     "昭和の日",
-	{2015, time.May, 3}:        "憲法記念日",
-	{2015, time.May, 4}:        "みどりの日",
-	{2015, time.May, 5}:        "こどもの日",
-	{2015, time.May, 6}:        "休日",
-	{2015, time.July, 20}:      "海の日",
-	{2015, time.September, 21}: "敬老の日",
-	{2015, time.September, 22}: "休日",
-	{2015, time.September, 23}: "秋分の日",
-	{2015, time.October, 12}:   "体育の日",
-	{2015, time.November, 3}:   "文化の日",
-	{2015, time.November, 23}:  "勤労感謝の日",
-	{2015, time.December, 23}:  "天皇誕生日",
+	20150101: "元日",
+	20150112: "成人の日",
+	20150211: "建国記念の日",
+	20150321: "春分の日",
+	20150429: "昭和の日",
+	20150503: "憲法記念日",
+	20150504: "みどりの日",
+	20150505: "こどもの日",
+	20150506: "休日",
+	20150720: "海の日",
+	20150921: "敬老の日",
+	20150922: "休日",
+	20150923: "秋分の日",
+	20151012: "体育の日",
+	20151103: "文化の日",
+	20151123: "勤労感謝の日",
+	20151223: "天皇誕生日",
 
 	// 2016
-	{2016, time.January, 1}:    "元日",
-	{2016, time.January, 11}:   "成人の日",
-	{2016, time.February, 11}:  "建国記念の日",
-	{2016, time.March, 20}:     "春分の日",
-	{2016, time.March, 21}:     "休日",
-	{2016, time.April, 29}:     "昭和の日",
-	{2016, time.May, 3}:        "憲法記念日",
-	{2016, time.May, 4}:        "みどりの日",
-	{2016, time.May, 5}:        "こどもの日",
-	{2016, time.July, 18}:      "海の日",
-	{2016, time.August, 11}:    "山の日",
-	{2016, time.September, 19}: "敬老の日",
-	{2016, time.September, 22}: "秋分の日",
-	{2016, time.October, 10}:   "体育の日",
-	{2016, time.November, 3}:   "文化の日",
-	{2016, time.November, 23}:  "勤労感謝の日",
-	{2016, time.December, 23}:  "天皇誕生日",
+	20160101: "元日",
+	20160111: "成人の日",
+	20160211: "建国記念の日",
+	20160320: "春分の日",
+	20160321: "休日",
+	20160429: "昭和の日",
+	20160503: "憲法記念日",
+	20160504: "みどりの日",
+	20160505: "こどもの日",
+	20160718: "海の日",
+	20160811: "山の日",
+	20160919: "敬老の日",
+	20160922: "秋分の日",
+	20161010: "体育の日",
+	20161103: "文化の日",
+	20161123: "勤労感謝の日",
+	20161223: "天皇誕生日",
 
 	// 2017
-	{2017, time.January, 1}:    "元日",
-	{2017, time.January, 2}:    "休日",
-	{2017, time.January, 9}:    "成人の日",
-	{2017, time.February, 11}:  "建国記念の日",
-	{2017, time.March, 20}:     "春分の日",
-	{2017, time.April, 29}:     "昭和の日",
-	{2017, time.May, 3}:        "憲法記念日",
-	{2017, time.May, 4}:        "みどりの日",
-	{2017, time.May, 5}:        "こどもの日",
-	{2017, time.July, 17}:      "海の日",
-	{2017, time.August, 11}:    "山の日",
-	{2017, time.September, 18}: "敬老の日",
-	{2017, time.September, 23}: "秋分の日",
-	{2017, time.October, 9}:    "体育の日",
-	{2017, time.November, 3}:   "文化の日",
-	{2017, time.November, 23}:  "勤労感謝の日",
-	{2017, time.December, 23}:  "天皇誕生日",
+	20170101: "元日",
+	20170102: "休日",
+	20170109: "成人の日",
+	20170211: "建国記念の日",
+	20170320: "春分の日",
+	20170429: "昭和の日",
+	20170503: "憲法記念日",
+	20170504: "みどりの日",
+	20170505: "こどもの日",
+	20170717: "海の日",
+	20170811: "山の日",
+	20170918: "敬老の日",
+	20170923: "秋分の日",
+	20171009: "体育の日",
+	20171103: "文化の日",
+	20171123: "勤労感謝の日",
+	20171223: "天皇誕生日",
 
 	// 2018
-	{2018, time.January, 1}:    "元日",
-	{2018, time.January, 8}:    "成人の日",
-	{2018, time.February, 11}:  "建国記念の日",
-	{2018, time.February, 12}:  "休日",
-	{2018, time.March, 21}:     "春分の日",
-	{2018, time.April, 29}:     "昭和の日",
-	{2018, time.April, 30}:     "休日",
-	{2018, time.May, 3}:        "憲法記念日",
-	{2018, time.May, 4}:        "みどりの日",
-	{2018, time.May, 5}:        "こどもの日",
-	{2018, time.July, 16}:      "海の日",
-	{2018, time.August, 11}:    "山の日",
-	{2018, time.September, 17}: "敬老の日",
-	{2018, time.September, 23}: "秋分の日",
-	{2018, time.September, 24}: "休日",
-	{2018, time.October, 8}:    "体育の日",
-	{2018, time.November, 3}:   "文化の日",
-	{2018, time.November, 23}:  "勤労感謝の日",
-	{2018, time.December, 23}:  "天皇誕生日",
-	{2018, time.December, 24}:  "休日",
+	20180101: "元日",
+	20180108: "成人の日",
+	20180211: "建国記念の日",
+	20180212: "休日",
+	20180321: "春分の日",
+	20180429: "昭和の日",
+	20180430: "休日",
+	20180503: "憲法記念日",
+	20180504: "みどりの日",
+	20180505: "こどもの日",
+	20180716: "海の日",
+	20180811: "山の日",
+	20180917: "敬老の日",
+	20180923: "秋分の日",
+	20180924: "休日",
+	20181008: "体育の日",
+	20181103: "文化の日",
+	20181123: "勤労感謝の日",
+	20181223: "天皇誕生日",
+	20181224: "休日",
 
 	// 2019
-	{2019, time.January, 1}:    "元日",
-	{2019, time.January, 14}:   "成人の日",
-	{2019, time.February, 11}:  "建国記念の日",
-	{2019, time.March, 21}:     "春分の日",
-	{2019, time.April, 29}:     "昭和の日",
-	{2019, time.April, 30}:     "休日",
-	{2019, time.May, 1}:        "休日（祝日扱い）",
-	{2019, time.May, 2}:        "休日",
-	{2019, time.May, 3}:        "憲法記念日",
-	{2019, time.May, 4}:        "みどりの日",
-	{2019, time.May, 5}:        "こどもの日",
-	{2019, time.May, 6}:        "休日",
-	{2019, time.July, 15}:      "海の日",
-	{2019, time.August, 11}:    "山の日",
-	{2019, time.August, 12}:    "休日",
-	{2019, time.September, 16}: "敬老の日",
-	{2019, time.September, 23}: "秋分の日",
-	{2019, time.October, 14}:   "体育の日（スポーツの日）",
-	{2019, time.October, 22}:   "休日（祝日扱い）",
-	{2019, time.November, 3}:   "文化の日",
-	{2019, time.November, 4}:   "休日",
-	{2019, time.November, 23}:  "勤労感謝の日",
+	20190101: "元日",
+	20190114: "成人の日",
+	20190211: "建国記念の日",
+	20190321: "春分の日",
+	20190429: "昭和の日",
+	20190430: "休日",
+	20190501: "休日（祝日扱い）",
+	20190502: "休日",
+	20190503: "憲法記念日",
+	20190504: "みどりの日",
+	20190505: "こどもの日",
+	20190506: "休日",
+	20190715: "海の日",
+	20190811: "山の日",
+	20190812: "休日",
+	20190916: "敬老の日",
+	20190923: "秋分の日",
+	20191014: "体育の日（スポーツの日）",
+	20191022: "休日（祝日扱い）",
+	20191103: "文化の日",
+	20191104: "休日",
+	20191123: "勤労感謝の日",
 
 	// 2020
-	{2020, time.January, 1}:    "元日",
-	{2020, time.January, 13}:   "成人の日",
-	{2020, time.February, 11}:  "建国記念の日",
-	{2020, time.February, 23}:  "天皇誕生日",
-	{2020, time.February, 24}:  "休日",
-	{2020, time.March, 20}:     "春分の日",
-	{2020, time.April, 29}:     "昭和の日",
-	{2020, time.May, 3}:        "憲法記念日",
-	{2020, time.May, 4}:        "みどりの日",
-	{2020, time.May, 5}:        "こどもの日",
-	{2020, time.May, 6}:        "休日",
-	{2020, time.July, 23}:      "海の日",
-	{2020, time.July, 24}:      "スポーツの日",
-	{2020, time.August, 10}:    "山の日",
-	{2020, time.September, 21}: "敬老の日",
-	{2020, time.September, 22}: "秋分の日",
-	{2020, time.November, 3}:   "文化の日",
-	{2020, time.November, 23}:  "勤労感謝の日",
+	20200101: "元日",
+	20200113: "成人の日",
+	20200211: "建国記念の日",
+	20200223: "天皇誕生日",
+	20200224: "休日",
+	20200320: "春分の日",
+	20200429: "昭和の日",
+	20200503: "憲法記念日",
+	20200504: "みどりの日",
+	20200505: "こどもの日",
+	20200506: "休日",
+	20200723: "海の日",
+	20200724: "スポーツの日",
+	20200810: "山の日",
+	20200921: "敬老の日",
+	20200922: "秋分の日",
+	20201103: "文化の日",
+	20201123: "勤労感謝の日",
 
 	// 2021
-	{2021, time.January, 1}:    "元日",
-	{2021, time.January, 11}:   "成人の日",
-	{2021, time.February, 11}:  "建国記念の日",
-	{2021, time.February, 23}:  "天皇誕生日",
-	{2021, time.March, 20}:     "春分の日",
-	{2021, time.April, 29}:     "昭和の日",
-	{2021, time.May, 3}:        "憲法記念日",
-	{2021, time.May, 4}:        "みどりの日",
-	{2021, time.May, 5}:        "こどもの日",
-	{2021, time.July, 22}:      "海の日",
-	{2021, time.July, 23}:      "スポーツの日",
-	{2021, time.August, 8}:     "山の日",
-	{2021, time.August, 9}:     "休日",
-	{2021, time.September, 20}: "敬老の日",
-	{2021, time.September, 23}: "秋分の日",
-	{2021, time.November, 3}:   "文化の日",
-	{2021, time.November, 23}:  "勤労感謝の日",
+	20210101: "元日",
+	20210111: "成人の日",
+	20210211: "建国記念の日",
+	20210223: "天皇誕生日",
+	20210320: "春分の日",
+	20210429: "昭和の日",
+	20210503: "憲法記念日",
+	20210504: "みどりの日",
+	20210505: "こどもの日",
+	20210722: "海の日",
+	20210723: "スポーツの日",
+	20210808: "山の日",
+	20210809: "休日",
+	20210920: "敬老の日",
+	20210923: "秋分の日",
+	20211103: "文化の日",
+	20211123: "勤労感謝の日",
 
 	// 2022
-	{2022, time.January, 1}:    "元日",
-	{2022, time.January, 10}:   "成人の日",
-	{2022, time.February, 11}:  "建国記念の日",
-	{2022, time.February, 23}:  "天皇誕生日",
-	{2022, time.March, 21}:     "春分の日",
-	{2022, time.April, 29}:     "昭和の日",
-	{2022, time.May, 3}:        "憲法記念日",
-	{2022, time.May, 4}:        "みどりの日",
-	{2022, time.May, 5}:        "こどもの日",
-	{2022, time.July, 18}:      "海の日",
-	{2022, time.August, 11}:    "山の日",
-	{2022, time.September, 19}: "敬老の日",
-	{2022, time.September, 23}: "秋分の日",
-	{2022, time.October, 10}:   "スポーツの日",
-	{2022, time.November, 3}:   "文化の日",
-	{2022, time.November, 23}:  "勤労感謝の日",
+	20220101: "元日",
+	20220110: "成人の日",
+	20220211: "建国記念の日",
+	20220223: "天皇誕生日",
+	20220321: "春分の日",
+	20220429: "昭和の日",
+	20220503: "憲法記念日",
+	20220504: "みどりの日",
+	20220505: "こどもの日",
+	20220718: "海の日",
+	20220811: "山の日",
+	20220919: "敬老の日",
+	20220923: "秋分の日",
+	20221010: "スポーツの日",
+	20221103: "文化の日",
+	20221123: "勤労感謝の日",
 
 	// 2023
-	{2023, time.January, 1}:    "元日",
-	{2023, time.January, 2}:    "休日",
-	{2023, time.January, 9}:    "成人の日",
-	{2023, time.February, 11}:  "建国記念の日",
-	{2023, time.February, 23}:  "天皇誕生日",
-	{2023, time.March, 21}:     "春分の日",
-	{2023, time.April, 29}:     "昭和の日",
-	{2023, time.May, 3}:        "憲法記念日",
-	{2023, time.May, 4}:        "みどりの日",
-	{2023, time.May, 5}:        "こどもの日",
-	{2023, time.July, 17}:      "海の日",
-	{2023, time.August, 11}:    "山の日",
-	{2023, time.September, 18}: "敬老の日",
-	{2023, time.September, 23}: "秋分の日",
-	{2023, time.October, 9}:    "スポーツの日",
-	{2023, time.November, 3}:   "文化の日",
-	{2023, time.November, 23}:  "勤労感謝の日",
+	20230101: "元日",
+	20230102: "休日",
+	20230109: "成人の日",
+	20230211: "建国記念の日",
+	20230223: "天皇誕生日",
+	20230321: "春分の日",
+	20230429: "昭和の日",
+	20230503: "憲法記念日",
+	20230504: "みどりの日",
+	20230505: "こどもの日",
+	20230717: "海の日",
+	20230811: "山の日",
+	20230918: "敬老の日",
+	20230923: "秋分の日",
+	20231009: "スポーツの日",
+	20231103: "文化の日",
+	20231123: "勤労感謝の日",
 
 	// 2024
-	{2024, time.January, 1}:    "元日",
-	{2024, time.January, 8}:    "成人の日",
-	{2024, time.February, 11}:  "建国記念の日",
-	{2024, time.February, 12}:  "休日",
-	{2024, time.February, 23}:  "天皇誕生日",
-	{2024, time.March, 20}:     "春分の日",
-	{2024, time.April, 29}:     "昭和の日",
-	{2024, time.May, 3}:        "憲法記念日",
-	{2024, time.May, 4}:        "みどりの日",
-	{2024, time.May, 5}:        "こどもの日",
-	{2024, time.May, 6}:        "休日",
-	{2024, time.July, 15}:      "海の日",
-	{2024, time.August, 11}:    "山の日",
-	{2024, time.August, 12}:    "休日",
-	{2024, time.September, 16}: "敬老の日",
-	{2024, time.September, 22}: "秋分の日",
-	{2024, time.September, 23}: "休日",
-	{2024, time.October, 14}:   "スポーツの日",
-	{2024, time.November, 3}:   "文化の日",
-	{2024, time.November, 4}:   "休日",
-	{2024, time.November, 23}:  "勤労感謝の日",
+	20240101: "元日",
+	20240108: "成人の日",
+	20240211: "建国記念の日",
+	20240212: "休日",
+	20240223: "天皇誕生日",
+	20240320: "春分の日",
+	20240429: "昭和の日",
+	20240503: "憲法記念日",
+	20240504: "みどりの日",
+	20240505: "こどもの日",
+	20240506: "休日",
+	20240715: "海の日",
+	20240811: "山の日",
+	20240812: "休日",
+	20240916: "敬老の日",
+	20240922: "秋分の日",
+	20240923: "休日",
+	20241014: "スポーツの日",
+	20241103: "文化の日",
+	20241104: "休日",
+	20241123: "勤労感謝の日",
 
 	// 2025
-	{2025, time.January, 1}:    "元日",
-	{2025, time.January, 13}:   "成人の日",
-	{2025, time.February, 11}:  "建国記念の日",
-	{2025, time.February, 23}:  "天皇誕生日",
-	{2025, time.February, 24}:  "休日",
-	{2025, time.March, 20}:     "春分の日",
-	{2025, time.April, 29}:     "昭和の日",
-	{2025, time.May, 3}:        "憲法記念日",
-	{2025, time.May, 4}:        "みどりの日",
-	{2025, time.May, 5}:        "こどもの日",
-	{2025, time.May, 6}:        "休日",
-	{2025, time.July, 21}:      "海の日",
-	{2025, time.August, 11}:    "山の日",
-	{2025, time.September, 15}: "敬老の日",
-	{2025, time.September, 23}: "秋分の日",
-	{2025, time.October, 13}:   "スポーツの日",
-	{2025, time.November, 3}:   "文化の日",
-	{2025, time.November, 23}:  "勤労感謝の日",
-	{2025, time.November, 24}:  "休日",
+	20250101: "元日",
+	20250113: "成人の日",
+	20250211: "建国記念の日",
+	20250223: "天皇誕生日",
+	20250224: "休日",
+	20250320: "春分の日",
+	20250429: "昭和の日",
+	20250503: "憲法記念日",
+	20250504: "みどりの日",
+	20250505: "こどもの日",
+	20250506: "休日",
+	20250721: "海の日",
+	20250811: "山の日",
+	20250915: "敬老の日",
+	20250923: "秋分の日",
+	20251013: "スポーツの日",
+	20251103: "文化の日",
+	20251123: "勤労感謝の日",
+	20251124: "休日",
 
 	// 2026
-	{2026, time.January, 1}:    "元日",
-	{2026, time.January, 12}:   "成人の日",
-	{2026, time.February, 11}:  "建国記念の日",
-	{2026, time.February, 23}:  "天皇誕生日",
-	{2026, time.March, 20}:     "春分の日",
-	{2026, time.April, 29}:     "昭和の日",
-	{2026, time.May, 3}:        "憲法記念日",
-	{2026, time.May, 4}:        "みどりの日",
-	{2026, time.May, 5}:        "こどもの日",
-	{2026, time.May, 6}:        "休日",
-	{2026, time.July, 20}:      "海の日",
-	{2026, time.August, 11}:    "山の日",
-	{2026, time.September, 21}: "敬老の日",
-	{2026, time.September, 22}: "休日",
-	{2026, time.September, 23}: "秋分の日",
-	{2026, time.October, 12}:   "スポーツの日",
-	{2026, time.November, 3}:   "文化の日",
-	{2026, time.November, 23}:  "勤労感謝の日",
+	20260101: "元日",
+	20260112: "成人の日",
+	20260211: "建国記念の日",
+	20260223: "天皇誕生日",
+	20260320: "春分の日",
+	20260429: "昭和の日",
+	20260503: "憲法記念日",
+	20260504: "みどりの日",
+	20260505: "こどもの日",
+	20260506: "休日",
+	20260720: "海の日",
+	20260811: "山の日",
+	20260921: "敬老の日",
+	20260922: "休日",
+	20260923: "秋分の日",
+	20261012: "スポーツの日",
+	20261103: "文化の日",
+	20261123: "勤労感謝の日",
 
 	// 2027
-	{2027, time.January, 1}:    "元日",
-	{2027, time.January, 11}:   "成人の日",
-	{2027, time.February, 11}:  "建国記念の日",
-	{2027, time.February, 23}:  "天皇誕生日",
-	{2027, time.March, 21}:     "春分の日",
-	{2027, time.March, 22}:     "休日",
-	{2027, time.April, 29}:     "昭和の日",
-	{2027, time.May, 3}:        "憲法記念日",
-	{2027, time.May, 4}:        "みどりの日",
-	{2027, time.May, 5}:        "こどもの日",
-	{2027, time.July, 19}:      "海の日",
-	{2027, time.August, 11}:    "山の日",
-	{2027, time.September, 20}: "敬老の日",
-	{2027, time.September, 23}: "秋分の日",
-	{2027, time.October, 11}:   "スポーツの日",
-	{2027, time.November, 3}:   "文化の日",
-	{2027, time.November, 23}:  "勤労感謝の日",
+	20270101: "元日",
+	20270111: "成人の日",
+	20270211: "建国記念の日",
+	20270223: "天皇誕生日",
+	20270321: "春分の日",
+	20270322: "休日",
+	20270429: "昭和の日",
+	20270503: "憲法記念日",
+	20270504: "みどりの日",
+	20270505: "こどもの日",
+	20270719: "海の日",
+	20270811: "山の日",
+	20270920: "敬老の日",
+	20270923: "秋分の日",
+	20271011: "スポーツの日",
+	20271103: "文化の日",
+	20271123: "勤労感謝の日",
 }
@@ -0,0 +1,42 @@
+// Code generated by cmd/genholidays; DO NOT EDIT.
+
+//go:build jpholiday_csv
+
+package jpholiday
+
+import "time"
+
+var builtinDataset = DatasetMetadata{
+	SourceURL: "https://www8.cao.go.jp/chosei/shukujitsu/syukujitsu.csv",
+	FetchedAt: time.Date(2026, time.October, 16, 3, 24, 34, 0, time.UTC),
+	SHA256:    "cec37a743c96995cdb9cb52b685c9003634682a9b0e1a640a6b9b96881fe964a",
+	Rows:      1067,
+}
+
+const builtinChecksum = "8c6f05318f7ff762665028b170a29b51007d2e6ab453232bff4ee175f6300297"
+
+var builtinEnglishNames = map[string]string{
+	"こどもの日":        "Children's Day",
+	"みどりの日":        "Greenery Day",
+	"スポーツの日":       "Sports Day",
+	"休日":           "Holiday",
+	"休日（祝日扱い）":     "Holiday (treated as a national holiday)",
+	"体育の日":         "Health and Sports Day",
+	"体育の日（スポーツの日）": "Health and Sports Day (Sports Day)",
+	"元日":           "New Year's Day",
+	"勤労感謝の日":       "Labor Thanksgiving Day",
+	"即位礼正殿の儀":      "Enthronement Ceremony",
+	"大喪の礼":         "Funeral Ceremony of Emperor Showa",
+	"天皇誕生日":        "The Emperor's Birthday",
+	"山の日":          "Mountain Day",
+	"建国記念の日":       "National Foundation Day",
+	"憲法記念日":        "Constitution Memorial Day",
+	"成人の日":         "Coming of Age Day",
+	"敬老の日":         "Respect for the Aged Day",
+	"文化の日":         "Culture Day",
+	"春分の日":         "Vernal Equinox Day",
+	"昭和の日":         "Showa Day",
+	"海の日":          "Marine Day",
+	"秋分の日":         "Autumnal Equinox Day",
+	"結婚の儀":         "Imperial Wedding Ceremony",
+}
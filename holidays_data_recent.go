@@ -0,0 +1,583 @@
+// Code generated by cmd/genholidays; DO NOT EDIT.
+
+//go:build jpholiday_recent && !jpholiday_csv
+
+package jpholiday
+
+import "time"
+
+var builtinDataset = DatasetMetadata{
+	SourceURL: "https://www8.cao.go.jp/chosei/shukujitsu/syukujitsu.csv",
+	FetchedAt: time.Date(2026, time.October, 16, 3, 24, 34, 0, time.UTC),
+	SHA256:    "cec37a743c96995cdb9cb52b685c9003634682a9b0e1a640a6b9b96881fe964a",
+	Rows:      486,
+}
+
+const builtinChecksum = "e2284c2da833876aad5237c35d09ec65f5d7b1860064a1e9fe186ae6d241a995"
+
+var builtinEnglishNames = map[string]string{
+	"こどもの日":        "Children's Day",
+	"みどりの日":        "Greenery Day",
+	"スポーツの日":       "Sports Day",
+	"休日":           "Holiday",
+	"休日（祝日扱い）":     "Holiday (treated as a national holiday)",
+	"体育の日":         "Health and Sports Day",
+	"体育の日（スポーツの日）": "Health and Sports Day (Sports Day)",
+	"元日":           "New Year's Day",
+	"勤労感謝の日":       "Labor Thanksgiving Day",
+	"天皇誕生日":        "The Emperor's Birthday",
+	"山の日":          "Mountain Day",
+	"建国記念の日":       "National Foundation Day",
+	"憲法記念日":        "Constitution Memorial Day",
+	"成人の日":         "Coming of Age Day",
+	"敬老の日":         "Respect for the Aged Day",
+	"文化の日":         "Culture Day",
+	"春分の日":         "Vernal Equinox Day",
+	"昭和の日":         "Showa Day",
+	"海の日":          "Marine Day",
+	"秋分の日":         "Autumnal Equinox Day",
+}
+
+var builtinHolidays = map[date]string{
+	// 2000
+	20000101: "元日",
+	20000110: "成人の日",
+	20000211: "建国記念の日",
+	20000320: "春分の日",
+	20000429: "みどりの日",
+	20000503: "憲法記念日",
+	20000504: "休日",
+	20000505: "こどもの日",
+	20000720: "海の日",
+	20000915: "敬老の日",
+	20000923: "秋分の日",
+	20001009: "体育の日",
+	20001103: "文化の日",
+	20001123: "勤労感謝の日",
+	20001223: "天皇誕生日",
+
+	// 2001
+	20010101: "元日",
+	20010108: "成人の日",
+	20010211: "建国記念の日",
+	20010212: "休日",
+	20010320: "春分の日",
+	20010429: "みどりの日",
+	20010430: "休日",
+	20010503: "憲法記念日",
+	20010504: "休日",
+	20010505: "こどもの日",
+	20010720: "海の日",
+	20010915: "敬老の日",
+	20010923: "秋分の日",
+	20010924: "休日",
+	20011008: "体育の日",
+	20011103: "文化の日",
+	20011123: "勤労感謝の日",
+	20011223: "天皇誕生日",
+	20011224: "休日",
+
+	// 2002
+	20020101: "元日",
+	20020114: "成人の日",
+	20020211: "建国記念の日",
+	20020321: "春分の日",
+	20020429: "みどりの日",
+	20020503: "憲法記念日",
+	20020504: "休日",
+	20020505: "こどもの日",
+	20020506: "休日",
+	20020720: "海の日",
+	20020915: "敬老の日",
+	20020916: "休日",
+	20020923: "秋分の日",
+	20021014: "体育の日",
+	20021103: "文化の日",
+	20021104: "休日",
+	20021123: "勤労感謝の日",
+	20021223: "天皇誕生日",
+
+	// 2003
+	20030101: "元日",
+	20030113: "成人の日",
+	20030211: "建国記念の日",
+	20030321: "春分の日",
+	20030429: "みどりの日",
+	20030503: "憲法記念日",
+	20030505: "こどもの日",
+	20030721: "海の日",
+	20030915: "敬老の日",
+	20030923: "秋分の日",
+	20031013: "体育の日",
+	20031103: "文化の日",
+	20031123: "勤労感謝の日",
+	20031124: "休日",
+	20031223: "天皇誕生日",
+
+	// 2004
+	20040101: "元日",
+	20040112: "成人の日",
+	20040211: "建国記念の日",
+	20040320: "春分の日",
+	20040429: "みどりの日",
+	20040503: "憲法記念日",
+	20040504: "休日",
+	20040505: "こどもの日",
+	20040719: "海の日",
+	20040920: "敬老の日",
+	20040923: "秋分の日",
+	20041011: "体育の日",
+	20041103: "文化の日",
+	20041123: "勤労感謝の日",
+	20041223: "天皇誕生日",
+
+	// 2005
+	20050101: "元日",
+	20050110: "成人の日",
+	20050211: "建国記念の日",
+	20050320: "春分の日",
+	20050321: "休日",
+	20050429: "みどりの日",
+	20050503: "憲法記念日",
+	20050504: "休日",
+	20050505: "こどもの日",
+	20050718: "海の日",
+	20050919: "敬老の日",
+	20050923: "秋分の日",
+	20051010: "体育の日",
+	20051103: "文化の日",
+	20051123: "勤労感謝の日",
+	20051223: "天皇誕生日",
+
+	// 2006
+	20060101: "元日",
+	20060102: "休日",
+	20060109: "成人の日",
+	20060211: "建国記念の日",
+	20060321: "春分の日",
+	20060429: "みどりの日",
+	20060503: "憲法記念日",
+	20060504: "休日",
+	20060505: "こどもの日",
+	20060717: "海の日",
+	20060918: "敬老の日",
+	20060923: "秋分の日",
+	20061009: "体育の日",
+	20061103: "文化の日",
+	20061123: "勤労感謝の日",
+	20061223: "天皇誕生日",
+
+	// 2007
+	20070101: "元日",
+	20070108: "成人の日",
+	20070211: "建国記念の日",
+	20070212: "休日",
+	20070321: "春分の日",
+	20070429: "昭和の日",
+	20070430: "休日",
+	20070503: "憲法記念日",
+	20070504: "みどりの日",
+	20070505: "こどもの日",
+	20070716: "海の日",
+	20070917: "敬老の日",
+	20070923: "秋分の日",
+	20070924: "休日",
+	20071008: "体育の日",
+	20071103: "文化の日",
+	20071123: "勤労感謝の日",
+	20071223: "天皇誕生日",
+	20071224: "休日",
+
+	// 2008
+	20080101: "元日",
+	20080114: "成人の日",
+	20080211: "建国記念の日",
+	20080320: "春分の日",
+	20080429: "昭和の日",
+	20080503: "憲法記念日",
+	20080504: "みどりの日",
+	20080505: "こどもの日",
+	20080506: "休日",
+	20080721: "海の日",
+	20080915: "敬老の日",
+	20080923: "秋分の日",
+	20081013: "体育の日",
+	20081103: "文化の日",
+	20081123: "勤労感謝の日",
+	20081124: "休日",
+	20081223: "天皇誕生日",
+
+	// 2009
+	20090101: "元日",
+	20090112: "成人の日",
+	20090211: "建国記念の日",
+	20090320: "春分の日",
+	20090429: "昭和の日",
+	20090503: "憲法記念日",
+	20090504: "みどりの日",
+	20090505: "こどもの日",
+	20090506: "休日",
+	20090720: "海の日",
+	20090921: "敬老の日",
+	20090922: "休日",
+	20090923: "秋分の日",
+	20091012: "体育の日",
+	20091103: "文化の日",
+	20091123: "勤労感謝の日",
+	20091223: "天皇誕生日",
+
+	// 2010
+	20100101: "元日",
+	20100111: "成人の日",
+	20100211: "建国記念の日",
+	20100321: "春分の日",
+	20100322: "休日",
+	20100429: "昭和の日",
+	20100503: "憲法記念日",
+	20100504: "みどりの日",
+	20100505: "こどもの日",
+	20100719: "海の日",
+	20100920: "敬老の日",
+	20100923: "秋分の日",
+	20101011: "体育の日",
+	20101103: "文化の日",
+	20101123: "勤労感謝の日",
+	20101223: "天皇誕生日",
+
+	// 2011
+	20110101: "元日",
+	20110110: "成人の日",
+	20110211: "建国記念の日",
+	20110321: "春分の日",
+	20110429: "昭和の日",
+	20110503: "憲法記念日",
+	20110504: "みどりの日",
+	20110505: "こどもの日",
+	20110718: "海の日",
+	20110919: "敬老の日",
+	20110923: "秋分の日",
+	20111010: "体育の日",
+	20111103: "文化の日",
+	20111123: "勤労感謝の日",
+	20111223: "天皇誕生日",
+
+	// 2012
+	20120101: "元日",
+	20120102: "休日",
+	20120109: "成人の日",
+	20120211: "建国記念の日",
+	20120320: "春分の日",
+	20120429: "昭和の日",
+	20120430: "休日",
+	20120503: "憲法記念日",
+	20120504: "みどりの日",
+	20120505: "こどもの日",
+	20120716: "海の日",
+	20120917: "敬老の日",
+	20120922: "秋分の日",
+	20121008: "体育の日",
+	20121103: "文化の日",
+	20121123: "勤労感謝の日",
+	20121223: "天皇誕生日",
+	20121224: "休日",
+
+	// 2013
+	20130101: "元日",
+	20130114: "成人の日",
+	20130211: "建国記念の日",
+	20130320: "春分の日",
+	20130429: "昭和の日",
+	20130503: "憲法記念日",
+	20130504: "みどりの日",
+	20130505: "こどもの日",
+	20130506: "休日",
+	20130715: "海の日",
+	20130916: "敬老の日",
+	20130923: "秋分の日",
+	20131014: "体育の日",
+	20131103: "文化の日",
+	20131104: "休日",
+	20131123: "勤労感謝の日",
+	20131223: "天皇誕生日",
+
+	// 2014
+	20140101: "元日",
+	20140113: "成人の日",
+	20140211: "建国記念の日",
+	20140321: "春分の日",
+	20140429: "昭和の日",
+	20140503: "憲法記念日",
+	20140504: "みどりの日",
+	20140505: "こどもの日",
+	20140506: "休日",
+	20140721: "海の日",
+	20140915: "敬老の日",
+	20140923: "秋分の日",
+	20141013: "体育の日",
+	20141103: "文化の日",
+	20141123: "勤労感謝の日",
+	20141124: "休日",
+	20141223: "天皇誕生日",
+
+	// 2015
+	20150101: "元日",
+	20150112: "成人の日",
+	20150211: "建国記念の日",
+	20150321: "春分の日",
+	20150429: "昭和の日",
+	20150503: "憲法記念日",
+	20150504: "みどりの日",
+	20150505: "こどもの日",
+	20150506: "休日",
+	20150720: "海の日",
+	20150921: "敬老の日",
+	20150922: "休日",
+	20150923: "秋分の日",
+	20151012: "体育の日",
+	20151103: "文化の日",
+	20151123: "勤労感謝の日",
+	20151223: "天皇誕生日",
+
+	// 2016
+	20160101: "元日",
+	20160111: "成人の日",
+	20160211: "建国記念の日",
+	20160320: "春分の日",
+	20160321: "休日",
+	20160429: "昭和の日",
+	20160503: "憲法記念日",
+	20160504: "みどりの日",
+	20160505: "こどもの日",
+	20160718: "海の日",
+	20160811: "山の日",
+	20160919: "敬老の日",
+	20160922: "秋分の日",
+	20161010: "体育の日",
+	20161103: "文化の日",
+	20161123: "勤労感謝の日",
+	20161223: "天皇誕生日",
+
+	// 2017
+	20170101: "元日",
+	20170102: "休日",
+	20170109: "成人の日",
+	20170211: "建国記念の日",
+	20170320: "春分の日",
+	20170429: "昭和の日",
+	20170503: "憲法記念日",
+	20170504: "みどりの日",
+	20170505: "こどもの日",
+	20170717: "海の日",
+	20170811: "山の日",
+	20170918: "敬老の日",
+	20170923: "秋分の日",
+	20171009: "体育の日",
+	20171103: "文化の日",
+	20171123: "勤労感謝の日",
+	20171223: "天皇誕生日",
+
+	// 2018
+	20180101: "元日",
+	20180108: "成人の日",
+	20180211: "建国記念の日",
+	20180212: "休日",
+	20180321: "春分の日",
+	20180429: "昭和の日",
+	20180430: "休日",
+	20180503: "憲法記念日",
+	20180504: "みどりの日",
+	20180505: "こどもの日",
+	20180716: "海の日",
+	20180811: "山の日",
+	20180917: "敬老の日",
+	20180923: "秋分の日",
+	20180924: "休日",
+	20181008: "体育の日",
+	20181103: "文化の日",
+	20181123: "勤労感謝の日",
+	20181223: "天皇誕生日",
+	20181224: "休日",
+
+	// 2019
+	20190101: "元日",
+	20190114: "成人の日",
+	20190211: "建国記念の日",
+	20190321: "春分の日",
+	20190429: "昭和の日",
+	20190430: "休日",
+	20190501: "休日（祝日扱い）",
+	20190502: "休日",
+	20190503: "憲法記念日",
+	20190504: "みどりの日",
+	20190505: "こどもの日",
+	20190506: "休日",
+	20190715: "海の日",
+	20190811: "山の日",
+	20190812: "休日",
+	20190916: "敬老の日",
+	20190923: "秋分の日",
+	20191014: "体育の日（スポーツの日）",
+	20191022: "休日（祝日扱い）",
+	20191103: "文化の日",
+	20191104: "休日",
+	20191123: "勤労感謝の日",
+
+	// 2020
+	20200101: "元日",
+	20200113: "成人の日",
+	20200211: "建国記念の日",
+	20200223: "天皇誕生日",
+	20200224: "休日",
+	20200320: "春分の日",
+	20200429: "昭和の日",
+	20200503: "憲法記念日",
+	20200504: "みどりの日",
+	20200505: "こどもの日",
+	20200506: "休日",
+	20200723: "海の日",
+	20200724: "スポーツの日",
+	20200810: "山の日",
+	20200921: "敬老の日",
+	20200922: "秋分の日",
+	20201103: "文化の日",
+	20201123: "勤労感謝の日",
+
+	// 2021
+	20210101: "元日",
+	20210111: "成人の日",
+	20210211: "建国記念の日",
+	20210223: "天皇誕生日",
+	20210320: "春分の日",
+	20210429: "昭和の日",
+	20210503: "憲法記念日",
+	20210504: "みどりの日",
+	20210505: "こどもの日",
+	20210722: "海の日",
+	20210723: "スポーツの日",
+	20210808: "山の日",
+	20210809: "休日",
+	20210920: "敬老の日",
+	20210923: "秋分の日",
+	20211103: "文化の日",
+	20211123: "勤労感謝の日",
+
+	// 2022
+	20220101: "元日",
+	20220110: "成人の日",
+	20220211: "建国記念の日",
+	20220223: "天皇誕生日",
+	20220321: "春分の日",
+	20220429: "昭和の日",
+	20220503: "憲法記念日",
+	20220504: "みどりの日",
+	20220505: "こどもの日",
+	20220718: "海の日",
+	20220811: "山の日",
+	20220919: "敬老の日",
+	20220923: "秋分の日",
+	20221010: "スポーツの日",
+	20221103: "文化の日",
+	20221123: "勤労感謝の日",
+
+	// 2023
+	20230101: "元日",
+	20230102: "休日",
+	20230109: "成人の日",
+	20230211: "建国記念の日",
+	20230223: "天皇誕生日",
+	20230321: "春分の日",
+	20230429: "昭和の日",
+	20230503: "憲法記念日",
+	20230504: "みどりの日",
+	20230505: "こどもの日",
+	20230717: "海の日",
+	20230811: "山の日",
+	20230918: "敬老の日",
+	20230923: "秋分の日",
+	20231009: "スポーツの日",
+	20231103: "文化の日",
+	20231123: "勤労感謝の日",
+
+	// 2024
+	20240101: "元日",
+	20240108: "成人の日",
+	20240211: "建国記念の日",
+	20240212: "休日",
+	20240223: "天皇誕生日",
+	20240320: "春分の日",
+	20240429: "昭和の日",
+	20240503: "憲法記念日",
+	20240504: "みどりの日",
+	20240505: "こどもの日",
+	20240506: "休日",
+	20240715: "海の日",
+	20240811: "山の日",
+	20240812: "休日",
+	20240916: "敬老の日",
+	20240922: "秋分の日",
+	20240923: "休日",
+	20241014: "スポーツの日",
+	20241103: "文化の日",
+	20241104: "休日",
+	20241123: "勤労感謝の日",
+
+	// 2025
+	20250101: "元日",
+	20250113: "成人の日",
+	20250211: "建国記念の日",
+	20250223: "天皇誕生日",
+	20250224: "休日",
+	20250320: "春分の日",
+	20250429: "昭和の日",
+	20250503: "憲法記念日",
+	20250504: "みどりの日",
+	20250505: "こどもの日",
+	20250506: "休日",
+	20250721: "海の日",
+	20250811: "山の日",
+	20250915: "敬老の日",
+	20250923: "秋分の日",
+	20251013: "スポーツの日",
+	20251103: "文化の日",
+	20251123: "勤労感謝の日",
+	20251124: "休日",
+
+	// 2026
+	20260101: "元日",
+	20260112: "成人の日",
+	20260211: "建国記念の日",
+	20260223: "天皇誕生日",
+	20260320: "春分の日",
+	20260429: "昭和の日",
+	20260503: "憲法記念日",
+	20260504: "みどりの日",
+	20260505: "こどもの日",
+	20260506: "休日",
+	20260720: "海の日",
+	20260811: "山の日",
+	20260921: "敬老の日",
+	20260922: "休日",
+	20260923: "秋分の日",
+	20261012: "スポーツの日",
+	20261103: "文化の日",
+	20261123: "勤労感謝の日",
+
+	// 2027
+	20270101: "元日",
+	20270111: "成人の日",
+	20270211: "建国記念の日",
+	20270223: "天皇誕生日",
+	20270321: "春分の日",
+	20270322: "休日",
+	20270429: "昭和の日",
+	20270503: "憲法記念日",
+	20270504: "みどりの日",
+	20270505: "こどもの日",
+	20270719: "海の日",
+	20270811: "山の日",
+	20270920: "敬老の日",
+	20270923: "秋分の日",
+	20271011: "スポーツの日",
+	20271103: "文化の日",
+	20271123: "勤労感謝の日",
+}
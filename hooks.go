@@ -0,0 +1,62 @@
+package jpholiday
+
+import (
+	"slices"
+	"time"
+)
+
+// CustomHolidayChange is a change made with [Calendar.AddCustomHoliday] or
+// [Calendar.RemoveCustomHoliday], as passed to the functions registered
+// with [Calendar.OnCustomHolidayChange].
+type CustomHolidayChange struct {
+	Date    time.Time // The date of the custom holiday (midnight UTC).
+	Name    string    // The name added; empty if Removed.
+	Removed bool      // Whether the custom holiday was removed.
+}
+
+// customHook is a function registered with OnCustomHolidayChange. Hooks
+// are compared by pointer so that each registration can be removed alone.
+type customHook struct {
+	fn func(CustomHolidayChange)
+}
+
+// OnCustomHolidayChange registers fn to be called after each call to
+// [Calendar.AddCustomHoliday] or [Calendar.RemoveCustomHoliday], including
+// removals of dates without a custom holiday, for example to write the
+// change back to a shared store. Custom holidays added by other means, such
+// as [Calendar.ApplyConfig] or [Calendar.LoadCustomFromDB], are not
+// reported.
+//
+// fn is called synchronously, before the change method returns, and with
+// other changes to the calendar held off, so that it sees changes in the
+// order they were made; it may read the calendar but must not change it.
+// The returned function unregisters fn.
+func (c *Calendar) OnCustomHolidayChange(fn func(CustomHolidayChange)) (remove func()) {
+	h := &customHook{fn: fn}
+	c.mu.Lock()
+	c.hooks = append(c.hooks, h)
+	c.mu.Unlock()
+	return func() {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		c.hooks = slices.DeleteFunc(c.hooks, func(x *customHook) bool { return x == h })
+	}
+}
+
+// updateCustom is update for a change to a custom holiday, which it reports
+// to the registered hooks.
+func (c *Calendar) updateCustom(change CustomHolidayChange, fn func(s *snapshot)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := c.load().clone()
+	fn(s)
+	c.state.Store(s)
+	for _, h := range c.hooks {
+		h.fn(change)
+	}
+}
+
+// OnCustomHolidayChange registers fn to be called after each change to the custom holidays of the default calendar.
+func OnCustomHolidayChange(fn func(CustomHolidayChange)) (remove func()) {
+	return defaultCal.OnCustomHolidayChange(fn)
+}
@@ -0,0 +1,112 @@
+package httpapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Client is a typed client for the API described in openapi.yaml. The zero
+// value is not usable; create one with [NewClient].
+type Client struct {
+	base string
+	hc   *http.Client
+}
+
+// NewClient returns a client for the API served at baseURL, such as
+// "https://holidays.example.com/api". If hc is nil, http.DefaultClient is
+// used.
+func NewClient(baseURL string, hc *http.Client) *Client {
+	if hc == nil {
+		hc = http.DefaultClient
+	}
+	return &Client{base: strings.TrimSuffix(baseURL, "/"), hc: hc}
+}
+
+// APIError is an error response from the server.
+type APIError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("httpapi: %d %s", e.StatusCode, http.StatusText(e.StatusCode))
+	}
+	return fmt.Sprintf("httpapi: %d %s: %s", e.StatusCode, http.StatusText(e.StatusCode), e.Message)
+}
+
+// HolidaysInYear calls GET /holidays/{year}.
+func (c *Client) HolidaysInYear(ctx context.Context, year int) ([]Holiday, error) {
+	var resp HolidaysResponse
+	if err := c.get(ctx, fmt.Sprintf("/holidays/%04d", year), nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Holidays, nil
+}
+
+// HolidaysBetween calls GET /holidays for the dates of from and to.
+func (c *Client) HolidaysBetween(ctx context.Context, from, to time.Time) ([]Holiday, error) {
+	var resp HolidaysResponse
+	if err := c.get(ctx, "/holidays", rangeQuery(from, to), &resp); err != nil {
+		return nil, err
+	}
+	return resp.Holidays, nil
+}
+
+// IsHoliday calls GET /is_holiday for the date of t.
+func (c *Client) IsHoliday(ctx context.Context, t time.Time) (IsHolidayResponse, error) {
+	var resp IsHolidayResponse
+	err := c.get(ctx, "/is_holiday", url.Values{"date": {formatDate(t)}}, &resp)
+	return resp, err
+}
+
+// BusinessDays calls GET /business_days for the dates of from and to.
+func (c *Client) BusinessDays(ctx context.Context, from, to time.Time) (int, error) {
+	var resp BusinessDaysResponse
+	if err := c.get(ctx, "/business_days", rangeQuery(from, to), &resp); err != nil {
+		return 0, err
+	}
+	return resp.BusinessDays, nil
+}
+
+// rangeQuery formats the from and to parameters. The dates are taken as
+// they are, without conversion to JST, like the other arguments.
+func rangeQuery(from, to time.Time) url.Values {
+	return url.Values{"from": {formatDate(from)}, "to": {formatDate(to)}}
+}
+
+func (c *Client) get(ctx context.Context, path string, query url.Values, v any) error {
+	u := c.base + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		apiErr := &APIError{StatusCode: resp.StatusCode}
+		var body ErrorResponse
+		if json.NewDecoder(io.LimitReader(resp.Body, 1<<16)).Decode(&body) == nil {
+			apiErr.Message = body.Error
+		}
+		return apiErr
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("httpapi: decode %s: %w", path, err)
+	}
+	return nil
+}
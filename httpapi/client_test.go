@@ -0,0 +1,106 @@
+package httpapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"slices"
+	"testing"
+	"time"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+)
+
+func TestClient(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(NewHandler(Options{Calendar: jpholiday.New()}))
+	defer srv.Close()
+	c := NewClient(srv.URL+"/", srv.Client())
+	ctx := context.Background()
+	date := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 0, 0, 0, 0, time.UTC) }
+
+	hs, err := c.HolidaysInYear(ctx, 2026)
+	if err != nil {
+		t.Fatalf("HolidaysInYear error: %v", err)
+	}
+	if len(hs) != len(jpholiday.New().HolidaysInYear(2026)) || hs[0] != (Holiday{Date: "2026-01-01", Name: "元日"}) {
+		t.Errorf("HolidaysInYear = %v", hs)
+	}
+
+	hs, err = c.HolidaysBetween(ctx, date(2026, time.May, 4), date(2026, time.May, 5))
+	if err != nil {
+		t.Fatalf("HolidaysBetween error: %v", err)
+	}
+	if want := []Holiday{{"2026-05-04", "みどりの日"}, {"2026-05-05", "こどもの日"}}; !slices.Equal(hs, want) {
+		t.Errorf("HolidaysBetween = %v, want %v", hs, want)
+	}
+
+	got, err := c.IsHoliday(ctx, date(2026, time.January, 12))
+	if err != nil {
+		t.Fatalf("IsHoliday error: %v", err)
+	}
+	if want := (IsHolidayResponse{Date: "2026-01-12", Holiday: true, Name: "成人の日"}); got != want {
+		t.Errorf("IsHoliday = %+v, want %+v", got, want)
+	}
+
+	n, err := c.BusinessDays(ctx, date(2026, time.April, 27), date(2026, time.May, 8))
+	if err != nil {
+		t.Fatalf("BusinessDays error: %v", err)
+	}
+	if n != 6 {
+		t.Errorf("BusinessDays = %d, want 6", n)
+	}
+
+	_, err = c.BusinessDays(ctx, date(2026, time.May, 8), date(2026, time.May, 1))
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadRequest || apiErr.Message != "to is before from" {
+		t.Errorf("BusinessDays error = %v, want a 400 APIError", err)
+	}
+}
+
+func TestClient_NonJSONError(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.NotFoundHandler())
+	defer srv.Close()
+
+	_, err := NewClient(srv.URL, nil).HolidaysInYear(context.Background(), 2026)
+	if err == nil || err.Error() != "httpapi: 404 Not Found" {
+		t.Errorf("error = %v", err)
+	}
+}
+
+// TestOpenAPI checks that the served document describes exactly the
+// registered endpoints.
+func TestOpenAPI(t *testing.T) {
+	t.Parallel()
+
+	h := NewHandler(Options{})
+	rec := get(t, h, "/openapi.yaml")
+	if rec.Code != http.StatusOK || rec.Header().Get("Content-Type") != "application/yaml" {
+		t.Fatalf("status = %d, Content-Type = %q", rec.Code, rec.Header().Get("Content-Type"))
+	}
+
+	var paths []string
+	for _, m := range regexp.MustCompile(`(?m)^  (/\S*):$`).FindAllStringSubmatch(rec.Body.String(), -1) {
+		paths = append(paths, m[1])
+	}
+	want := []string{"/holidays/{year}", "/holidays", "/is_holiday", "/business_days", "/ics/{file}", "/feed.atom", "/events", "/openapi.yaml"}
+	if !slices.Equal(paths, want) {
+		t.Errorf("documented paths = %v, want %v", paths, want)
+	}
+	for _, p := range want {
+		pattern := "GET " + p
+		if _, registered := h.mux.Handler(httptest.NewRequest(http.MethodGet, examplePath(p), nil)); registered != pattern {
+			t.Errorf("%s is documented but routed to %q", p, registered)
+		}
+	}
+}
+
+// examplePath fills the wildcards of a documented path.
+func examplePath(p string) string {
+	return regexp.MustCompile(`\{[^}]+\}`).ReplaceAllString(p, "x")
+}
@@ -0,0 +1,97 @@
+package httpapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// eventsKeepAlive is how often a comment is sent on an idle event stream so
+// that proxies do not close it.
+const eventsKeepAlive = 30 * time.Second
+
+// Event types sent by /events.
+const (
+	EventHoliday = "holiday" // the date is a holiday
+	EventEve     = "eve"     // the next date is a holiday
+)
+
+// DayEvent is the data of an /events event.
+type DayEvent struct {
+	Date    string  `json:"date"`    // the current JST date, YYYY-MM-DD
+	Holiday Holiday `json:"holiday"` // the holiday on Date (holiday) or the next date (eve)
+}
+
+// events streams server-sent events as the JST date changes: a "holiday"
+// event when the new date is a holiday, and an "eve" event when the date
+// after it is. Events for the current date are sent on connect.
+func (h *Handler) events(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeError(w, http.StatusInternalServerError, "streaming unsupported")
+		return
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+
+	keepAlive := time.NewTicker(eventsKeepAlive)
+	defer keepAlive.Stop()
+	for {
+		now := h.now().In(jst)
+		if err := h.writeDayEvents(w, now); err != nil {
+			return
+		}
+		flusher.Flush()
+
+		y, m, d := now.Date()
+		rollover := time.NewTimer(time.Date(y, m, d+1, 0, 0, 0, 0, jst).Sub(now))
+	wait:
+		for {
+			select {
+			case <-r.Context().Done():
+				rollover.Stop()
+				return
+			case <-keepAlive.C:
+				if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+					rollover.Stop()
+					return
+				}
+				flusher.Flush()
+			case <-rollover.C:
+				break wait
+			}
+		}
+	}
+}
+
+// writeDayEvents writes the events for the date of now, if any.
+func (h *Handler) writeDayEvents(w http.ResponseWriter, now time.Time) error {
+	y, m, d := now.Date()
+	today := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	tomorrow := today.AddDate(0, 0, 1)
+
+	if name := h.cal.HolidayName(today); name != "" {
+		ev := DayEvent{Date: formatDate(today), Holiday: Holiday{Date: formatDate(today), Name: name}}
+		if err := writeEvent(w, EventHoliday, ev); err != nil {
+			return err
+		}
+	}
+	if name := h.cal.HolidayName(tomorrow); name != "" {
+		ev := DayEvent{Date: formatDate(today), Holiday: Holiday{Date: formatDate(tomorrow), Name: name}}
+		if err := writeEvent(w, EventEve, ev); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeEvent(w http.ResponseWriter, typ string, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", typ, data)
+	return err
+}
@@ -0,0 +1,68 @@
+package httpapi
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+)
+
+func TestEvents(t *testing.T) {
+	t.Parallel()
+
+	// The clock runs in real time from one second before midnight on 2026-05-02
+	// (JST), the eve of 憲法記念日.
+	offset := time.Until(time.Date(2026, time.May, 2, 23, 59, 59, 0, jst))
+	h := NewHandler(Options{Calendar: jpholiday.New(), Now: func() time.Time { return time.Now().Add(offset) }})
+	srv := httptest.NewServer(h)
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+"/events", nil)
+	resp, err := srv.Client().Do(req)
+	if err != nil {
+		t.Fatalf("GET /events error: %v", err)
+	}
+	defer resp.Body.Close()
+	if ct := resp.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Errorf("Content-Type = %q", ct)
+	}
+
+	want := []string{
+		`event: eve`,
+		`data: {"date":"2026-05-02","holiday":{"date":"2026-05-03","name":"憲法記念日"}}`,
+		`event: holiday`,
+		`data: {"date":"2026-05-03","holiday":{"date":"2026-05-03","name":"憲法記念日"}}`,
+		`event: eve`,
+		`data: {"date":"2026-05-03","holiday":{"date":"2026-05-04","name":"みどりの日"}}`,
+	}
+	sc := bufio.NewScanner(resp.Body)
+	var got []string
+	for len(got) < len(want) && sc.Scan() {
+		if line := sc.Text(); line != "" && !strings.HasPrefix(line, ":") {
+			got = append(got, line)
+		}
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("events =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestWriteDayEvents_PlainDay(t *testing.T) {
+	t.Parallel()
+
+	h := NewHandler(Options{})
+	rec := httptest.NewRecorder()
+	if err := h.writeDayEvents(rec, time.Date(2026, time.June, 10, 12, 0, 0, 0, jst)); err != nil {
+		t.Fatal(err)
+	}
+	if rec.Body.Len() != 0 {
+		t.Errorf("got events on a plain day: %q", rec.Body)
+	}
+}
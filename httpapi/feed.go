@@ -0,0 +1,80 @@
+package httpapi
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+)
+
+// atomMonths is the length of the feed.atom window.
+const atomMonths = 12
+
+// cachedFeed is a generated feed kept for conditional requests.
+type cachedFeed struct {
+	body     []byte
+	etag     string
+	modified time.Time // when the content last changed
+	expires  time.Time // when the feed is rebuilt
+}
+
+func (h *Handler) atomFeed(w http.ResponseWriter, r *http.Request) {
+	y, m, d := h.now().In(jst).Date()
+	from := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	opts := jpholiday.AtomOptions{
+		From: from,
+		To:   from.AddDate(0, atomMonths, -1),
+	}
+	h.serveFeed(w, r, "feed.atom", "application/atom+xml; charset=utf-8", func(w io.Writer) error {
+		return h.cal.ExportAtom(w, opts)
+	})
+}
+
+// serveFeed serves the feed cached under key, building it with build when
+// it is missing or expired, and answers conditional requests.
+func (h *Handler) serveFeed(w http.ResponseWriter, r *http.Request, key, contentType string, build func(io.Writer) error) {
+	feed, err := h.loadFeed(key, build)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("ETag", feed.etag)
+	w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(h.icsRefresh.Seconds())))
+	http.ServeContent(w, r, "", feed.modified, bytes.NewReader(feed.body))
+}
+
+// loadFeed returns the feed cached under key, rebuilding it once it has
+// expired. The modification time only advances when the rebuilt content
+// differs, so polling clients keep getting 304 Not Modified.
+func (h *Handler) loadFeed(key string, build func(io.Writer) error) (*cachedFeed, error) {
+	now := h.now()
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	old := h.feeds[key]
+	if old != nil && now.Before(old.expires) {
+		return old, nil
+	}
+	var b bytes.Buffer
+	if err := build(&b); err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(b.Bytes())
+	feed := &cachedFeed{
+		body:     b.Bytes(),
+		etag:     `"` + hex.EncodeToString(sum[:16]) + `"`,
+		modified: now.UTC().Truncate(time.Second),
+		expires:  now.Add(h.icsRefresh),
+	}
+	if old != nil && old.etag == feed.etag {
+		feed.modified = old.modified
+	}
+	h.feeds[key] = feed
+	return feed, nil
+}
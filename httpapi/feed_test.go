@@ -0,0 +1,42 @@
+package httpapi
+
+import (
+	"encoding/xml"
+	"net/http"
+	"testing"
+	"time"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+)
+
+func TestAtomFeed(t *testing.T) {
+	t.Parallel()
+
+	clock := &fakeClock{t: time.Date(2026, time.May, 4, 15, 30, 0, 0, time.UTC)} // 2026-05-05 00:30 JST
+	h := NewHandler(Options{Calendar: jpholiday.New(), Now: clock.Now})
+
+	rec := get(t, h, "/feed.atom")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, body = %s", rec.Code, rec.Body)
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "application/atom+xml; charset=utf-8" {
+		t.Errorf("Content-Type = %q", ct)
+	}
+	var feed struct {
+		Entries []string `xml:"entry>id"`
+	}
+	if err := xml.Unmarshal(rec.Body.Bytes(), &feed); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	want := len(jpholiday.New().HolidaysBetween(
+		time.Date(2026, time.May, 5, 0, 0, 0, 0, time.UTC),
+		time.Date(2027, time.May, 4, 0, 0, 0, 0, time.UTC)))
+	if len(feed.Entries) != want || feed.Entries[0] != "urn:jp-holidays:2026-05-05" {
+		t.Errorf("entries = %v, want %d starting with 2026-05-05", feed.Entries, want)
+	}
+
+	etag := rec.Header().Get("ETag")
+	if rec := serve(h, "/feed.atom", http.Header{"If-None-Match": {etag}}); rec.Code != http.StatusNotModified {
+		t.Errorf("If-None-Match status = %d, want 304", rec.Code)
+	}
+}
@@ -0,0 +1,265 @@
+// Package httpapi serves Japanese holiday data as a JSON REST API.
+//
+// The handler can be mounted on any server to stand up an internal holiday
+// service:
+//
+//	http.Handle("/", httpapi.NewHandler(httpapi.Options{}))
+//	log.Fatal(http.ListenAndServe(":8080", nil))
+//
+// Endpoints (all GET; dates are YYYY-MM-DD Japanese calendar dates):
+//
+//	/holidays/{year}                  holidays in a year
+//	/holidays?from=&to=               holidays in a date range, inclusive
+//	/is_holiday?date=                 whether a date is a holiday, and its name
+//	/business_days?from=&to=          number of business days in a range, inclusive
+//	/ics/{year}.ics                   iCalendar feed of a year
+//	/ics/upcoming.ics                 iCalendar feed of the current month and the next 23
+//	/feed.atom                        Atom feed of holidays in the next 12 months
+//	/events                           server-sent events as the JST date turns into a holiday or its eve
+//	/openapi.yaml                     OpenAPI 3 description of these endpoints
+//
+// The iCalendar feeds are meant for webcal subscriptions, and the Atom feed
+// for portals that only read RSS or Atom. Both are cached for
+// Options.ICSRefresh and answer conditional requests (If-None-Match,
+// If-Modified-Since) with 304 Not Modified.
+//
+// Errors are reported as {"error": "..."} with a 4xx status.
+//
+// [Client] is a typed Go client for the API; clients in other languages can
+// be generated from the OpenAPI document.
+package httpapi
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+)
+
+// openAPI is the OpenAPI 3 document describing the endpoints.
+//
+//go:embed openapi.yaml
+var openAPI []byte
+
+// maxRangeDays limits the span of ranges accepted by /business_days, which
+// examines every day in the range.
+const maxRangeDays = 3660
+
+// Options configures the handler.
+type Options struct {
+	// Calendar supplies holidays and business days. If nil, the
+	// package-level default calendar is used.
+	Calendar *jpholiday.Calendar
+
+	// ICSRefresh is how long a generated iCalendar or Atom feed is reused
+	// before it is rebuilt from the calendar; it is also sent as
+	// Cache-Control max-age. The zero value means one hour.
+	ICSRefresh time.Duration
+
+	// Now returns the current time. If nil, time.Now is used.
+	Now func() time.Time
+}
+
+// calendar is the subset of [jpholiday.Calendar] used by the handler.
+type calendar interface {
+	HolidaysInYear(year int) []jpholiday.Holiday
+	HolidaysBetween(from, to time.Time) []jpholiday.Holiday
+	HolidayName(t time.Time) string
+	BusinessDaysBetween(from, to time.Time) int
+	IsBusinessDay(t time.Time) bool
+	NextBusinessDay(t time.Time) (time.Time, bool)
+	ExportICS(w io.Writer, opts jpholiday.ICSOptions) error
+	ExportAtom(w io.Writer, opts jpholiday.AtomOptions) error
+}
+
+func (o Options) calendar() calendar {
+	if o.Calendar != nil {
+		return o.Calendar
+	}
+	return jpholiday.Default()
+}
+
+// Holiday is a holiday in API responses.
+type Holiday struct {
+	Date string `json:"date"` // YYYY-MM-DD
+	Name string `json:"name"`
+}
+
+// HolidaysResponse is the response of /holidays.
+type HolidaysResponse struct {
+	Holidays []Holiday `json:"holidays"`
+}
+
+// IsHolidayResponse is the response of /is_holiday. Name is empty when
+// Holiday is false.
+type IsHolidayResponse struct {
+	Date    string `json:"date"`
+	Holiday bool   `json:"holiday"`
+	Name    string `json:"name,omitempty"`
+}
+
+// BusinessDaysResponse is the response of /business_days.
+type BusinessDaysResponse struct {
+	From         string `json:"from"`
+	To           string `json:"to"`
+	BusinessDays int    `json:"business_days"`
+}
+
+// ErrorResponse is the body of every error response.
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// Handler is an http.Handler serving the holiday API.
+type Handler struct {
+	cal        calendar
+	mux        *http.ServeMux
+	now        func() time.Time
+	icsRefresh time.Duration
+
+	mu    sync.Mutex
+	feeds map[string]*cachedFeed // by path
+}
+
+// NewHandler returns a handler serving the endpoints described in the
+// package documentation.
+func NewHandler(opts Options) *Handler {
+	h := &Handler{
+		cal:        opts.calendar(),
+		mux:        http.NewServeMux(),
+		now:        opts.Now,
+		icsRefresh: opts.ICSRefresh,
+		feeds:      make(map[string]*cachedFeed),
+	}
+	if h.now == nil {
+		h.now = time.Now
+	}
+	if h.icsRefresh <= 0 {
+		h.icsRefresh = time.Hour
+	}
+	h.mux.HandleFunc("GET /holidays/{year}", h.holidaysInYear)
+	h.mux.HandleFunc("GET /holidays", h.holidaysBetween)
+	h.mux.HandleFunc("GET /is_holiday", h.isHoliday)
+	h.mux.HandleFunc("GET /business_days", h.businessDays)
+	h.mux.HandleFunc("GET /ics/{file}", h.icsFeed)
+	h.mux.HandleFunc("GET /feed.atom", h.atomFeed)
+	h.mux.HandleFunc("GET /events", h.events)
+	h.mux.HandleFunc("GET /openapi.yaml", serveOpenAPI)
+	return h
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+func (h *Handler) holidaysInYear(w http.ResponseWriter, r *http.Request) {
+	s := r.PathValue("year")
+	year, err := strconv.Atoi(s)
+	if err != nil || len(s) != 4 {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid year %q", s))
+		return
+	}
+	writeJSON(w, http.StatusOK, HolidaysResponse{Holidays: toHolidays(h.cal.HolidaysInYear(year))})
+}
+
+func (h *Handler) holidaysBetween(w http.ResponseWriter, r *http.Request) {
+	from, to, ok := parseRange(w, r)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, HolidaysResponse{Holidays: toHolidays(h.cal.HolidaysBetween(from, to))})
+}
+
+func (h *Handler) isHoliday(w http.ResponseWriter, r *http.Request) {
+	t, ok := parseDateParam(w, r, "date")
+	if !ok {
+		return
+	}
+	name := h.cal.HolidayName(t)
+	writeJSON(w, http.StatusOK, IsHolidayResponse{Date: formatDate(t), Holiday: name != "", Name: name})
+}
+
+func (h *Handler) businessDays(w http.ResponseWriter, r *http.Request) {
+	from, to, ok := parseRange(w, r)
+	if !ok {
+		return
+	}
+	if to.Sub(from) > maxRangeDays*24*time.Hour {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("range exceeds %d days", maxRangeDays))
+		return
+	}
+	writeJSON(w, http.StatusOK, BusinessDaysResponse{
+		From:         formatDate(from),
+		To:           formatDate(to),
+		BusinessDays: h.cal.BusinessDaysBetween(from, to),
+	})
+}
+
+func serveOpenAPI(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/yaml")
+	_, _ = w.Write(openAPI) // a write error means the client has gone away
+}
+
+// parseRange reads the from and to query parameters. On failure it writes
+// an error response and returns ok == false.
+func parseRange(w http.ResponseWriter, r *http.Request) (from, to time.Time, ok bool) {
+	if from, ok = parseDateParam(w, r, "from"); !ok {
+		return
+	}
+	if to, ok = parseDateParam(w, r, "to"); !ok {
+		return
+	}
+	if to.Before(from) {
+		writeError(w, http.StatusBadRequest, "to is before from")
+		return from, to, false
+	}
+	return from, to, true
+}
+
+// parseDateParam reads a required YYYY-MM-DD or wareki (令和8年1月1日) query
+// parameter. On failure it writes an error response and returns ok == false.
+func parseDateParam(w http.ResponseWriter, r *http.Request, name string) (time.Time, bool) {
+	s := r.URL.Query().Get(name)
+	if s == "" {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("missing %s parameter", name))
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.DateOnly, s)
+	if err != nil {
+		t, err = jpholiday.ParseWareki(s)
+	}
+	if err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid %s %q (want YYYY-MM-DD)", name, s))
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+func formatDate(t time.Time) string { return t.Format(time.DateOnly) }
+
+func toHolidays(hs []jpholiday.Holiday) []Holiday {
+	out := make([]Holiday, len(hs))
+	for i, h := range hs {
+		out[i] = Holiday{Date: formatDate(h.Date), Name: h.Name}
+	}
+	return out
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	// An encoding error here means the client has gone away; there is no
+	// one left to report it to.
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, msg string) {
+	writeJSON(w, status, ErrorResponse{Error: msg})
+}
@@ -0,0 +1,147 @@
+package httpapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+)
+
+// get serves a GET request for target and returns the recorded response.
+func get(t *testing.T, h http.Handler, target string) *httptest.ResponseRecorder {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target, nil))
+	return rec
+}
+
+// decode unmarshals a JSON response body into v.
+func decode(t *testing.T, rec *httptest.ResponseRecorder, v any) {
+	t.Helper()
+	if ct := rec.Header().Get("Content-Type"); ct != "application/json; charset=utf-8" {
+		t.Errorf("Content-Type = %q", ct)
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), v); err != nil {
+		t.Fatalf("unmarshal: %v\n%s", err, rec.Body.String())
+	}
+}
+
+func TestHolidaysInYear(t *testing.T) {
+	t.Parallel()
+
+	rec := get(t, NewHandler(Options{Calendar: jpholiday.New()}), "/holidays/2026")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, body = %s", rec.Code, rec.Body)
+	}
+	var got HolidaysResponse
+	decode(t, rec, &got)
+	if len(got.Holidays) != len(jpholiday.New().HolidaysInYear(2026)) {
+		t.Errorf("got %d holidays", len(got.Holidays))
+	}
+	if got.Holidays[0] != (Holiday{Date: "2026-01-01", Name: "元日"}) {
+		t.Errorf("first holiday = %+v", got.Holidays[0])
+	}
+}
+
+func TestHolidaysBetween(t *testing.T) {
+	t.Parallel()
+
+	cal := jpholiday.New()
+	cal.AddCustomHoliday(time.Date(2026, time.June, 1, 0, 0, 0, 0, time.UTC), "創立記念日")
+
+	rec := get(t, NewHandler(Options{Calendar: cal}), "/holidays?from=2026-05-05&to=2026-06-01")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, body = %s", rec.Code, rec.Body)
+	}
+	want := `{"holidays":[{"date":"2026-05-05","name":"こどもの日"},{"date":"2026-05-06","name":"休日"},{"date":"2026-06-01","name":"創立記念日"}]}` + "\n"
+	if got := rec.Body.String(); got != want {
+		t.Errorf("body = %s, want %s", got, want)
+	}
+
+	rec = get(t, NewHandler(Options{Calendar: cal}), "/holidays?from=2026-06-02&to=2026-06-30")
+	if got := rec.Body.String(); got != `{"holidays":[]}`+"\n" {
+		t.Errorf("empty range body = %s", got)
+	}
+}
+
+func TestIsHoliday(t *testing.T) {
+	t.Parallel()
+
+	h := NewHandler(Options{})
+	tests := []struct {
+		target string
+		want   IsHolidayResponse
+	}{
+		{"/is_holiday?date=2026-01-12", IsHolidayResponse{Date: "2026-01-12", Holiday: true, Name: "成人の日"}},
+		{"/is_holiday?date=2026-01-13", IsHolidayResponse{Date: "2026-01-13"}},
+		{"/is_holiday?date=R8.1.12", IsHolidayResponse{Date: "2026-01-12", Holiday: true, Name: "成人の日"}},
+	}
+	for _, tt := range tests {
+		rec := get(t, h, tt.target)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("%s: status = %d", tt.target, rec.Code)
+		}
+		var got IsHolidayResponse
+		decode(t, rec, &got)
+		if got != tt.want {
+			t.Errorf("%s = %+v, want %+v", tt.target, got, tt.want)
+		}
+	}
+}
+
+func TestBusinessDays(t *testing.T) {
+	t.Parallel()
+
+	cal := jpholiday.New()
+	cal.AddClosure(time.Date(2026, time.May, 1, 0, 0, 0, 0, time.UTC))
+
+	rec := get(t, NewHandler(Options{Calendar: cal}), "/business_days?from=2026-04-27&to=2026-05-08")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, body = %s", rec.Code, rec.Body)
+	}
+	var got BusinessDaysResponse
+	decode(t, rec, &got)
+	want := BusinessDaysResponse{From: "2026-04-27", To: "2026-05-08", BusinessDays: 5}
+	if got != want {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	t.Parallel()
+
+	h := NewHandler(Options{})
+	tests := []struct {
+		target string
+		status int
+		want   string
+	}{
+		{"/holidays/26", http.StatusBadRequest, "invalid year"},
+		{"/holidays?from=2026-01-01", http.StatusBadRequest, "missing to parameter"},
+		{"/holidays?from=2026-13-01&to=2026-12-31", http.StatusBadRequest, "invalid from"},
+		{"/holidays?from=2026-12-31&to=2026-01-01", http.StatusBadRequest, "to is before from"},
+		{"/is_holiday", http.StatusBadRequest, "missing date parameter"},
+		{"/business_days?from=2000-01-01&to=2026-01-01", http.StatusBadRequest, "range exceeds"},
+	}
+	for _, tt := range tests {
+		rec := get(t, h, tt.target)
+		if rec.Code != tt.status {
+			t.Errorf("%s: status = %d, want %d", tt.target, rec.Code, tt.status)
+		}
+		var got ErrorResponse
+		decode(t, rec, &got)
+		if !strings.Contains(got.Error, tt.want) {
+			t.Errorf("%s: error = %q, want it to contain %q", tt.target, got.Error, tt.want)
+		}
+	}
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/is_holiday?date=2026-01-01", nil))
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("POST status = %d, want %d", rec.Code, http.StatusMethodNotAllowed)
+	}
+}
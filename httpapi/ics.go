@@ -0,0 +1,51 @@
+package httpapi
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+)
+
+// icsRollingMonths is the length of the upcoming.ics window.
+const icsRollingMonths = 24
+
+func (h *Handler) icsFeed(w http.ResponseWriter, r *http.Request) {
+	file := r.PathValue("file")
+	opts, ok := h.icsOptions(file)
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("unknown feed %q (want YYYY.ics or upcoming.ics)", file))
+		return
+	}
+
+	h.serveFeed(w, r, "ics/"+file, "text/calendar; charset=utf-8", func(w io.Writer) error {
+		return h.cal.ExportICS(w, opts)
+	})
+}
+
+// icsOptions maps a feed file name to the export range it covers.
+func (h *Handler) icsOptions(file string) (jpholiday.ICSOptions, bool) {
+	if file == "upcoming.ics" {
+		y, m, _ := h.now().In(jst).Date()
+		from := time.Date(y, m, 1, 0, 0, 0, 0, time.UTC)
+		return jpholiday.ICSOptions{
+			From: from,
+			To:   from.AddDate(0, icsRollingMonths, -1),
+			Name: "日本の祝日",
+		}, true
+	}
+	ys, ok := strings.CutSuffix(file, ".ics")
+	year, err := strconv.Atoi(ys)
+	if !ok || err != nil || len(ys) != 4 {
+		return jpholiday.ICSOptions{}, false
+	}
+	return jpholiday.ICSOptions{
+		From: time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC),
+		Name: fmt.Sprintf("日本の祝日 %d", year),
+	}, true
+}
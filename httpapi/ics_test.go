@@ -0,0 +1,135 @@
+package httpapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+)
+
+// fakeClock is a settable clock for cache expiry tests.
+type fakeClock struct {
+	mu sync.Mutex
+	t  time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.t
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.t = c.t.Add(d)
+}
+
+func serve(h http.Handler, target string, header http.Header) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, target, nil)
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestICSFeed_Year(t *testing.T) {
+	t.Parallel()
+
+	rec := get(t, NewHandler(Options{Calendar: jpholiday.New()}), "/ics/2026.ics")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, body = %s", rec.Code, rec.Body)
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "text/calendar; charset=utf-8" {
+		t.Errorf("Content-Type = %q", ct)
+	}
+	if rec.Header().Get("ETag") == "" || rec.Header().Get("Last-Modified") == "" {
+		t.Errorf("missing validators: %v", rec.Header())
+	}
+	if cc := rec.Header().Get("Cache-Control"); cc != "public, max-age=3600" {
+		t.Errorf("Cache-Control = %q", cc)
+	}
+	body := rec.Body.String()
+	if n := strings.Count(body, "BEGIN:VEVENT"); n != len(jpholiday.New().HolidaysInYear(2026)) {
+		t.Errorf("got %d events", n)
+	}
+	if !strings.Contains(body, "X-WR-CALNAME:日本の祝日 2026\r\n") || strings.Contains(body, "UID:2027") {
+		t.Errorf("unexpected feed:\n%s", body)
+	}
+}
+
+func TestICSFeed_Upcoming(t *testing.T) {
+	t.Parallel()
+
+	clock := &fakeClock{t: time.Date(2026, time.April, 30, 16, 0, 0, 0, time.UTC)} // 2026-05-01 01:00 JST
+	rec := get(t, NewHandler(Options{Calendar: jpholiday.New(), Now: clock.Now}), "/ics/upcoming.ics")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d", rec.Code)
+	}
+	body := rec.Body.String()
+	if strings.Contains(body, "UID:20260429@") || !strings.Contains(body, "UID:20260503@") {
+		t.Error("window should start at the current month in JST")
+	}
+	if !strings.Contains(body, "UID:20271123@") {
+		t.Error("window should cover 24 months")
+	}
+}
+
+func TestICSFeed_ConditionalRequests(t *testing.T) {
+	t.Parallel()
+
+	cal := jpholiday.New()
+	clock := &fakeClock{t: time.Date(2026, time.May, 1, 0, 0, 0, 0, time.UTC)}
+	h := NewHandler(Options{Calendar: cal, Now: clock.Now, ICSRefresh: time.Minute})
+
+	first := serve(h, "/ics/2026.ics", nil)
+	etag, modified := first.Header().Get("ETag"), first.Header().Get("Last-Modified")
+
+	if rec := serve(h, "/ics/2026.ics", http.Header{"If-None-Match": {etag}}); rec.Code != http.StatusNotModified {
+		t.Errorf("If-None-Match status = %d, want 304", rec.Code)
+	}
+	if rec := serve(h, "/ics/2026.ics", http.Header{"If-Modified-Since": {modified}}); rec.Code != http.StatusNotModified {
+		t.Errorf("If-Modified-Since status = %d, want 304", rec.Code)
+	}
+
+	// A rebuild with unchanged content keeps the validators.
+	clock.Advance(2 * time.Minute)
+	rec := serve(h, "/ics/2026.ics", http.Header{"If-None-Match": {etag}})
+	if rec.Code != http.StatusNotModified {
+		t.Errorf("status after unchanged rebuild = %d, want 304", rec.Code)
+	}
+
+	// A calendar change is picked up at the next rebuild, not before.
+	cal.AddCustomHoliday(time.Date(2026, time.June, 1, 0, 0, 0, 0, time.UTC), "創立記念日")
+	if rec := serve(h, "/ics/2026.ics", http.Header{"If-None-Match": {etag}}); rec.Code != http.StatusNotModified {
+		t.Errorf("status before refresh = %d, want 304", rec.Code)
+	}
+	clock.Advance(2 * time.Minute)
+	rec = serve(h, "/ics/2026.ics", http.Header{"If-None-Match": {etag}})
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status after change = %d, want 200", rec.Code)
+	}
+	if rec.Header().Get("ETag") == etag || rec.Header().Get("Last-Modified") == modified {
+		t.Error("validators should change with the content")
+	}
+	if !strings.Contains(rec.Body.String(), "SUMMARY:創立記念日") {
+		t.Error("rebuilt feed should include the new holiday")
+	}
+}
+
+func TestICSFeed_NotFound(t *testing.T) {
+	t.Parallel()
+
+	h := NewHandler(Options{})
+	for _, target := range []string{"/ics/26.ics", "/ics/2026", "/ics/holidays.ics"} {
+		if rec := get(t, h, target); rec.Code != http.StatusNotFound {
+			t.Errorf("%s: status = %d, want 404", target, rec.Code)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package httpapi
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+)
+
+// jst is the Asia/Tokyo timezone in which business days change over.
+var jst = time.FixedZone("Asia/Tokyo", 9*60*60)
+
+// MiddlewareOptions configures [RequireBusinessDay].
+type MiddlewareOptions struct {
+	// Calendar decides which days are business days. If nil, the
+	// package-level default calendar is used.
+	Calendar *jpholiday.Calendar
+
+	// Status is the response status on non-business days when Closed is
+	// nil. The zero value means 503 Service Unavailable.
+	Status int
+
+	// Closed, if set, handles requests on non-business days instead of the
+	// default JSON error response.
+	Closed http.Handler
+
+	// Now returns the current time. If nil, time.Now is used.
+	Now func() time.Time
+}
+
+// RequireBusinessDay returns middleware that passes requests to next only
+// on business days in JST, for services that must refuse transactions on
+// weekends, holidays, and closures (banking cut-offs, securities orders):
+//
+//	mux.Handle("/orders", httpapi.RequireBusinessDay(orders, httpapi.MiddlewareOptions{}))
+//
+// On other days the default response is an ErrorResponse with opts.Status
+// and a Retry-After header counting the seconds until the next business
+// day begins.
+func RequireBusinessDay(next http.Handler, opts MiddlewareOptions) http.Handler {
+	cal := Options{Calendar: opts.Calendar}.calendar()
+	now := opts.Now
+	if now == nil {
+		now = time.Now
+	}
+	code := opts.Status
+	if code == 0 {
+		code = http.StatusServiceUnavailable
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t := now().In(jst)
+		if cal.IsBusinessDay(t) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if opts.Closed != nil {
+			opts.Closed.ServeHTTP(w, r)
+			return
+		}
+
+		today := formatDate(t)
+		msg := "closed on " + today
+		if name := cal.HolidayName(t); name != "" {
+			msg = fmt.Sprintf("closed on %s (%s)", today, name)
+		}
+		if reopen, ok := cal.NextBusinessDay(t); ok {
+			y, m, d := reopen.Date()
+			opening := time.Date(y, m, d, 0, 0, 0, 0, jst)
+			w.Header().Set("Retry-After", strconv.Itoa(int(opening.Sub(t).Seconds())))
+		}
+		writeError(w, code, msg)
+	})
+}
@@ -0,0 +1,81 @@
+package httpapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+)
+
+var okHandler = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusNoContent) })
+
+// at returns a clock fixed at the given JST time.
+func at(y int, m time.Month, d, hour int) func() time.Time {
+	return func() time.Time { return time.Date(y, m, d, hour, 0, 0, 0, jst) }
+}
+
+func TestRequireBusinessDay(t *testing.T) {
+	t.Parallel()
+
+	cal := jpholiday.New()
+	cal.AddClosure(time.Date(2026, time.May, 8, 0, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name       string
+		now        func() time.Time
+		status     int
+		retryAfter string
+		body       string
+	}{
+		{"business day", at(2026, time.May, 7, 10), http.StatusNoContent, "", ""},
+		{"holiday", at(2026, time.May, 6, 22), http.StatusServiceUnavailable, "7200",
+			`{"error":"closed on 2026-05-06 (休日)"}` + "\n"},
+		{"weekend", at(2026, time.May, 9, 12), http.StatusServiceUnavailable, "129600",
+			`{"error":"closed on 2026-05-09"}` + "\n"},
+		{"closure", at(2026, time.May, 8, 0), http.StatusServiceUnavailable, "259200",
+			`{"error":"closed on 2026-05-08"}` + "\n"},
+		// 2026-05-06 20:00 UTC is already 2026-05-07 05:00 in JST.
+		{"judged in JST", func() time.Time { return time.Date(2026, time.May, 6, 20, 0, 0, 0, time.UTC) },
+			http.StatusNoContent, "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			h := RequireBusinessDay(okHandler, MiddlewareOptions{Calendar: cal, Now: tt.now})
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/orders", nil))
+			if rec.Code != tt.status {
+				t.Errorf("status = %d, want %d", rec.Code, tt.status)
+			}
+			if got := rec.Header().Get("Retry-After"); got != tt.retryAfter {
+				t.Errorf("Retry-After = %q, want %q", got, tt.retryAfter)
+			}
+			if got := rec.Body.String(); got != tt.body {
+				t.Errorf("body = %q, want %q", got, tt.body)
+			}
+		})
+	}
+}
+
+func TestRequireBusinessDay_Options(t *testing.T) {
+	t.Parallel()
+
+	holiday := at(2026, time.January, 1, 9)
+
+	h := RequireBusinessDay(okHandler, MiddlewareOptions{Now: holiday, Status: http.StatusForbidden})
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	if rec.Code != http.StatusForbidden {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusForbidden)
+	}
+
+	closed := http.RedirectHandler("/maintenance", http.StatusFound)
+	h = RequireBusinessDay(okHandler, MiddlewareOptions{Now: holiday, Closed: closed})
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	if rec.Code != http.StatusFound || rec.Header().Get("Location") != "/maintenance" {
+		t.Errorf("Closed handler not used: status %d, Location %q", rec.Code, rec.Header().Get("Location"))
+	}
+}
@@ -0,0 +1,248 @@
+package jpholiday
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// maxICSEventDays limits how many days a single all-day VEVENT may span.
+// It guards against malformed DTEND values expanding into huge ranges.
+const maxICSEventDays = 366
+
+// icsEvent is an all-day VEVENT parsed from an iCalendar stream.
+type icsEvent struct {
+	start, end date // end is exclusive, per RFC 5545
+	summary    string
+}
+
+// ImportICS parses an iCalendar (RFC 5545) stream and registers every
+// all-day VEVENT as a custom holiday, using its SUMMARY as the holiday name.
+// Multi-day events register one custom holiday per day (DTEND is exclusive).
+//
+// Events with a date-time DTSTART (i.e., not all-day) are ignored, as are
+// recurrence rules. Either all events are imported or, on a parse error,
+// none are.
+func (c *Calendar) ImportICS(r io.Reader) error {
+	events, err := parseICS(r)
+	if err != nil {
+		return err
+	}
+
+	c.update(func(s *snapshot) {
+		for _, ev := range events {
+			for cur := ev.start; cur.before(ev.end); cur = cur.addDays(1) {
+				s.setCustom(cur, ev.summary)
+			}
+		}
+	})
+	return nil
+}
+
+// parseICS extracts all-day events from an iCalendar stream.
+func parseICS(r io.Reader) ([]icsEvent, error) {
+	lines, err := unfoldICSLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		events  []icsEvent
+		inEvent bool
+		allDay  bool
+		ev      icsEvent
+		hasEnd  bool
+		nested  int // depth of sub-components (e.g., VALARM) inside the event
+	)
+	for i, line := range lines {
+		name, params, value, ok := splitICSLine(line)
+		if !ok {
+			continue
+		}
+		lineNum := i + 1
+
+		switch {
+		case name == "BEGIN" && strings.EqualFold(value, "VEVENT"):
+			inEvent, allDay, hasEnd, nested = true, false, false, 0
+			ev = icsEvent{}
+		case name == "END" && strings.EqualFold(value, "VEVENT"):
+			if !inEvent {
+				return nil, fmt.Errorf("ics: content line %d: END:VEVENT without BEGIN", lineNum)
+			}
+			inEvent = false
+			if !allDay {
+				continue
+			}
+			if ev.summary == "" {
+				return nil, fmt.Errorf("ics: content line %d: all-day VEVENT without SUMMARY", lineNum)
+			}
+			if !hasEnd {
+				ev.end = ev.start.addDays(1)
+			}
+			if !ev.start.before(ev.end) {
+				return nil, fmt.Errorf("ics: content line %d: DTEND is not after DTSTART", lineNum)
+			}
+			if ev.end.toTime().Sub(ev.start.toTime()) > maxICSEventDays*24*time.Hour {
+				return nil, fmt.Errorf("ics: content line %d: event spans more than %d days", lineNum, maxICSEventDays)
+			}
+			events = append(events, ev)
+		case !inEvent:
+			continue
+		case name == "BEGIN":
+			nested++
+		case name == "END":
+			nested--
+		case nested > 0:
+			continue
+		case name == "DTSTART":
+			d, isDate, err := parseICSDate(params, value)
+			if err != nil {
+				return nil, fmt.Errorf("ics: content line %d: DTSTART: %w", lineNum, err)
+			}
+			ev.start, allDay = d, isDate
+		case name == "DTEND":
+			d, isDate, err := parseICSDate(params, value)
+			if err != nil {
+				return nil, fmt.Errorf("ics: content line %d: DTEND: %w", lineNum, err)
+			}
+			if isDate {
+				ev.end, hasEnd = d, true
+			}
+		case name == "SUMMARY":
+			ev.summary = unescapeICSText(value)
+		}
+	}
+	if inEvent {
+		return nil, fmt.Errorf("ics: unterminated VEVENT")
+	}
+	return events, nil
+}
+
+// unfoldICSLines reads content lines, joining folded continuation lines
+// (those beginning with a space or tab) onto the previous line.
+func unfoldICSLines(r io.Reader) ([]string, error) {
+	var lines []string
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line := strings.TrimRight(sc.Text(), "\r")
+		if len(lines) > 0 && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("ics: %w", err)
+	}
+	return lines, nil
+}
+
+// splitICSLine splits "NAME;PARAM=V:value" into its upper-cased name,
+// parameter section, and value.
+func splitICSLine(line string) (name, params, value string, ok bool) {
+	head, value, ok := strings.Cut(line, ":")
+	if !ok {
+		return "", "", "", false
+	}
+	name, params, _ = strings.Cut(head, ";")
+	return strings.ToUpper(name), strings.ToUpper(params), value, true
+}
+
+// parseICSDate parses a DTSTART/DTEND value. It reports isDate=true for
+// DATE values (all-day); DATE-TIME values return isDate=false.
+func parseICSDate(params, value string) (d date, isDate bool, err error) {
+	value = strings.TrimSpace(value)
+	if strings.Contains(params, "VALUE=DATE-TIME") || strings.Contains(value, "T") {
+		return 0, false, nil
+	}
+	t, err := time.Parse("20060102", value)
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid date %q", value)
+	}
+	return newDate(t.Year(), t.Month(), t.Day()), true, nil
+}
+
+// icsTextUnescaper decodes RFC 5545 TEXT escapes.
+var icsTextUnescaper = strings.NewReplacer(`\\`, `\`, `\;`, `;`, `\,`, `,`, `\n`, "\n", `\N`, "\n")
+
+// unescapeICSText decodes a TEXT property value and trims surrounding space.
+func unescapeICSText(s string) string {
+	return strings.TrimSpace(icsTextUnescaper.Replace(s))
+}
+
+// ICSOptions configures [Calendar.ExportICS].
+type ICSOptions struct {
+	// From and To limit the export to holidays in [From, To] inclusive.
+	// A zero value leaves that end of the range open.
+	From, To time.Time
+
+	// Name, if set, is written as X-WR-CALNAME, the display name most
+	// calendar applications show for a subscribed calendar.
+	Name string
+}
+
+// icsLineLimit is the maximum length of a content line in octets, excluding
+// the line break (RFC 5545 section 3.1).
+const icsLineLimit = 75
+
+// icsTextEscaper encodes RFC 5545 TEXT values.
+var icsTextEscaper = strings.NewReplacer(`\`, `\\`, `;`, `\;`, `,`, `\,`, "\n", `\n`)
+
+// ExportICS writes the effective holiday set (built-in + custom, minus
+// removed) as an iCalendar (RFC 5545) stream with one all-day VEVENT per
+// holiday. The output is deterministic: each event's UID is derived from
+// its date and DTSTAMP is the holiday date itself, so regenerating the
+// file only changes it when the holidays change.
+func (c *Calendar) ExportICS(w io.Writer, opts ICSOptions) error {
+	holidays := c.exportRange(opts.From, opts.To)
+
+	bw := bufio.NewWriter(w)
+	writeICSLine(bw, "BEGIN:VCALENDAR")
+	writeICSLine(bw, "VERSION:2.0")
+	writeICSLine(bw, "PRODID:-//rabitt1ove//jp-holidays//JA")
+	writeICSLine(bw, "CALSCALE:GREGORIAN")
+	if opts.Name != "" {
+		writeICSLine(bw, "X-WR-CALNAME:"+icsTextEscaper.Replace(opts.Name))
+	}
+	for _, h := range holidays {
+		day := h.Date.Format("20060102")
+		writeICSLine(bw, "BEGIN:VEVENT")
+		writeICSLine(bw, "UID:"+day+"@jp-holidays")
+		writeICSLine(bw, "DTSTAMP:"+day+"T000000Z")
+		writeICSLine(bw, "DTSTART;VALUE=DATE:"+day)
+		writeICSLine(bw, "DTEND;VALUE=DATE:"+h.Date.AddDate(0, 0, 1).Format("20060102"))
+		writeICSLine(bw, "SUMMARY:"+icsTextEscaper.Replace(h.Name))
+		writeICSLine(bw, "TRANSP:TRANSPARENT")
+		writeICSLine(bw, "END:VEVENT")
+	}
+	writeICSLine(bw, "END:VCALENDAR")
+	return bw.Flush()
+}
+
+// writeICSLine writes a content line with a CRLF break, folding it so that
+// no physical line exceeds icsLineLimit octets. Folds never split a UTF-8
+// sequence. Write errors are reported by the final Flush.
+func writeICSLine(w *bufio.Writer, line string) {
+	limit := icsLineLimit
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		w.WriteString(line[:cut])
+		w.WriteString("\r\n ")
+		line = line[cut:]
+		limit = icsLineLimit - 1 // continuation lines start with a space
+	}
+	w.WriteString(line)
+	w.WriteString("\r\n")
+}
+
+// ImportICS imports all-day iCalendar events into the default calendar.
+func ImportICS(r io.Reader) error { return defaultCal.ImportICS(r) }
+
+// ExportICS writes the default calendar's holidays as an iCalendar stream.
+func ExportICS(w io.Writer, opts ICSOptions) error { return defaultCal.ExportICS(w, opts) }
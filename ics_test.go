@@ -0,0 +1,186 @@
+package jpholiday_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	. "github.com/rabitt1ove/jp-holidays"
+)
+
+func TestImportICS(t *testing.T) {
+	t.Parallel()
+
+	ics := "BEGIN:VCALENDAR\r\n" +
+		"VERSION:2.0\r\n" +
+		"PRODID:-//Example//Company Calendar//EN\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"UID:1@example.com\r\n" +
+		"DTSTART;VALUE=DATE:20260615\r\n" +
+		"DTEND;VALUE=DATE:20260616\r\n" +
+		"SUMMARY:会社記念日\r\n" +
+		"BEGIN:VALARM\r\n" +
+		"ACTION:DISPLAY\r\n" +
+		"SUMMARY:reminder\r\n" +
+		"END:VALARM\r\n" +
+		"END:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"UID:2@example.com\r\n" +
+		"DTSTART;VALUE=DATE:20261229\r\n" +
+		"DTEND;VALUE=DATE:20270101\r\n" +
+		"SUMMARY:年末\r\n" +
+		" 休業\r\n" +
+		"END:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"UID:3@example.com\r\n" +
+		"DTSTART:20260701T090000Z\r\n" +
+		"DTEND:20260701T100000Z\r\n" +
+		"SUMMARY:Meeting\r\n" +
+		"END:VEVENT\r\n" +
+		"END:VCALENDAR\r\n"
+
+	cal := New()
+	if err := cal.ImportICS(strings.NewReader(ics)); err != nil {
+		t.Fatalf("ImportICS error: %v", err)
+	}
+
+	tests := []struct {
+		date time.Time
+		want string
+	}{
+		{d(2026, time.June, 15), "会社記念日"},
+		{d(2026, time.June, 16), ""},
+		{d(2026, time.December, 29), "年末休業"},
+		{d(2026, time.December, 31), "年末休業"},
+		{d(2027, time.January, 1), "元日"},
+		{d(2026, time.July, 1), ""},
+	}
+	for _, tt := range tests {
+		if got := cal.HolidayName(tt.date); got != tt.want {
+			t.Errorf("HolidayName(%s) = %q, want %q", tt.date.Format("2006-01-02"), got, tt.want)
+		}
+	}
+}
+
+func TestImportICS_DefaultsToSingleDay(t *testing.T) {
+	t.Parallel()
+
+	ics := "BEGIN:VEVENT\nDTSTART;VALUE=DATE:20260615\nSUMMARY:Foo\\, Bar\nEND:VEVENT\n"
+	cal := New()
+	if err := cal.ImportICS(strings.NewReader(ics)); err != nil {
+		t.Fatalf("ImportICS error: %v", err)
+	}
+	if got := cal.HolidayName(d(2026, time.June, 15)); got != "Foo, Bar" {
+		t.Errorf("HolidayName = %q, want %q", got, "Foo, Bar")
+	}
+	if cal.IsHoliday(d(2026, time.June, 16)) {
+		t.Error("event without DTEND should cover a single day")
+	}
+}
+
+func TestImportICS_Errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		ics     string
+		wantErr string
+	}{
+		{"invalid date", "BEGIN:VEVENT\nDTSTART;VALUE=DATE:2026-06-15\nSUMMARY:x\nEND:VEVENT\n", "invalid date"},
+		{"missing summary", "BEGIN:VEVENT\nDTSTART;VALUE=DATE:20260615\nEND:VEVENT\n", "without SUMMARY"},
+		{"end before start", "BEGIN:VEVENT\nDTSTART;VALUE=DATE:20260615\nDTEND;VALUE=DATE:20260615\nSUMMARY:x\nEND:VEVENT\n", "not after DTSTART"},
+		{"too long", "BEGIN:VEVENT\nDTSTART;VALUE=DATE:20260101\nDTEND;VALUE=DATE:20280101\nSUMMARY:x\nEND:VEVENT\n", "more than"},
+		{"unterminated", "BEGIN:VEVENT\nDTSTART;VALUE=DATE:20260615\nSUMMARY:x\n", "unterminated"},
+		{"stray end", "END:VEVENT\n", "without BEGIN"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			cal := New()
+			err := cal.ImportICS(strings.NewReader(tt.ics))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("ImportICS error = %v, want containing %q", err, tt.wantErr)
+			}
+			if len(cal.HolidaysInYear(2026)) != len(New().HolidaysInYear(2026)) {
+				t.Error("failed import should not register any holidays")
+			}
+		})
+	}
+}
+
+func TestExportICS(t *testing.T) {
+	t.Parallel()
+
+	cal := New()
+	cal.AddCustomHoliday(d(2026, 6, 15), "会社記念日; 本社, 支社")
+
+	var b strings.Builder
+	err := cal.ExportICS(&b, ICSOptions{From: d(2026, 5, 5), To: d(2026, 6, 30), Name: "日本の祝日"})
+	if err != nil {
+		t.Fatalf("ExportICS error: %v", err)
+	}
+
+	want := "BEGIN:VCALENDAR\r\n" +
+		"VERSION:2.0\r\n" +
+		"PRODID:-//rabitt1ove//jp-holidays//JA\r\n" +
+		"CALSCALE:GREGORIAN\r\n" +
+		"X-WR-CALNAME:日本の祝日\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"UID:20260505@jp-holidays\r\n" +
+		"DTSTAMP:20260505T000000Z\r\n" +
+		"DTSTART;VALUE=DATE:20260505\r\n" +
+		"DTEND;VALUE=DATE:20260506\r\n" +
+		"SUMMARY:こどもの日\r\n" +
+		"TRANSP:TRANSPARENT\r\n" +
+		"END:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"UID:20260506@jp-holidays\r\n" +
+		"DTSTAMP:20260506T000000Z\r\n" +
+		"DTSTART;VALUE=DATE:20260506\r\n" +
+		"DTEND;VALUE=DATE:20260507\r\n" +
+		"SUMMARY:休日\r\n" +
+		"TRANSP:TRANSPARENT\r\n" +
+		"END:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"UID:20260615@jp-holidays\r\n" +
+		"DTSTAMP:20260615T000000Z\r\n" +
+		"DTSTART;VALUE=DATE:20260615\r\n" +
+		"DTEND;VALUE=DATE:20260616\r\n" +
+		`SUMMARY:会社記念日\; 本社\, 支社` + "\r\n" +
+		"TRANSP:TRANSPARENT\r\n" +
+		"END:VEVENT\r\n" +
+		"END:VCALENDAR\r\n"
+	if got := b.String(); got != want {
+		t.Errorf("ExportICS =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestExportICS_FoldingRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	long := strings.Repeat("年末年始休業", 10)
+	src := New()
+	src.AddCustomHoliday(d(2026, 12, 30), long)
+
+	var b strings.Builder
+	if err := src.ExportICS(&b, ICSOptions{From: d(2026, 12, 1), To: d(2026, 12, 31)}); err != nil {
+		t.Fatalf("ExportICS error: %v", err)
+	}
+	for _, line := range strings.Split(strings.TrimSuffix(b.String(), "\r\n"), "\r\n") {
+		if len(line) > 75 {
+			t.Errorf("line exceeds 75 octets (%d): %q", len(line), line)
+		}
+		if !utf8.ValidString(line) {
+			t.Errorf("fold split a UTF-8 sequence: %q", line)
+		}
+	}
+
+	dst := New()
+	if err := dst.ImportICS(strings.NewReader(b.String())); err != nil {
+		t.Fatalf("ImportICS error: %v", err)
+	}
+	if got := dst.HolidayName(d(2026, 12, 30)); got != long {
+		t.Errorf("round-tripped name = %q, want %q", got, long)
+	}
+}
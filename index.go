@@ -0,0 +1,136 @@
+package jpholiday
+
+import (
+	"maps"
+	"slices"
+	"sync"
+	"time"
+)
+
+// holidayData is a holiday dataset in the format of the Cabinet Office CSV:
+// the built-in one, or one loaded at runtime with
+// [Calendar.RefreshFromOfficialSource].
+type holidayData struct {
+	names       map[date]string
+	dates       []date // keys of names in ascending order
+	first, last date   // January 1 of the first year, December 31 of the last
+	meta        DatasetMetadata
+}
+
+// builtin returns the built-in dataset. It is loaded from builtinTable on
+// first use, and its dates are sorted then so that NextHoliday and
+// PreviousHoliday can binary search instead of scanning the whole map.
+var builtin = sync.OnceValue(func() *holidayData {
+	names := builtinTable()
+	dates := sortedDates(names)
+	return &holidayData{
+		names: names,
+		dates: dates,
+		first: newDate(dates[0].year(), time.January, 1),
+		last:  newDate(dates[len(dates)-1].year(), time.December, 31),
+		meta:  builtinDataset,
+	}
+})
+
+// data returns the dataset in effect for s.
+func (s *snapshot) data() *holidayData {
+	if s.official != nil {
+		return s.official
+	}
+	return builtin()
+}
+
+// yearlyRule adds closures or local holidays to s for the years first
+// through last, such as the bank closures of [PresetBank].
+type yearlyRule func(s *snapshot, first, last int)
+
+// addYearly applies rule to the years of the dataset and records it, so
+// that setOfficial applies it to years added later.
+func (s *snapshot) addYearly(rule yearlyRule) {
+	data := s.data()
+	rule(s, data.first.year(), data.last.year())
+	s.yearly = append(s.yearly, rule)
+}
+
+// setOfficial replaces the dataset with data and applies the recorded
+// yearly rules to the years it adds. It must only be called on an
+// unpublished snapshot.
+func (s *snapshot) setOfficial(data *holidayData) {
+	prev := s.data()
+	s.official = data
+	for _, rule := range s.yearly {
+		if first := data.first.year(); first < prev.first.year() {
+			rule(s, first, prev.first.year()-1)
+		}
+		if last := data.last.year(); last > prev.last.year() {
+			rule(s, prev.last.year()+1, last)
+		}
+	}
+}
+
+// builtinBusinessDays holds prefix sums of business days under the default
+// weekend and the built-in holidays: element i is the number of business
+// days in the i days starting at the dataset's first day. It covers every year of the
+// dataset, so BusinessDaysBetween can count any range within it in
+// constant time. It is built on first use.
+var builtinBusinessDays = sync.OnceValue(businessPrefixSums)
+
+func businessPrefixSums() []int32 {
+	data := builtin()
+	n := data.last.days() - data.first.days() + 1
+	sums := make([]int32, n+1)
+	for i := range n {
+		d := dateFromDays(data.first.days() + i)
+		_, holiday := data.names[d]
+		sums[i+1] = sums[i]
+		if !defaultWeekend.has(d.weekday()) && !holiday {
+			sums[i+1]++
+		}
+	}
+	return sums
+}
+
+// minDate and maxDate bound the open-ended ranges of list APIs.
+var (
+	minDate = newDate(1, time.January, 1)
+	maxDate = newDate(9999, time.December, 31)
+)
+
+// sortedDates returns the keys of m in ascending order.
+func sortedDates(m map[date]string) []date {
+	return slices.Sorted(maps.Keys(m))
+}
+
+// searchDates returns the index of the first date in the sorted slice ds
+// that is not before d, or len(ds) if there is none.
+func searchDates(ds []date, d date) int {
+	i, _ := slices.BinarySearch(ds, d)
+	return i
+}
+
+// searchDatesAfter returns the index of the first date in the sorted slice
+// ds that is after d, or len(ds) if there is none.
+func searchDatesAfter(ds []date, d date) int {
+	i, _ := slices.BinarySearch(ds, d+1)
+	return i
+}
+
+// setCustom adds or replaces a custom holiday, keeping customDates sorted.
+// It must only be called on an unpublished snapshot.
+func (s *snapshot) setCustom(d date, name string) {
+	if _, ok := s.custom[d]; !ok {
+		s.customDates = slices.Insert(s.customDates, searchDates(s.customDates, d), d)
+	}
+	s.custom[d] = name
+}
+
+// deleteCustom removes a custom holiday, keeping customDates sorted.
+// It must only be called on an unpublished snapshot.
+func (s *snapshot) deleteCustom(d date) {
+	if _, ok := s.custom[d]; !ok {
+		return
+	}
+	delete(s.custom, d)
+	i := searchDates(s.customDates, d)
+	s.customDates = slices.Delete(s.customDates, i, i+1)
+}
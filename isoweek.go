@@ -0,0 +1,63 @@
+package jpholiday
+
+import "time"
+
+// WeekHolidays is an ISO 8601 week and its holidays, as returned by
+// [Calendar.WeeksWithHolidays].
+type WeekHolidays struct {
+	Year     int       // The ISO week-numbering year.
+	Week     int       // The ISO week number, 1–53.
+	Holidays []Holiday // The holidays in the week, sorted by date.
+}
+
+// HolidaysInISOWeek returns the holidays in the given ISO 8601 week, which
+// runs from Monday to Sunday, sorted by date. Week 1 of an ISO year is the
+// week containing January 4, so it may start in late December, and the
+// last weeks of December may belong to the next ISO year, as reported by
+// [time.Time.ISOWeek]. It returns nil if the week does not exist in the
+// year.
+func (c *Calendar) HolidaysInISOWeek(year, week int) []Holiday {
+	if week < 1 || week > isoWeeksInYear(year) {
+		return nil
+	}
+	monday := isoWeekMonday(year, week)
+	return c.holidaysInRange(monday, monday.addDays(6))
+}
+
+// WeeksWithHolidays returns the ISO 8601 weeks of the given ISO year that
+// contain at least one holiday, in week order, for planning tools that work
+// in weeks.
+func (c *Calendar) WeeksWithHolidays(year int) []WeekHolidays {
+	first := isoWeekMonday(year, 1)
+	last := isoWeekMonday(year, isoWeeksInYear(year)).addDays(6)
+	var weeks []WeekHolidays
+	for _, h := range c.holidaysInRange(first, last) {
+		_, week := h.Date.ISOWeek()
+		if n := len(weeks); n > 0 && weeks[n-1].Week == week {
+			weeks[n-1].Holidays = append(weeks[n-1].Holidays, h)
+			continue
+		}
+		weeks = append(weeks, WeekHolidays{Year: year, Week: week, Holidays: []Holiday{h}})
+	}
+	return weeks
+}
+
+// isoWeekMonday returns the Monday of the given ISO week.
+func isoWeekMonday(year, week int) date {
+	jan4 := newDate(year, time.January, 4)
+	offset := (int(jan4.weekday()) + 6) % 7 // days since Monday
+	return jan4.addDays(-offset + (week-1)*7)
+}
+
+// isoWeeksInYear returns the number of ISO weeks in the given ISO year: 53
+// if December 28 falls in week 53, otherwise 52.
+func isoWeeksInYear(year int) int {
+	_, week := newDate(year, time.December, 28).toTime().ISOWeek()
+	return week
+}
+
+// HolidaysInISOWeek returns the default calendar's holidays in the given ISO week.
+func HolidaysInISOWeek(year, week int) []Holiday { return defaultCal.HolidaysInISOWeek(year, week) }
+
+// WeeksWithHolidays returns the ISO weeks of the year that contain holidays on the default calendar.
+func WeeksWithHolidays(year int) []WeekHolidays { return defaultCal.WeeksWithHolidays(year) }
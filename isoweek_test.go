@@ -0,0 +1,74 @@
+package jpholiday_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/rabitt1ove/jp-holidays"
+)
+
+func TestHolidaysInISOWeek(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		year, week int
+		want       []time.Time
+	}{
+		// Golden Week 2026: Monday 05-04 to Sunday 05-10.
+		{"golden week", 2026, 19, []time.Time{d(2026, time.May, 4), d(2026, time.May, 5), d(2026, time.May, 6)}},
+		{"no holidays", 2026, 24, nil},
+		// 2026-W01 runs from 2025-12-29 to 2026-01-04.
+		{"week 1 starts in December", 2026, 1, []time.Time{d(2026, time.January, 1)}},
+		// 2020 has 53 ISO weeks; 2021-01-01 falls in 2020-W53.
+		{"week 53", 2020, 53, []time.Time{d(2021, time.January, 1)}},
+		{"week 53 of a 52-week year", 2025, 53, nil},
+		{"week 0", 2026, 0, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := HolidaysInISOWeek(tt.year, tt.week)
+			if len(got) != len(tt.want) {
+				t.Fatalf("HolidaysInISOWeek(%d, %d) = %v, want %v", tt.year, tt.week, got, tt.want)
+			}
+			for i := range got {
+				if !got[i].Date.Equal(tt.want[i]) {
+					t.Errorf("HolidaysInISOWeek(%d, %d)[%d] = %s, want %s", tt.year, tt.week, i, got[i].Date.Format(time.DateOnly), tt.want[i].Format(time.DateOnly))
+				}
+			}
+		})
+	}
+}
+
+func TestWeeksWithHolidays(t *testing.T) {
+	t.Parallel()
+
+	weeks := WeeksWithHolidays(2025)
+	total := 0
+	for i, w := range weeks {
+		if w.Year != 2025 || (i > 0 && weeks[i-1].Week >= w.Week) {
+			t.Errorf("weeks[%d] = %d-W%02d out of order", i, w.Year, w.Week)
+		}
+		for _, h := range w.Holidays {
+			if y, week := h.Date.ISOWeek(); y != 2025 || week != w.Week {
+				t.Errorf("%s is in %d-W%02d, listed under W%02d", h.Date.Format(time.DateOnly), y, week, w.Week)
+			}
+		}
+		total += len(w.Holidays)
+	}
+	// 2025 runs from 2024-12-30 to 2025-12-28 as an ISO year, so it holds
+	// exactly the calendar year's holidays.
+	if want := len(HolidaysInYear(2025)); total != want {
+		t.Errorf("WeeksWithHolidays(2025) holds %d holidays, want %d", total, want)
+	}
+
+	// 2026 has 53 ISO weeks, the last of which holds 2027-01-01.
+	weeks = WeeksWithHolidays(2026)
+	if last := weeks[len(weeks)-1]; last.Week != 53 || !last.Holidays[0].Date.Equal(d(2027, time.January, 1)) {
+		t.Errorf("last week of 2026 = %+v, want W53 with 2027-01-01", last)
+	}
+	if weeks[0].Week != 1 {
+		t.Errorf("first week = W%02d, want W01 (元日)", weeks[0].Week)
+	}
+}
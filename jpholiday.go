@@ -21,8 +21,10 @@
 package jpholiday
 
 import (
-	"sort"
+	"maps"
+	"slices"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -34,38 +36,130 @@ type Holiday struct {
 
 // Calendar holds holiday data and supports custom holidays.
 // Create one with [New]. All methods are safe for concurrent use.
+//
+// Reads never block: they load an immutable snapshot of the calendar's
+// settings, and each change publishes a modified copy of the snapshot.
+// Changes are therefore relatively expensive; configure a calendar up front
+// (for example with [Calendar.ApplyConfig], which copies once) rather than
+// mutating it on hot paths.
 type Calendar struct {
-	mu      sync.RWMutex
-	custom  map[date]string
-	removed map[date]bool
+	mu    sync.Mutex // serializes changes
+	state atomic.Pointer[snapshot]
+	hooks []*customHook // guarded by mu
+}
+
+// snapshot is the state of a Calendar at one point in time. A published
+// snapshot is never modified.
+type snapshot struct {
+	custom      map[date]string
+	customDates []date // keys of custom in ascending order
+	removed     map[date]bool
+	closed      map[date]bool
+	local       map[date]string // local holidays added with WithPrefecture
+	weekend     weekdaySet
+	locale      string
+	observances bool
+	sources     []HolidaySource
+	official    *holidayData // replaces the built-in dataset if non-nil
+	yearly      []yearlyRule // applied by setOfficial to years it adds
+
+	// ranges memoizes the results of HolidaysInYear and HolidaysInMonth,
+	// keyed by [from, to]. Each published snapshot starts with an empty
+	// cache, so changes to the calendar invalidate it.
+	ranges sync.Map
 }
 
-// New creates a new Calendar backed by the built-in holiday dataset.
-func New() *Calendar {
-	return &Calendar{
+// An Option configures a Calendar created by [New], such as
+// [WithPrefecture].
+type Option func(s *snapshot)
+
+// New creates a new Calendar backed by the built-in holiday dataset,
+// configured by opts.
+func New(opts ...Option) *Calendar {
+	s := &snapshot{
 		custom:  make(map[date]string),
 		removed: make(map[date]bool),
+		closed:  make(map[date]bool),
+		local:   make(map[date]string),
+		weekend: defaultWeekend,
+		locale:  LocaleJapanese,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	c := &Calendar{}
+	c.state.Store(s)
+	return c
+}
+
+// load returns the current snapshot.
+func (c *Calendar) load() *snapshot { return c.state.Load() }
+
+// update applies fn to a copy of the current snapshot and publishes the copy.
+func (c *Calendar) update(fn func(s *snapshot)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := c.load().clone()
+	fn(s)
+	c.state.Store(s)
+}
+
+// tryUpdate is update for a change that depends on the current snapshot
+// and may fail: if fn returns an error, the calendar is left unchanged.
+func (c *Calendar) tryUpdate(fn func(s *snapshot) error) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := c.load().clone()
+	if err := fn(s); err != nil {
+		return err
+	}
+	c.state.Store(s)
+	return nil
+}
+
+func (s *snapshot) clone() *snapshot {
+	return &snapshot{
+		custom:      maps.Clone(s.custom),
+		customDates: slices.Clone(s.customDates),
+		removed:     maps.Clone(s.removed),
+		closed:      maps.Clone(s.closed),
+		local:       maps.Clone(s.local),
+		weekend:     s.weekend,
+		locale:      s.locale,
+		observances: s.observances,
+		sources:     slices.Clone(s.sources),
+		official:    s.official,
+		yearly:      slices.Clone(s.yearly),
 	}
 }
 
 // defaultCal is the package-level calendar used by top-level functions.
 var defaultCal = New()
 
+// Default returns the calendar used by the package-level functions, such as
+// [IsHoliday] and [AddCustomHoliday], for code that takes a *Calendar.
+func Default() *Calendar { return defaultCal }
+
 // lookup returns the holiday name for a date, checking custom holidays first,
-// then built-in holidays (unless removed).
+// then sources and built-in holidays (unless removed).
 func (c *Calendar) lookup(d date) (string, bool) {
-	c.mu.RLock()
-	defer c.mu.RUnlock()
+	return c.load().lookup(d)
+}
 
-	if name, ok := c.custom[d]; ok {
+// lookup returns the holiday name for a date in s.
+func (s *snapshot) lookup(d date) (string, bool) {
+	if name, ok := s.custom[d]; ok {
 		return name, true
 	}
-	if c.removed[d] {
+	if s.removed[d] {
 		return "", false
 	}
-	if name, ok := builtinHolidays[d]; ok {
+	if name, ok := s.sourceLookup(d); ok {
 		return name, true
 	}
+	if name, ok := s.data().names[d]; ok {
+		return s.localName(d, name), true
+	}
 	return "", false
 }
 
@@ -86,18 +180,34 @@ func (c *Calendar) HolidayName(t time.Time) string {
 }
 
 // HolidaysInYear returns all holidays in the given year, sorted by date.
+// Results are cached until the calendar is next changed; each call returns
+// a new slice that the caller may modify.
 func (c *Calendar) HolidaysInYear(year int) []Holiday {
-	from := date{year: year, month: time.January, day: 1}
-	to := date{year: year, month: time.December, day: 31}
-	return c.holidaysInRange(from, to)
+	from := newDate(year, time.January, 1)
+	to := newDate(year, time.December, 31)
+	return c.load().cachedRange(from, to)
 }
 
 // HolidaysInMonth returns all holidays in the given year and month, sorted by date.
+// Like [Calendar.HolidaysInYear], results are cached.
 func (c *Calendar) HolidaysInMonth(year int, month time.Month) []Holiday {
-	from := date{year: year, month: month, day: 1}
+	from := newDate(year, month, 1)
 	lastDay := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
-	to := date{year: year, month: month, day: lastDay}
-	return c.holidaysInRange(from, to)
+	to := newDate(year, month, lastDay)
+	return c.load().cachedRange(from, to)
+}
+
+// HolidaysOnWeekday returns the holidays in the given year that fall on the
+// given day of the week, sorted by date. For example, the Monday holidays
+// of a year are its three-day weekends.
+func (c *Calendar) HolidaysOnWeekday(year int, weekday time.Weekday) []Holiday {
+	var result []Holiday
+	for _, h := range c.HolidaysInYear(year) {
+		if h.Date.Weekday() == weekday {
+			result = append(result, h)
+		}
+	}
+	return result
 }
 
 // HolidaysBetween returns all holidays in the range [from, to] inclusive,
@@ -115,55 +225,72 @@ func (c *Calendar) HolidaysBetween(from, to time.Time) []Holiday {
 // If a built-in and a custom holiday exist on the same date, only the custom
 // holiday is returned.
 func (c *Calendar) Holidays() []Holiday {
-	c.mu.RLock()
-	defer c.mu.RUnlock()
+	return c.holidaysInRange(minDate, maxDate)
+}
 
-	var result []Holiday
-	for d, name := range builtinHolidays {
-		if c.removed[d] {
-			continue
-		}
-		if _, ok := c.custom[d]; ok {
-			continue
-		}
-		result = append(result, Holiday{Date: d.toTime(), Name: name})
+// cachedRange is holidaysInRange memoized in s.ranges. Only ranges within
+// the built-in dataset are cached, which bounds the cache to the years and
+// months of the dataset; ranges outside it hold custom holidays at most and
+// are cheap to compute. Calendars with sources or a locale registered with
+// RegisterLocale are not cached, since both may change.
+func (s *snapshot) cachedRange(from, to date) []Holiday {
+	if data := s.data(); from.before(data.first) || to.after(data.last) || len(s.sources) > 0 {
+		return s.holidaysInRange(from, to)
 	}
-	for d, name := range c.custom {
-		result = append(result, Holiday{Date: d.toTime(), Name: name})
+	if _, ok := registeredNames(s.locale); ok {
+		return s.holidaysInRange(from, to)
 	}
-
-	sort.Slice(result, func(i, j int) bool {
-		return result[i].Date.Before(result[j].Date)
-	})
-	return result
+	key := [2]date{from, to}
+	if v, ok := s.ranges.Load(key); ok {
+		return slices.Clone(v.([]Holiday))
+	}
+	result := s.holidaysInRange(from, to)
+	s.ranges.Store(key, result)
+	return slices.Clone(result)
 }
 
-// holidaysInRange collects holidays within the given date range (inclusive).
+// holidaysInRange collects holidays within the given date range (inclusive),
+// sorted by date.
 func (c *Calendar) holidaysInRange(from, to date) []Holiday {
-	c.mu.RLock()
-	defer c.mu.RUnlock()
+	return c.load().holidaysInRange(from, to)
+}
 
-	var result []Holiday
-	for d, name := range builtinHolidays {
-		if c.removed[d] {
-			continue
+// holidaysInRange collects holidays within the given date range (inclusive),
+// sorted by date, or nil if there are none. The built-in and custom
+// holidays in the range are found by binary search and merged, so no
+// sorting or full scan is needed, and the result is allocated once.
+func (s *snapshot) holidaysInRange(from, to date) []Holiday {
+	data := s.data()
+	builtin := data.dates[searchDates(data.dates, from):searchDatesAfter(data.dates, to)]
+	custom := s.customDates[searchDates(s.customDates, from):searchDatesAfter(s.customDates, to)]
+	if len(builtin)+len(custom) == 0 && len(s.sources) == 0 {
+		return nil
+	}
+
+	result := make([]Holiday, 0, len(builtin)+len(custom))
+	appendCustom := func(until date) {
+		for len(custom) > 0 && !until.before(custom[0]) {
+			result = append(result, Holiday{Date: custom[0].toTime(), Name: s.custom[custom[0]]})
+			custom = custom[1:]
 		}
-		if _, ok := c.custom[d]; ok {
+	}
+	for _, d := range builtin {
+		if s.removed[d] {
 			continue
 		}
-		if d.inRange(from, to) {
-			result = append(result, Holiday{Date: d.toTime(), Name: name})
+		appendCustom(d)
+		if _, ok := s.custom[d]; ok {
+			continue
 		}
+		result = append(result, Holiday{Date: d.toTime(), Name: s.localName(d, data.names[d])})
 	}
-	for d, name := range c.custom {
-		if d.inRange(from, to) {
-			result = append(result, Holiday{Date: d.toTime(), Name: name})
-		}
+	appendCustom(to)
+	if len(s.sources) > 0 {
+		result = s.withSources(result, from, to)
+	}
+	if len(result) == 0 {
+		return nil
 	}
-
-	sort.Slice(result, func(i, j int) bool {
-		return result[i].Date.Before(result[j].Date)
-	})
 	return result
 }
 
@@ -173,35 +300,27 @@ func (c *Calendar) holidaysInRange(from, to date) []Holiday {
 // precedence in lookups and list APIs.
 func (c *Calendar) AddCustomHoliday(t time.Time, name string) {
 	d := dateFromTime(t)
-	c.mu.Lock()
-	defer c.mu.Unlock()
-	c.custom[d] = name
+	c.updateCustom(CustomHolidayChange{Date: d.toTime(), Name: name}, func(s *snapshot) { s.setCustom(d, name) })
 }
 
 // RemoveCustomHoliday removes a previously added custom holiday.
 // Has no effect if no custom holiday exists on that date.
 func (c *Calendar) RemoveCustomHoliday(t time.Time) {
 	d := dateFromTime(t)
-	c.mu.Lock()
-	defer c.mu.Unlock()
-	delete(c.custom, d)
+	c.updateCustom(CustomHolidayChange{Date: d.toTime(), Removed: true}, func(s *snapshot) { s.deleteCustom(d) })
 }
 
 // RemoveHoliday suppresses a built-in holiday so it no longer appears in queries.
 // Has no effect on custom holidays. Use [Calendar.RestoreHoliday] to undo.
 func (c *Calendar) RemoveHoliday(t time.Time) {
 	d := dateFromTime(t)
-	c.mu.Lock()
-	defer c.mu.Unlock()
-	c.removed[d] = true
+	c.update(func(s *snapshot) { s.removed[d] = true })
 }
 
 // RestoreHoliday restores a previously removed built-in holiday.
 func (c *Calendar) RestoreHoliday(t time.Time) {
 	d := dateFromTime(t)
-	c.mu.Lock()
-	defer c.mu.Unlock()
-	delete(c.removed, d)
+	c.update(func(s *snapshot) { delete(s.removed, d) })
 }
 
 // --- Package-level convenience functions ---
@@ -220,6 +339,11 @@ func HolidaysInMonth(year int, month time.Month) []Holiday {
 	return defaultCal.HolidaysInMonth(year, month)
 }
 
+// HolidaysOnWeekday returns the default calendar's holidays in the year on the given weekday.
+func HolidaysOnWeekday(year int, weekday time.Weekday) []Holiday {
+	return defaultCal.HolidaysOnWeekday(year, weekday)
+}
+
 // HolidaysBetween returns all holidays in the range [from, to] inclusive.
 func HolidaysBetween(from, to time.Time) []Holiday {
 	return defaultCal.HolidaysBetween(from, to)
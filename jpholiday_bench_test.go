@@ -12,6 +12,15 @@ func BenchmarkIsHoliday_Hit(b *testing.B) {
 	}
 }
 
+func BenchmarkIsHoliday_Parallel(b *testing.B) {
+	t := d(2026, time.January, 1)
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			IsHoliday(t)
+		}
+	})
+}
+
 func BenchmarkIsHoliday_Miss(b *testing.B) {
 	t := d(2026, time.June, 10)
 	for b.Loop() {
@@ -19,6 +28,17 @@ func BenchmarkIsHoliday_Miss(b *testing.B) {
 	}
 }
 
+func BenchmarkAreHolidays(b *testing.B) {
+	b.ReportAllocs()
+	ts := make([]time.Time, 1000)
+	for i := range ts {
+		ts[i] = d(2026, time.January, 1).Add(time.Duration(i) * 7 * time.Hour)
+	}
+	for b.Loop() {
+		AreHolidays(ts)
+	}
+}
+
 func BenchmarkHolidayName(b *testing.B) {
 	t := d(2026, time.January, 1)
 	for b.Loop() {
@@ -27,18 +47,21 @@ func BenchmarkHolidayName(b *testing.B) {
 }
 
 func BenchmarkHolidaysInYear(b *testing.B) {
+	b.ReportAllocs()
 	for b.Loop() {
 		HolidaysInYear(2026)
 	}
 }
 
 func BenchmarkHolidaysInMonth(b *testing.B) {
+	b.ReportAllocs()
 	for b.Loop() {
 		HolidaysInMonth(2026, time.May)
 	}
 }
 
 func BenchmarkHolidaysBetween(b *testing.B) {
+	b.ReportAllocs()
 	from := d(2026, time.April, 28)
 	to := d(2026, time.May, 7)
 	for b.Loop() {
@@ -89,3 +112,32 @@ func BenchmarkBusinessDaysBetween_Year(b *testing.B) {
 		BusinessDaysBetween(from, to)
 	}
 }
+
+// TestAllocations guards the allocation counts listed in the README: lookups
+// allocate nothing, and range and batch queries allocate only their result.
+func TestAllocations(t *testing.T) {
+	day := d(2026, time.May, 4)
+	from, to := d(2026, time.April, 28), d(2026, time.May, 7)
+	ts := []time.Time{from, day, to}
+	tests := []struct {
+		name string
+		f    func()
+		want float64
+	}{
+		{"IsHoliday", func() { IsHoliday(day) }, 0},
+		{"HolidayName", func() { HolidayName(day) }, 0},
+		{"IsBusinessDay", func() { IsBusinessDay(day) }, 0},
+		{"NextBusinessDay", func() { NextBusinessDay(day) }, 0},
+		{"NextHoliday", func() { NextHoliday(day) }, 0},
+		{"BusinessDaysBetween", func() { BusinessDaysBetween(from, to) }, 0},
+		{"HolidaysInYear", func() { HolidaysInYear(2026) }, 1},
+		{"HolidaysInMonth", func() { HolidaysInMonth(2026, time.May) }, 1},
+		{"HolidaysBetween", func() { HolidaysBetween(from, to) }, 1},
+		{"AreHolidays", func() { AreHolidays(ts) }, 1},
+	}
+	for _, tt := range tests {
+		if got := testing.AllocsPerRun(100, tt.f); got != tt.want {
+			t.Errorf("%s allocs = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -7,42 +7,168 @@ import "time"
 // beyond any realistic consecutive non-business-day streak.
 const maxSearchDays = 366
 
+// weekdaySet is a bitmask of weekdays, indexed by time.Weekday.
+type weekdaySet uint8
+
+// defaultWeekend is Saturday and Sunday.
+const defaultWeekend = weekdaySet(1<<time.Saturday | 1<<time.Sunday)
+
+// allWeekdays is the set of all seven weekdays.
+const allWeekdays = weekdaySet(1<<7 - 1)
+
+func (s weekdaySet) has(wd time.Weekday) bool { return s&(1<<wd) != 0 }
+
 // IsBusinessDay reports whether the given date is a business day
 // (neither a weekend nor a holiday). The date is interpreted in JST.
+// Weekends are Saturday and Sunday unless changed with [Calendar.SetWeekend];
+// closures added with [Calendar.AddClosure] and local holidays added with
+// [WithPrefecture] are also non-business days.
 func (c *Calendar) IsBusinessDay(t time.Time) bool {
-	wd := t.In(jstZone).Weekday()
-	if wd == time.Saturday || wd == time.Sunday {
+	d := dateFromTime(t)
+	return c.load().businessDay(d)
+}
+
+// businessDay reports whether d is a business day.
+func (s *snapshot) businessDay(d date) bool {
+	return !s.weekend.has(d.weekday()) && !s.dayOff(d)
+}
+
+// dayOff reports whether d is a holiday, a local holiday, or a closure,
+// whatever its weekday.
+func (s *snapshot) dayOff(d date) bool {
+	if s.closed[d] {
+		return true
+	}
+	if _, ok := s.local[d]; ok {
+		return true
+	}
+	if _, ok := s.custom[d]; ok {
+		return true
+	}
+	if s.removed[d] {
 		return false
 	}
-	return !c.IsHoliday(t)
+	if len(s.sources) > 0 {
+		if _, ok := s.sourceLookup(d); ok {
+			return true
+		}
+	}
+	_, ok := s.data().names[d]
+	return ok
+}
+
+// SetWeekend sets the weekdays treated as non-business days. Calling it with
+// no arguments makes every weekday a potential business day.
+func (c *Calendar) SetWeekend(days ...time.Weekday) {
+	var set weekdaySet
+	for _, wd := range days {
+		set |= 1 << wd
+	}
+	c.update(func(s *snapshot) { s.weekend = set })
+}
+
+// Weekend returns the weekdays treated as non-business days, in order from Sunday.
+func (c *Calendar) Weekend() []time.Weekday {
+	set := c.load().weekend
+
+	var days []time.Weekday
+	for wd := time.Sunday; wd <= time.Saturday; wd++ {
+		if set.has(wd) {
+			days = append(days, wd)
+		}
+	}
+	return days
+}
+
+// AddClosure marks the given date as a non-business day without making it a
+// holiday, e.g. a company-wide shutdown or a bank closure day. Closures affect
+// the business day functions only; they never appear in holiday lookups.
+func (c *Calendar) AddClosure(t time.Time) {
+	d := dateFromTime(t)
+	c.update(func(s *snapshot) { s.closed[d] = true })
+}
+
+// RemoveClosure removes a closure added with [Calendar.AddClosure].
+// Has no effect if the date is not a closure.
+func (c *Calendar) RemoveClosure(t time.Time) {
+	d := dateFromTime(t)
+	c.update(func(s *snapshot) { delete(s.closed, d) })
+}
+
+// IsClosure reports whether the given date was added with [Calendar.AddClosure].
+func (c *Calendar) IsClosure(t time.Time) bool {
+	d := dateFromTime(t)
+	return c.load().closed[d]
+}
+
+// YearEndPeriod returns the customary year-end and New Year period
+// (年末年始) that starts in the given year: December 29 through January 3 of
+// the next year, when government offices close. Only January 1 is a
+// national holiday; use [Calendar.AddYearEndClosure] to make the rest of the
+// period non-business days.
+func YearEndPeriod(year int) (first, last time.Time) {
+	return newDate(year, time.December, 29).toTime(), newDate(year+1, time.January, 3).toTime()
+}
+
+// yearEndDates returns the dates of the year-end period starting in year.
+func yearEndDates(year int) []date {
+	return []date{
+		newDate(year, time.December, 29),
+		newDate(year, time.December, 30),
+		newDate(year, time.December, 31),
+		newDate(year+1, time.January, 1),
+		newDate(year+1, time.January, 2),
+		newDate(year+1, time.January, 3),
+	}
+}
+
+// AddYearEndClosure adds closures for every day of the year-end period that
+// starts in the given year (see [YearEndPeriod]), as government offices
+// observe it. The [PresetGovernment] config preset does this for every year
+// of the dataset.
+func (c *Calendar) AddYearEndClosure(year int) {
+	c.update(func(s *snapshot) {
+		for _, d := range yearEndDates(year) {
+			s.closed[d] = true
+		}
+	})
+}
+
+// governmentClosures adds closures for the days of the year-end periods
+// that fall in the years first through last.
+func governmentClosures(s *snapshot, first, last int) {
+	for year := first - 1; year <= last; year++ {
+		for _, d := range yearEndDates(year) {
+			if y := d.year(); first <= y && y <= last {
+				s.closed[d] = true
+			}
+		}
+	}
 }
 
 // NextHoliday returns the next holiday strictly after the given date.
 // Returns false if no future holiday exists in the dataset.
 func (c *Calendar) NextHoliday(t time.Time) (Holiday, bool) {
 	d := dateFromTime(t)
+	s := c.load()
+	if len(s.sources) > 0 {
+		return firstHoliday(s.walkSources(d, 1, 1))
+	}
+
 	var best date
 	var bestName string
 	found := false
-
-	c.mu.RLock()
-	defer c.mu.RUnlock()
-
-	for hd, name := range builtinHolidays {
-		if c.removed[hd] {
-			continue
-		}
-		if hd.after(d) && (!found || hd.before(best)) {
-			best = hd
-			bestName = name
-			found = true
+	data := s.data()
+	for i := searchDatesAfter(data.dates, d); i < len(data.dates); i++ {
+		if hd := data.dates[i]; !s.removed[hd] {
+			best, bestName, found = hd, s.localName(hd, data.names[hd]), true
+			break
 		}
 	}
-	for hd, name := range c.custom {
-		if hd.after(d) && (!found || hd.before(best)) {
-			best = hd
-			bestName = name
-			found = true
+	// A custom holiday on the same date takes precedence.
+	if i := searchDatesAfter(s.customDates, d); i < len(s.customDates) {
+		if hd := s.customDates[i]; !found || !best.before(hd) {
+			best, bestName, found = hd, s.custom[hd], true
 		}
 	}
 
@@ -56,28 +182,25 @@ func (c *Calendar) NextHoliday(t time.Time) (Holiday, bool) {
 // Returns false if no past holiday exists in the dataset.
 func (c *Calendar) PreviousHoliday(t time.Time) (Holiday, bool) {
 	d := dateFromTime(t)
+	s := c.load()
+	if len(s.sources) > 0 {
+		return firstHoliday(s.walkSources(d, -1, 1))
+	}
+
 	var best date
 	var bestName string
 	found := false
-
-	c.mu.RLock()
-	defer c.mu.RUnlock()
-
-	for hd, name := range builtinHolidays {
-		if c.removed[hd] {
-			continue
-		}
-		if hd.before(d) && (!found || hd.after(best)) {
-			best = hd
-			bestName = name
-			found = true
+	data := s.data()
+	for i := searchDates(data.dates, d) - 1; i >= 0; i-- {
+		if hd := data.dates[i]; !s.removed[hd] {
+			best, bestName, found = hd, s.localName(hd, data.names[hd]), true
+			break
 		}
 	}
-	for hd, name := range c.custom {
-		if hd.before(d) && (!found || hd.after(best)) {
-			best = hd
-			bestName = name
-			found = true
+	// A custom holiday on the same date takes precedence.
+	if i := searchDates(s.customDates, d) - 1; i >= 0 {
+		if hd := s.customDates[i]; !found || !hd.before(best) {
+			best, bestName, found = hd, s.custom[hd], true
 		}
 	}
 
@@ -87,73 +210,375 @@ func (c *Calendar) PreviousHoliday(t time.Time) (Holiday, bool) {
 	return Holiday{Date: best.toTime(), Name: bestName}, true
 }
 
-// NextBusinessDay returns the next business day on or after the given date.
-// If t itself is a business day, it returns t (normalized to midnight UTC).
-// Returns the zero time if no business day is found within maxSearchDays.
-func (c *Calendar) NextBusinessDay(t time.Time) time.Time {
+// FirstHoliday returns the first holiday of the given year. ok is false if
+// the year has no holidays.
+func (c *Calendar) FirstHoliday(year int) (Holiday, bool) {
+	return c.firstHolidayIn(newDate(year, time.January, 1), newDate(year, time.December, 31))
+}
+
+// LastHoliday returns the last holiday of the given year. ok is false if the
+// year has no holidays.
+func (c *Calendar) LastHoliday(year int) (Holiday, bool) {
+	return c.lastHolidayIn(newDate(year, time.January, 1), newDate(year, time.December, 31))
+}
+
+// FirstHolidayInMonth returns the first holiday of the given month. ok is
+// false if the month has no holidays.
+func (c *Calendar) FirstHolidayInMonth(year int, month time.Month) (Holiday, bool) {
+	return c.firstHolidayIn(monthRange(year, month))
+}
+
+// LastHolidayInMonth returns the last holiday of the given month. ok is
+// false if the month has no holidays.
+func (c *Calendar) LastHolidayInMonth(year int, month time.Month) (Holiday, bool) {
+	return c.lastHolidayIn(monthRange(year, month))
+}
+
+// monthRange returns the first and last days of a month.
+func monthRange(year int, month time.Month) (first, last date) {
+	end := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC)
+	return newDate(year, month, 1), newDate(year, month, end.Day())
+}
+
+// firstHolidayIn returns the first holiday in [from, to].
+func (c *Calendar) firstHolidayIn(from, to date) (Holiday, bool) {
+	h, ok := c.NextHoliday(from.addDays(-1).toTime())
+	if !ok || dateFromTime(h.Date).after(to) {
+		return Holiday{}, false
+	}
+	return h, true
+}
+
+// lastHolidayIn returns the last holiday in [from, to].
+func (c *Calendar) lastHolidayIn(from, to date) (Holiday, bool) {
+	h, ok := c.PreviousHoliday(to.addDays(1).toTime())
+	if !ok || dateFromTime(h.Date).before(from) {
+		return Holiday{}, false
+	}
+	return h, true
+}
+
+// HolidayWithin returns the first holiday in the given number of days
+// after the given date (interpreted in JST), answering "is there a holiday
+// in the next 7 days?" with a single index lookup. The window starts the
+// day after t and includes the last day, so a days of 7 covers the coming
+// week. ok is false if there is no holiday in the window or days is less
+// than 1.
+func (c *Calendar) HolidayWithin(t time.Time, days int) (Holiday, bool) {
+	if days < 1 {
+		return Holiday{}, false
+	}
+	h, ok := c.NextHoliday(t)
+	if !ok || dateFromTime(h.Date).after(dateFromTime(t).addDays(days)) {
+		return Holiday{}, false
+	}
+	return h, true
+}
+
+// Upcoming returns up to n holidays strictly after the given date, sorted
+// by date, as repeated calls to [Calendar.NextHoliday] would. It returns
+// nil if n is less than 1 or there are no later holidays.
+func (c *Calendar) Upcoming(t time.Time, n int) []Holiday {
 	d := dateFromTime(t)
-	cur := d.toTime()
-	for i := 0; i < maxSearchDays; i++ {
-		if c.IsBusinessDay(cur) {
-			return cur
+	s := c.load()
+	if len(s.sources) > 0 {
+		return s.walkSources(d, 1, n)
+	}
+	return s.walkHolidays(searchDatesAfter(s.data().dates, d), searchDatesAfter(s.customDates, d), 1, n)
+}
+
+// Recent returns up to n holidays strictly before the given date, most
+// recent first, as repeated calls to [Calendar.PreviousHoliday] would. It
+// returns nil if n is less than 1 or there are no earlier holidays.
+func (c *Calendar) Recent(t time.Time, n int) []Holiday {
+	d := dateFromTime(t)
+	s := c.load()
+	if len(s.sources) > 0 {
+		return s.walkSources(d, -1, n)
+	}
+	return s.walkHolidays(searchDates(s.data().dates, d)-1, searchDates(s.customDates, d)-1, -1, n)
+}
+
+// walkHolidays collects up to n holidays starting at index bi of
+// the dataset's dates and ci of s.customDates, moving through both in the
+// direction of step. A custom holiday takes precedence over a built-in
+// holiday on the same date.
+func (s *snapshot) walkHolidays(bi, ci, step, n int) []Holiday {
+	data := s.data()
+	var result []Holiday
+	for len(result) < n {
+		for bi >= 0 && bi < len(data.dates) && s.removed[data.dates[bi]] {
+			bi += step
+		}
+		var bd, cd date
+		if bi >= 0 && bi < len(data.dates) {
+			bd = data.dates[bi]
+		}
+		if ci >= 0 && ci < len(s.customDates) {
+			cd = s.customDates[ci]
+		}
+		switch {
+		case cd != 0 && (bd == 0 || step > 0 && !cd.after(bd) || step < 0 && !cd.before(bd)):
+			if bd == cd {
+				bi += step
+			}
+			result = append(result, Holiday{Date: cd.toTime(), Name: s.custom[cd]})
+			ci += step
+		case bd != 0:
+			result = append(result, Holiday{Date: bd.toTime(), Name: s.localName(bd, data.names[bd])})
+			bi += step
+		default:
+			return result
 		}
-		cur = cur.AddDate(0, 0, 1)
 	}
-	return time.Time{}
+	return result
+}
+
+// NextBusinessDay returns the next business day on or after the given date.
+// If t itself is a business day, it returns t (normalized to midnight UTC).
+// ok is false if there is no business day within maxSearchDays days, for
+// example when every weekday is a weekend day.
+func (c *Calendar) NextBusinessDay(t time.Time) (next time.Time, ok bool) {
+	d, ok := c.load().searchBusinessDay(dateFromTime(t), 1)
+	if !ok {
+		return time.Time{}, false
+	}
+	return d.toTime(), true
 }
 
 // PreviousBusinessDay returns the most recent business day on or before the given date.
 // If t itself is a business day, it returns t (normalized to midnight UTC).
-// Returns the zero time if no business day is found within maxSearchDays.
-func (c *Calendar) PreviousBusinessDay(t time.Time) time.Time {
-	d := dateFromTime(t)
-	cur := d.toTime()
-	for i := 0; i < maxSearchDays; i++ {
-		if c.IsBusinessDay(cur) {
-			return cur
+// ok is false if there is no business day within maxSearchDays days.
+func (c *Calendar) PreviousBusinessDay(t time.Time) (prev time.Time, ok bool) {
+	d, ok := c.load().searchBusinessDay(dateFromTime(t), -1)
+	if !ok {
+		return time.Time{}, false
+	}
+	return d.toTime(), true
+}
+
+// searchBusinessDay returns the first business day at or after d (step 1) or
+// at or before d (step -1), looking at most maxSearchDays days away. It
+// steps over day numbers and tracks the weekday arithmetically, so weekend
+// days are skipped without any date conversion or lookup; holidays and
+// closures are looked up only for the remaining candidates.
+func (s *snapshot) searchBusinessDay(d date, step int) (date, bool) {
+	if s.weekend == allWeekdays {
+		return 0, false
+	}
+	start := d.days()
+	wd := d.weekday()
+	for n := start; (n-start)*step < maxSearchDays; n += step {
+		if !s.weekend.has(wd) {
+			if cur := dateFromDays(n); !s.dayOff(cur) {
+				return cur, true
+			}
 		}
-		cur = cur.AddDate(0, 0, -1)
+		wd = (wd + time.Weekday(step) + 7) % 7
 	}
-	return time.Time{}
+	return 0, false
 }
 
 // BusinessDaysBetween returns the count of business days in the range [from, to] inclusive.
 // If from is after to, returns 0.
+//
+// With the default weekend, no sources, and the built-in dataset, the part of the range within the
+// built-in dataset is counted in constant time from precomputed sums,
+// corrected for custom holidays, removed holidays, and closures in the
+// range.
 func (c *Calendar) BusinessDaysBetween(from, to time.Time) int {
 	fromD := dateFromTime(from)
 	toD := dateFromTime(to)
 	if toD.before(fromD) {
 		return 0
 	}
+	lo, hi := fromD.days(), toD.days()
+
+	s := c.load()
+	sums := builtinBusinessDays()
+	base := builtin().first.days()
+	pLo, pHi := max(lo, base), min(hi, base+len(sums)-2)
+	if s.weekend != defaultWeekend || len(s.sources) > 0 || s.official != nil || pLo > pHi {
+		return s.countBusinessDays(lo, hi)
+	}
+	count := int(sums[pHi-base+1] - sums[pLo-base])
+	count += s.businessDayAdjustment(dateFromDays(pLo), dateFromDays(pHi))
+	if lo < pLo {
+		count += s.countBusinessDays(lo, pLo-1)
+	}
+	if hi > pHi {
+		count += s.countBusinessDays(pHi+1, hi)
+	}
+	return count
+}
 
+// countBusinessDays counts business days day by day in the range of day
+// numbers [lo, hi].
+func (s *snapshot) countBusinessDays(lo, hi int) int {
 	count := 0
-	cur := fromD.toTime()
-	end := toD.toTime()
-	for !cur.After(end) {
-		if c.IsBusinessDay(cur) {
+	for n := lo; n <= hi; n++ {
+		if s.businessDay(dateFromDays(n)) {
 			count++
 		}
-		cur = cur.AddDate(0, 0, 1)
 	}
 	return count
 }
 
+// businessDayAdjustment returns the difference between the actual number of
+// business days in [from, to] and the count in builtinBusinessDays, which
+// assumes the default weekend and no custom holidays, removals, closures, or
+// local holidays.
+func (s *snapshot) businessDayAdjustment(from, to date) int {
+	adj := 0
+	adjust := func(d date) {
+		_, holiday := builtin().names[d]
+		baseline := !defaultWeekend.has(d.weekday()) && !holiday
+		switch actual := s.businessDay(d); {
+		case actual && !baseline:
+			adj++
+		case !actual && baseline:
+			adj--
+		}
+	}
+
+	// Each date is adjusted once, even if it is, say, both a custom holiday
+	// and a closure.
+	for _, d := range s.customDates[searchDates(s.customDates, from):searchDatesAfter(s.customDates, to)] {
+		adjust(d)
+	}
+	for d := range s.removed {
+		if _, ok := s.custom[d]; !ok && d.inRange(from, to) {
+			adjust(d)
+		}
+	}
+	for d := range s.closed {
+		if _, ok := s.custom[d]; !ok && !s.removed[d] && d.inRange(from, to) {
+			adjust(d)
+		}
+	}
+	for d := range s.local {
+		if _, ok := s.custom[d]; !ok && !s.removed[d] && !s.closed[d] && d.inRange(from, to) {
+			adjust(d)
+		}
+	}
+	return adj
+}
+
+// HolidayPeriod is a run of consecutive non-business days (連休).
+type HolidayPeriod struct {
+	From time.Time // The first day of the run (midnight UTC).
+	To   time.Time // The last day of the run, inclusive (midnight UTC).
+	Days int       // The number of days in the run.
+}
+
+// ConsecutiveHolidayPeriods returns every run of consecutive non-business
+// days (weekends, holidays, and closures) of at least minDays days that
+// includes a day of the given year, in date order. A run that crosses into
+// the previous or next year, such as the New Year break, is reported in
+// full. Runs are followed at most maxSearchDays days beyond the year, so a
+// calendar with no business days reports a single truncated run.
+//
+// For example, ConsecutiveHolidayPeriods(2026, 3) returns all the long
+// weekends and Golden Week of 2026.
+func (c *Calendar) ConsecutiveHolidayPeriods(year, minDays int) []HolidayPeriod {
+	s := c.load()
+	lo := newDate(year, time.January, 1).days()
+	hi := newDate(year, time.December, 31).days()
+	// Extend a run that crosses either end of the year.
+	off := func(n int) bool { return !s.businessDay(dateFromDays(n)) }
+	for limit := lo - maxSearchDays; lo > limit && off(lo) && off(lo-1); {
+		lo--
+	}
+	for limit := hi + maxSearchDays; hi < limit && off(hi) && off(hi+1); {
+		hi++
+	}
+
+	var periods []HolidayPeriod
+	start := -1
+	for n := lo; n <= hi+1; n++ {
+		if n <= hi && off(n) {
+			if start < 0 {
+				start = n
+			}
+			continue
+		}
+		if start >= 0 && n-start >= minDays {
+			periods = append(periods, HolidayPeriod{
+				From: dateFromDays(start).toTime(),
+				To:   dateFromDays(n - 1).toTime(),
+				Days: n - start,
+			})
+		}
+		start = -1
+	}
+	return periods
+}
+
 // --- Package-level convenience functions ---
 
 // IsBusinessDay reports whether the given date is a business day.
 func IsBusinessDay(t time.Time) bool { return defaultCal.IsBusinessDay(t) }
 
+// SetWeekend sets the weekdays treated as non-business days on the default calendar.
+func SetWeekend(days ...time.Weekday) { defaultCal.SetWeekend(days...) }
+
+// Weekend returns the weekdays treated as non-business days on the default calendar.
+func Weekend() []time.Weekday { return defaultCal.Weekend() }
+
+// AddClosure marks a date as a non-business day on the default calendar.
+func AddClosure(t time.Time) { defaultCal.AddClosure(t) }
+
+// RemoveClosure removes a closure from the default calendar.
+func RemoveClosure(t time.Time) { defaultCal.RemoveClosure(t) }
+
+// IsClosure reports whether a date is a closure on the default calendar.
+func IsClosure(t time.Time) bool { return defaultCal.IsClosure(t) }
+
+// AddYearEndClosure adds closures for the year-end period starting in the given year on the default calendar.
+func AddYearEndClosure(year int) { defaultCal.AddYearEndClosure(year) }
+
 // NextHoliday returns the next holiday strictly after the given date.
 func NextHoliday(t time.Time) (Holiday, bool) { return defaultCal.NextHoliday(t) }
 
 // PreviousHoliday returns the most recent holiday strictly before the given date.
 func PreviousHoliday(t time.Time) (Holiday, bool) { return defaultCal.PreviousHoliday(t) }
 
+// FirstHoliday returns the default calendar's first holiday of the year.
+func FirstHoliday(year int) (Holiday, bool) { return defaultCal.FirstHoliday(year) }
+
+// LastHoliday returns the default calendar's last holiday of the year.
+func LastHoliday(year int) (Holiday, bool) { return defaultCal.LastHoliday(year) }
+
+// FirstHolidayInMonth returns the default calendar's first holiday of the month.
+func FirstHolidayInMonth(year int, month time.Month) (Holiday, bool) {
+	return defaultCal.FirstHolidayInMonth(year, month)
+}
+
+// LastHolidayInMonth returns the default calendar's last holiday of the month.
+func LastHolidayInMonth(year int, month time.Month) (Holiday, bool) {
+	return defaultCal.LastHolidayInMonth(year, month)
+}
+
+// HolidayWithin returns the first holiday in the given number of days after the date on the default calendar.
+func HolidayWithin(t time.Time, days int) (Holiday, bool) { return defaultCal.HolidayWithin(t, days) }
+
+// Upcoming returns up to n holidays after the given date on the default calendar.
+func Upcoming(t time.Time, n int) []Holiday { return defaultCal.Upcoming(t, n) }
+
+// Recent returns up to n holidays before the given date, most recent first, on the default calendar.
+func Recent(t time.Time, n int) []Holiday { return defaultCal.Recent(t, n) }
+
 // NextBusinessDay returns the next business day on or after the given date.
-func NextBusinessDay(t time.Time) time.Time { return defaultCal.NextBusinessDay(t) }
+func NextBusinessDay(t time.Time) (time.Time, bool) { return defaultCal.NextBusinessDay(t) }
 
 // PreviousBusinessDay returns the most recent business day on or before the given date.
-func PreviousBusinessDay(t time.Time) time.Time { return defaultCal.PreviousBusinessDay(t) }
+func PreviousBusinessDay(t time.Time) (time.Time, bool) { return defaultCal.PreviousBusinessDay(t) }
 
 // BusinessDaysBetween returns the count of business days in the range [from, to].
 func BusinessDaysBetween(from, to time.Time) int { return defaultCal.BusinessDaysBetween(from, to) }
+
+// ConsecutiveHolidayPeriods returns the default calendar's runs of at least
+// minDays non-business days that include a day of the given year.
+func ConsecutiveHolidayPeriods(year, minDays int) []HolidayPeriod {
+	return defaultCal.ConsecutiveHolidayPeriods(year, minDays)
+}
@@ -1,6 +1,8 @@
 package jpholiday_test
 
 import (
+	"fmt"
+	"slices"
 	"testing"
 	"time"
 
@@ -152,6 +154,43 @@ func TestPreviousHoliday_StartOfDataset(t *testing.T) {
 	}
 }
 
+func TestNextPreviousHoliday_CustomAndRemoved(t *testing.T) {
+	t.Parallel()
+
+	cal := New()
+	cal.AddCustomHoliday(d(2026, time.March, 1), "創立記念日")
+	cal.AddCustomHoliday(d(2026, time.January, 12), "社内成人式") // overrides 成人の日
+	cal.AddCustomHoliday(d(2026, time.June, 1), "removed later")
+	cal.RemoveCustomHoliday(d(2026, time.June, 1))
+	cal.RemoveHoliday(d(2026, time.February, 11))
+	cal.RemoveHoliday(d(2026, time.July, 20))
+
+	tests := []struct {
+		name string
+		fn   func(time.Time) (Holiday, bool)
+		from time.Time
+		want Holiday
+	}{
+		{"next custom overrides built-in", cal.NextHoliday, d(2026, time.January, 1), Holiday{d(2026, time.January, 12), "社内成人式"}},
+		{"next skips removed", cal.NextHoliday, d(2026, time.January, 12), Holiday{d(2026, time.February, 23), "天皇誕生日"}},
+		{"next custom before built-in", cal.NextHoliday, d(2026, time.February, 23), Holiday{d(2026, time.March, 1), "創立記念日"}},
+		{"next skips removed custom", cal.NextHoliday, d(2026, time.May, 6), Holiday{d(2026, time.August, 11), "山の日"}},
+		{"previous custom overrides built-in", cal.PreviousHoliday, d(2026, time.February, 23), Holiday{d(2026, time.January, 12), "社内成人式"}},
+		{"previous custom after built-in", cal.PreviousHoliday, d(2026, time.March, 20), Holiday{d(2026, time.March, 1), "創立記念日"}},
+		{"previous skips removed", cal.PreviousHoliday, d(2026, time.August, 11), Holiday{d(2026, time.May, 6), "休日"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, ok := tt.fn(tt.from)
+			if !ok || got != tt.want {
+				t.Errorf("got %v %q, %v; want %v %q",
+					got.Date.Format("2006-01-02"), got.Name, ok, tt.want.Date.Format("2006-01-02"), tt.want.Name)
+			}
+		})
+	}
+}
+
 func TestNextBusinessDay(t *testing.T) {
 	t.Parallel()
 
@@ -171,8 +210,8 @@ func TestNextBusinessDay(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			got := NextBusinessDay(tt.date)
-			if got != tt.want {
+			got, ok := NextBusinessDay(tt.date)
+			if !ok || got != tt.want {
 				t.Errorf("NextBusinessDay(%s) = %s, want %s",
 					tt.date.Format("2006-01-02"),
 					got.Format("2006-01-02"),
@@ -182,7 +221,7 @@ func TestNextBusinessDay(t *testing.T) {
 	}
 }
 
-func TestNextBusinessDay_ZeroOnExhaustion(t *testing.T) {
+func TestNextBusinessDay_NotFound(t *testing.T) {
 	t.Parallel()
 
 	cal := New()
@@ -192,13 +231,12 @@ func TestNextBusinessDay_ZeroOnExhaustion(t *testing.T) {
 		day := start.AddDate(0, 0, i)
 		cal.AddCustomHoliday(day, "blocked")
 	}
-	got := cal.NextBusinessDay(start)
-	if !got.IsZero() {
-		t.Errorf("expected zero time on exhaustion, got %s", got.Format("2006-01-02"))
+	if got, ok := cal.NextBusinessDay(start); ok || !got.IsZero() {
+		t.Errorf("NextBusinessDay = %s, %v, want zero time and false", got.Format("2006-01-02"), ok)
 	}
 }
 
-func TestPreviousBusinessDay_ZeroOnExhaustion(t *testing.T) {
+func TestPreviousBusinessDay_NotFound(t *testing.T) {
 	t.Parallel()
 
 	cal := New()
@@ -207,9 +245,47 @@ func TestPreviousBusinessDay_ZeroOnExhaustion(t *testing.T) {
 		day := start.AddDate(0, 0, -i)
 		cal.AddCustomHoliday(day, "blocked")
 	}
-	got := cal.PreviousBusinessDay(start)
-	if !got.IsZero() {
-		t.Errorf("expected zero time on exhaustion, got %s", got.Format("2006-01-02"))
+	if got, ok := cal.PreviousBusinessDay(start); ok || !got.IsZero() {
+		t.Errorf("PreviousBusinessDay = %s, %v, want zero time and false", got.Format("2006-01-02"), ok)
+	}
+}
+
+func TestNextBusinessDay_AllWeekend(t *testing.T) {
+	t.Parallel()
+
+	cal := New()
+	cal.SetWeekend(time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday)
+	if _, ok := cal.NextBusinessDay(d(2026, time.June, 5)); ok {
+		t.Error("NextBusinessDay found a business day with every weekday a weekend day")
+	}
+	if _, ok := cal.PreviousBusinessDay(d(2026, time.June, 5)); ok {
+		t.Error("PreviousBusinessDay found a business day with every weekday a weekend day")
+	}
+}
+
+func TestNextPreviousBusinessDay_MatchesIsBusinessDay(t *testing.T) {
+	t.Parallel()
+
+	cal := New()
+	cal.SetWeekend(time.Friday, time.Saturday)
+	cal.AddClosure(d(2025, time.December, 29))
+	cal.AddCustomHoliday(d(2026, time.January, 5), "仕事始め延期")
+	cal.RemoveHoliday(d(2026, time.January, 12))
+
+	// naive steps one day at a time, as the search did originally.
+	naive := func(day time.Time, step int) time.Time {
+		for !cal.IsBusinessDay(day) {
+			day = day.AddDate(0, 0, step)
+		}
+		return day
+	}
+	for day := d(2025, time.December, 1); day.Before(d(2026, time.February, 1)); day = day.AddDate(0, 0, 1) {
+		if got, _ := cal.NextBusinessDay(day); !got.Equal(naive(day, 1)) {
+			t.Errorf("NextBusinessDay(%s) = %s, want %s", day.Format(time.DateOnly), got.Format(time.DateOnly), naive(day, 1).Format(time.DateOnly))
+		}
+		if got, _ := cal.PreviousBusinessDay(day); !got.Equal(naive(day, -1)) {
+			t.Errorf("PreviousBusinessDay(%s) = %s, want %s", day.Format(time.DateOnly), got.Format(time.DateOnly), naive(day, -1).Format(time.DateOnly))
+		}
 	}
 }
 
@@ -229,8 +305,8 @@ func TestPreviousBusinessDay(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			got := PreviousBusinessDay(tt.date)
-			if got != tt.want {
+			got, ok := PreviousBusinessDay(tt.date)
+			if !ok || got != tt.want {
 				t.Errorf("PreviousBusinessDay(%s) = %s, want %s",
 					tt.date.Format("2006-01-02"),
 					got.Format("2006-01-02"),
@@ -272,3 +348,346 @@ func TestBusinessDaysBetween(t *testing.T) {
 		})
 	}
 }
+
+func TestSetWeekend(t *testing.T) {
+	t.Parallel()
+
+	cal := New()
+	if got := cal.Weekend(); len(got) != 2 || got[0] != time.Sunday || got[1] != time.Saturday {
+		t.Fatalf("default Weekend() = %v, want [Sunday Saturday]", got)
+	}
+
+	// 2026-06-05 is a Friday, 2026-06-06 a Saturday.
+	cal.SetWeekend(time.Friday, time.Saturday)
+	if cal.IsBusinessDay(d(2026, time.June, 5)) {
+		t.Error("Friday should not be a business day with a Friday/Saturday weekend")
+	}
+	if !cal.IsBusinessDay(d(2026, time.June, 7)) {
+		t.Error("Sunday should be a business day with a Friday/Saturday weekend")
+	}
+	if got, _ := cal.NextBusinessDay(d(2026, time.June, 5)); !got.Equal(d(2026, time.June, 7)) {
+		t.Errorf("NextBusinessDay = %s, want 2026-06-07", got.Format("2006-01-02"))
+	}
+
+	cal.SetWeekend()
+	if got := cal.Weekend(); len(got) != 0 {
+		t.Errorf("Weekend() after SetWeekend() = %v, want empty", got)
+	}
+	if !cal.IsBusinessDay(d(2026, time.June, 6)) {
+		t.Error("Saturday should be a business day with no weekend")
+	}
+	if cal.IsBusinessDay(d(2026, time.January, 1)) {
+		t.Error("holidays should still be non-business days with no weekend")
+	}
+}
+
+func TestAddClosure(t *testing.T) {
+	t.Parallel()
+
+	cal := New()
+	day := d(2026, time.June, 10) // Wednesday
+	cal.AddClosure(day)
+
+	if !cal.IsClosure(day) {
+		t.Error("IsClosure should report the added closure")
+	}
+	if cal.IsBusinessDay(day) {
+		t.Error("a closure should not be a business day")
+	}
+	if cal.IsHoliday(day) {
+		t.Error("a closure should not be a holiday")
+	}
+	if got, _ := cal.NextBusinessDay(day); !got.Equal(d(2026, time.June, 11)) {
+		t.Errorf("NextBusinessDay = %s, want 2026-06-11", got.Format("2006-01-02"))
+	}
+
+	cal.RemoveClosure(day)
+	if cal.IsClosure(day) || !cal.IsBusinessDay(day) {
+		t.Error("RemoveClosure should restore the business day")
+	}
+}
+
+func TestBusinessDaysBetween_MatchesIsBusinessDay(t *testing.T) {
+	t.Parallel()
+
+	adjusted := New()
+	adjusted.AddCustomHoliday(d(1954, time.December, 28), "before dataset")
+	adjusted.AddCustomHoliday(d(2026, time.June, 15), "weekday custom")
+	adjusted.AddCustomHoliday(d(2026, time.June, 20), "Saturday custom")
+	adjusted.AddCustomHoliday(d(2026, time.July, 20), "custom on 海の日")
+	adjusted.AddCustomHoliday(d(2026, time.August, 11), "custom on removed")
+	adjusted.RemoveHoliday(d(2026, time.August, 11))
+	adjusted.RemoveHoliday(d(2026, time.September, 21))
+	adjusted.AddClosure(d(2026, time.September, 21)) // removed and closed
+	adjusted.AddClosure(d(2026, time.June, 15))      // custom and closed
+	adjusted.AddClosure(d(2026, time.December, 31))
+	adjusted.AddClosure(d(2028, time.January, 4)) // after dataset
+
+	sixDay := New()
+	sixDay.SetWeekend(time.Sunday)
+
+	cals := map[string]*Calendar{"default": New(), "adjusted": adjusted, "six-day week": sixDay}
+	ranges := [][2]time.Time{
+		{d(2026, time.January, 1), d(2026, time.December, 31)},
+		{d(2026, time.June, 15), d(2026, time.June, 15)},
+		{d(2026, time.August, 11), d(2026, time.September, 30)},
+		{d(1954, time.December, 1), d(1955, time.February, 1)},
+		{d(2027, time.December, 1), d(2028, time.January, 31)},
+		{d(2030, time.January, 1), d(2030, time.March, 1)},
+		{d(1955, time.January, 1), d(2027, time.December, 31)},
+	}
+	for name, cal := range cals {
+		for _, r := range ranges {
+			want := 0
+			for cur := r[0]; !cur.After(r[1]); cur = cur.AddDate(0, 0, 1) {
+				if cal.IsBusinessDay(cur) {
+					want++
+				}
+			}
+			if got := cal.BusinessDaysBetween(r[0], r[1]); got != want {
+				t.Errorf("%s: BusinessDaysBetween(%s, %s) = %d, want %d",
+					name, r[0].Format("2006-01-02"), r[1].Format("2006-01-02"), got, want)
+			}
+		}
+	}
+}
+
+func TestConsecutiveHolidayPeriods(t *testing.T) {
+	t.Parallel()
+
+	want := []string{
+		"2026-01-10..2026-01-12 (3)",
+		"2026-02-21..2026-02-23 (3)",
+		"2026-03-20..2026-03-22 (3)",
+		"2026-05-02..2026-05-06 (5)", // Golden Week
+		"2026-07-18..2026-07-20 (3)",
+		"2026-09-19..2026-09-23 (5)", // Silver Week
+		"2026-10-10..2026-10-12 (3)",
+		"2026-11-21..2026-11-23 (3)",
+	}
+	var got []string
+	for _, p := range ConsecutiveHolidayPeriods(2026, 3) {
+		got = append(got, fmt.Sprintf("%s..%s (%d)", p.From.Format(time.DateOnly), p.To.Format(time.DateOnly), p.Days))
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("ConsecutiveHolidayPeriods(2026, 3) =\n%v\nwant\n%v", got, want)
+	}
+	if got := ConsecutiveHolidayPeriods(2026, 5); len(got) != 2 {
+		t.Errorf("ConsecutiveHolidayPeriods(2026, 5) = %v, want Golden Week and Silver Week", got)
+	}
+}
+
+func TestConsecutiveHolidayPeriods_CrossesYear(t *testing.T) {
+	t.Parallel()
+
+	cal := New()
+	for day := 28; day <= 31; day++ {
+		cal.AddClosure(d(2026, time.December, day))
+	}
+	cal.AddClosure(d(2027, time.January, 4))
+
+	// 2026-12-26 (Sat) through 2027-01-04 (Mon) is reported for both years.
+	for _, year := range []int{2026, 2027} {
+		periods := cal.ConsecutiveHolidayPeriods(year, 7)
+		if len(periods) != 1 || !periods[0].From.Equal(d(2026, time.December, 26)) ||
+			!periods[0].To.Equal(d(2027, time.January, 4)) || periods[0].Days != 10 {
+			t.Errorf("ConsecutiveHolidayPeriods(%d, 7) = %v", year, periods)
+		}
+	}
+}
+
+func TestConsecutiveHolidayPeriods_AllWeekend(t *testing.T) {
+	t.Parallel()
+
+	cal := New()
+	cal.SetWeekend(time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday)
+	periods := cal.ConsecutiveHolidayPeriods(2026, 1)
+	if len(periods) != 1 || periods[0].Days < 365 {
+		t.Errorf("ConsecutiveHolidayPeriods = %v, want one run covering the year", periods)
+	}
+}
+
+func TestYearEndPeriod(t *testing.T) {
+	t.Parallel()
+
+	first, last := YearEndPeriod(2026)
+	if !first.Equal(d(2026, time.December, 29)) || !last.Equal(d(2027, time.January, 3)) {
+		t.Errorf("YearEndPeriod(2026) = %s, %s", first.Format(time.DateOnly), last.Format(time.DateOnly))
+	}
+}
+
+func TestAddYearEndClosure(t *testing.T) {
+	t.Parallel()
+
+	cal := New()
+	if !cal.IsBusinessDay(d(2026, time.December, 29)) {
+		t.Fatal("2026-12-29 should be a business day by default")
+	}
+	cal.AddYearEndClosure(2026)
+	for day := d(2026, time.December, 29); !day.After(d(2027, time.January, 3)); day = day.AddDate(0, 0, 1) {
+		if cal.IsBusinessDay(day) {
+			t.Errorf("%s should be closed", day.Format(time.DateOnly))
+		}
+	}
+	if cal.IsHoliday(d(2026, time.December, 29)) {
+		t.Error("closures should not be holidays")
+	}
+	if !cal.IsBusinessDay(d(2027, time.January, 4)) || !cal.IsBusinessDay(d(2026, time.December, 28)) {
+		t.Error("days outside the period should be unaffected")
+	}
+}
+
+func TestUpcoming(t *testing.T) {
+	t.Parallel()
+
+	got := Upcoming(d(2026, time.April, 29), 4)
+	want := []time.Time{
+		d(2026, time.May, 3),
+		d(2026, time.May, 4),
+		d(2026, time.May, 5),
+		d(2026, time.May, 6),
+	}
+	if len(got) != len(want) {
+		t.Fatalf("Upcoming = %v, want %d holidays", got, len(want))
+	}
+	for i, h := range got {
+		if !h.Date.Equal(want[i]) {
+			t.Errorf("Upcoming[%d] = %s, want %s", i, h.Date.Format(time.DateOnly), want[i].Format(time.DateOnly))
+		}
+		if next, _ := NextHoliday(h.Date.AddDate(0, 0, -1)); i > 0 && !next.Date.Equal(h.Date) {
+			t.Errorf("Upcoming[%d] disagrees with NextHoliday", i)
+		}
+	}
+
+	if got := Upcoming(d(2026, time.April, 29), 0); got != nil {
+		t.Errorf("Upcoming(n = 0) = %v, want nil", got)
+	}
+	if got := Upcoming(d(9999, time.January, 1), 3); got != nil {
+		t.Errorf("Upcoming after dataset = %v, want nil", got)
+	}
+}
+
+func TestRecent(t *testing.T) {
+	t.Parallel()
+
+	got := Recent(d(2026, time.May, 6), 3)
+	want := []time.Time{
+		d(2026, time.May, 5),
+		d(2026, time.May, 4),
+		d(2026, time.May, 3),
+	}
+	if len(got) != len(want) {
+		t.Fatalf("Recent = %v, want %d holidays", got, len(want))
+	}
+	for i, h := range got {
+		if !h.Date.Equal(want[i]) {
+			t.Errorf("Recent[%d] = %s, want %s", i, h.Date.Format(time.DateOnly), want[i].Format(time.DateOnly))
+		}
+	}
+	if got := Recent(d(1900, time.January, 1), 3); got != nil {
+		t.Errorf("Recent before dataset = %v, want nil", got)
+	}
+}
+
+func TestUpcomingRecent_CalendarState(t *testing.T) {
+	t.Parallel()
+
+	cal := New()
+	cal.RemoveHoliday(d(2026, time.May, 4))
+	cal.AddCustomHoliday(d(2026, time.May, 5), "会社休日")
+	cal.AddCustomHoliday(d(2026, time.May, 1), "メーデー")
+
+	names := func(hs []Holiday) []string {
+		var ns []string
+		for _, h := range hs {
+			ns = append(ns, h.Date.Format("01-02")+" "+h.Name)
+		}
+		return ns
+	}
+	if got, want := names(cal.Upcoming(d(2026, time.April, 29), 4)), []string{"05-01 メーデー", "05-03 憲法記念日", "05-05 会社休日", "05-06 休日"}; !slices.Equal(got, want) {
+		t.Errorf("Upcoming = %v, want %v", got, want)
+	}
+	if got, want := names(cal.Recent(d(2026, time.May, 6), 4)), []string{"05-05 会社休日", "05-03 憲法記念日", "05-01 メーデー", "04-29 昭和の日"}; !slices.Equal(got, want) {
+		t.Errorf("Recent = %v, want %v", got, want)
+	}
+
+	// Past the end of the built-in dataset only custom holidays remain.
+	cal.AddCustomHoliday(d(3000, time.January, 1), "遠い未来")
+	if got := cal.Upcoming(d(2999, time.January, 1), 5); len(got) != 1 || got[0].Name != "遠い未来" {
+		t.Errorf("Upcoming after dataset = %v, want only the custom holiday", got)
+	}
+}
+
+func TestHolidayWithin(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		t      time.Time
+		days   int
+		want   time.Time
+		wantOK bool
+	}{
+		{"next week", d(2026, time.April, 27), 7, d(2026, time.April, 29), true},
+		{"last day of window", d(2026, time.April, 22), 7, d(2026, time.April, 29), true},
+		{"just outside window", d(2026, time.April, 21), 7, time.Time{}, false},
+		{"excludes the date itself", d(2026, time.April, 29), 4, d(2026, time.May, 3), true},
+		{"three days after a holiday", d(2026, time.April, 29), 3, time.Time{}, false},
+		{"zero days", d(2026, time.April, 28), 0, time.Time{}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, ok := HolidayWithin(tt.t, tt.days)
+			if ok != tt.wantOK || (ok && !got.Date.Equal(tt.want)) {
+				t.Errorf("HolidayWithin(%s, %d) = %s, %v; want %s, %v", tt.t.Format(time.DateOnly), tt.days, got.Date.Format(time.DateOnly), ok, tt.want.Format(time.DateOnly), tt.wantOK)
+			}
+		})
+	}
+
+	cal := New()
+	cal.RemoveHoliday(d(2026, time.April, 29))
+	if h, ok := cal.HolidayWithin(d(2026, time.April, 27), 7); !ok || !h.Date.Equal(d(2026, time.May, 3)) {
+		t.Errorf("HolidayWithin after RemoveHoliday = %s, %v; want 2026-05-03", h.Date.Format(time.DateOnly), ok)
+	}
+}
+
+func TestFirstLastHoliday(t *testing.T) {
+	t.Parallel()
+
+	check := func(name string, h Holiday, ok bool, want time.Time) {
+		t.Helper()
+		if want.IsZero() {
+			if ok {
+				t.Errorf("%s = %s, want none", name, h.Date.Format(time.DateOnly))
+			}
+			return
+		}
+		if !ok || !h.Date.Equal(want) {
+			t.Errorf("%s = %s, %v; want %s", name, h.Date.Format(time.DateOnly), ok, want.Format(time.DateOnly))
+		}
+	}
+
+	h, ok := FirstHoliday(2026)
+	check("FirstHoliday(2026)", h, ok, d(2026, time.January, 1))
+	h, ok = LastHoliday(2026)
+	check("LastHoliday(2026)", h, ok, d(2026, time.November, 23))
+	h, ok = FirstHolidayInMonth(2026, time.May)
+	check("FirstHolidayInMonth(2026, May)", h, ok, d(2026, time.May, 3))
+	h, ok = LastHolidayInMonth(2026, time.May)
+	check("LastHolidayInMonth(2026, May)", h, ok, d(2026, time.May, 6))
+	h, ok = FirstHolidayInMonth(2026, time.June)
+	check("FirstHolidayInMonth(2026, June)", h, ok, time.Time{})
+	h, ok = LastHolidayInMonth(2026, time.December)
+	check("LastHolidayInMonth(2026, December)", h, ok, time.Time{})
+	h, ok = FirstHoliday(9999)
+	check("FirstHoliday(9999)", h, ok, time.Time{})
+
+	cal := New()
+	cal.AddCustomHoliday(d(2026, time.December, 28), "仕事納め休暇")
+	cal.RemoveHoliday(d(2026, time.January, 1))
+	h, ok = cal.LastHoliday(2026)
+	check("LastHoliday with custom", h, ok, d(2026, time.December, 28))
+	h, ok = cal.FirstHoliday(2026)
+	check("FirstHoliday with removed", h, ok, d(2026, time.January, 12))
+}
@@ -1,6 +1,11 @@
 package jpholiday_test
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"slices"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -55,6 +60,18 @@ func TestIsHoliday(t *testing.T) {
 	}
 }
 
+func TestDefault(t *testing.T) {
+	t.Parallel()
+
+	if Default() != Default() {
+		t.Error("Default() returned different calendars")
+	}
+	day := d(2026, time.January, 1)
+	if Default().HolidayName(day) != HolidayName(day) || Default().DatasetVersion() != DatasetVersion() {
+		t.Error("Default() disagrees with the package-level functions")
+	}
+}
+
 func TestIsHoliday_TimeOfDayIgnored(t *testing.T) {
 	t.Parallel()
 
@@ -235,6 +252,65 @@ func TestHolidaysInMonth_Empty(t *testing.T) {
 	}
 }
 
+func TestHolidaysOnWeekday(t *testing.T) {
+	t.Parallel()
+
+	// The three-day weekends of 2026.
+	mondays := HolidaysOnWeekday(2026, time.Monday)
+	want := []time.Time{
+		d(2026, time.January, 12),
+		d(2026, time.February, 23),
+		d(2026, time.May, 4),
+		d(2026, time.July, 20),
+		d(2026, time.September, 21),
+		d(2026, time.October, 12),
+		d(2026, time.November, 23),
+	}
+	if len(mondays) != len(want) {
+		t.Fatalf("HolidaysOnWeekday(2026, Monday) = %v, want %d holidays", mondays, len(want))
+	}
+	for i, h := range mondays {
+		if !h.Date.Equal(want[i]) {
+			t.Errorf("HolidaysOnWeekday[%d] = %s, want %s", i, h.Date.Format(time.DateOnly), want[i].Format(time.DateOnly))
+		}
+	}
+
+	if got := HolidaysOnWeekday(2026, time.Saturday); got != nil {
+		t.Errorf("HolidaysOnWeekday(2026, Saturday) = %v, want nil", got)
+	}
+}
+
+func TestHolidaysInYear_CacheInvalidation(t *testing.T) {
+	t.Parallel()
+
+	cal := New()
+	first := cal.HolidaysInYear(2026)
+	first[0].Name = "changed by caller"
+	if got := cal.HolidaysInYear(2026)[0].Name; got != "元日" {
+		t.Errorf("modifying a result changed the cache: got %q", got)
+	}
+
+	cal.AddCustomHoliday(d(2026, time.June, 15), "創立記念日")
+	if got := len(cal.HolidaysInMonth(2026, time.June)); got != 1 {
+		t.Errorf("after AddCustomHoliday: %d holidays in June, want 1", got)
+	}
+	if got := len(cal.HolidaysInYear(2026)); got != len(first)+1 {
+		t.Errorf("after AddCustomHoliday: %d holidays in 2026, want %d", got, len(first)+1)
+	}
+
+	cal.RemoveHoliday(d(2026, time.January, 1))
+	if got := cal.HolidaysInMonth(2026, time.January); len(got) != 1 || got[0].Name != "成人の日" {
+		t.Errorf("after RemoveHoliday: January = %v", got)
+	}
+
+	if err := cal.SetLocale(LocaleEnglish); err != nil {
+		t.Fatal(err)
+	}
+	if got := cal.HolidaysInMonth(2026, time.May)[0].Name; got != "Constitution Memorial Day" {
+		t.Errorf("after SetLocale: name = %q", got)
+	}
+}
+
 func TestHolidaysBetween(t *testing.T) {
 	t.Parallel()
 
@@ -282,9 +358,15 @@ func TestHolidaysBetween_SameDay_NonHoliday(t *testing.T) {
 func TestHolidays(t *testing.T) {
 	t.Parallel()
 
+	// The dataset compiled in depends on the build tags.
 	all := Holidays()
-	if len(all) < 1000 {
-		t.Errorf("expected at least 1000 holidays, got %d", len(all))
+	if want := DatasetInfo().Rows; len(all) != want {
+		t.Errorf("expected %d holidays, got %d", want, len(all))
+	}
+	first, last := DatasetRange()
+	if len(all) == 0 || all[0].Date.Before(first) || all[len(all)-1].Date.After(last) {
+		t.Errorf("holidays not within the dataset range %s to %s",
+			first.Format("2006-01-02"), last.Format("2006-01-02"))
 	}
 
 	// Verify sorted.
@@ -296,6 +378,39 @@ func TestHolidays(t *testing.T) {
 	}
 }
 
+func TestHolidaysBetween_MergesCustom(t *testing.T) {
+	t.Parallel()
+
+	cal := New()
+	cal.AddCustomHoliday(d(1900, time.January, 2), "before dataset")
+	cal.AddCustomHoliday(d(2026, time.April, 30), "custom between")
+	cal.AddCustomHoliday(d(2026, time.May, 4), "custom override")
+	cal.AddCustomHoliday(d(2026, time.May, 5), "custom on removed")
+	cal.AddCustomHoliday(d(2100, time.January, 2), "after dataset")
+	cal.RemoveHoliday(d(2026, time.May, 5))
+	cal.RemoveHoliday(d(2026, time.May, 6))
+
+	got := cal.HolidaysBetween(d(2026, time.April, 29), d(2026, time.May, 31))
+	want := []Holiday{
+		{d(2026, time.April, 29), "昭和の日"},
+		{d(2026, time.April, 30), "custom between"},
+		{d(2026, time.May, 3), "憲法記念日"},
+		{d(2026, time.May, 4), "custom override"},
+		{d(2026, time.May, 5), "custom on removed"},
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("HolidaysBetween = %v, want %v", got, want)
+	}
+
+	all := cal.Holidays()
+	if all[0].Name != "before dataset" || all[len(all)-1].Name != "after dataset" {
+		t.Errorf("Holidays should include custom holidays outside the dataset: first %v, last %v", all[0], all[len(all)-1])
+	}
+	if len(all) != len(Holidays())+3-1 { // 3 new dates, 1 removed
+		t.Errorf("len(Holidays()) = %d, want %d", len(all), len(Holidays())+2)
+	}
+}
+
 // --- Custom holiday tests ---
 
 func TestCustomHoliday_AddAndRemove(t *testing.T) {
@@ -672,6 +787,31 @@ func TestConcurrentAccess(t *testing.T) {
 	wg.Wait()
 }
 
+func TestConcurrentWritesNotLost(t *testing.T) {
+	t.Parallel()
+
+	cal := New()
+	var wg sync.WaitGroup
+	for i := range 100 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			cal.AddCustomHoliday(d(2030, time.January, 1).AddDate(0, 0, i*3), "テスト")
+			cal.IsBusinessDay(d(2030, time.January, 2))
+		}()
+	}
+	wg.Wait()
+
+	for i := range 100 {
+		if day := d(2030, time.January, 1).AddDate(0, 0, i*3); !cal.IsHoliday(day) {
+			t.Errorf("custom holiday %s lost", day.Format(time.DateOnly))
+		}
+	}
+	if got := len(cal.HolidaysBetween(d(2030, time.January, 1), d(2030, time.December, 31))); got < 100 {
+		t.Errorf("HolidaysBetween returned %d holidays, want at least 100", got)
+	}
+}
+
 // --- Boundary value tests ---
 
 func TestHolidaysBetween_CrossYearBoundary(t *testing.T) {
@@ -732,3 +872,101 @@ func TestHolidaysInMonth_FebruaryNonLeapYear(t *testing.T) {
 		}
 	}
 }
+
+func TestDatasetInfo(t *testing.T) {
+	t.Parallel()
+
+	info := DatasetInfo()
+	if info.SourceURL == "" {
+		t.Error("DatasetInfo().SourceURL should not be empty")
+	}
+	if got := len(New().Holidays()); info.Rows != got {
+		t.Errorf("DatasetInfo().Rows = %d, want %d (len(New().Holidays()))", info.Rows, got)
+	}
+	if info.FetchedAt.IsZero() {
+		t.Error("DatasetInfo().FetchedAt should not be zero")
+	}
+	// Every build is generated from the CSV checked in next to it.
+	raw, err := os.ReadFile("syukujitsu.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sum := sha256.Sum256(raw); info.SHA256 != hex.EncodeToString(sum[:]) {
+		t.Errorf("DatasetInfo().SHA256 = %q, want the checksum of syukujitsu.csv", info.SHA256)
+	}
+}
+
+func TestDatasetProvenance(t *testing.T) {
+	t.Parallel()
+
+	if v := DatasetVersion(); len(v) != 12 || strings.Trim(v, "0123456789abcdef") != "" {
+		t.Errorf("DatasetVersion() = %q, want 12 hex digits", v)
+	}
+	cal := New()
+	if got, want := cal.DatasetVersion(), DatasetVersion(); got != want {
+		t.Errorf("New().DatasetVersion() = %q, want %q", got, want)
+	}
+	_, last := DatasetRange()
+	next := d(last.Year()+1, time.January, 1).Format(time.DateOnly)
+	if err := cal.ApplyPatch(DatasetPatch{Holidays: []ConfigHoliday{{Date: next, Name: "元日"}}}); err != nil {
+		t.Fatal(err)
+	}
+	if cal.DatasetVersion() == DatasetVersion() {
+		t.Error("DatasetVersion unchanged by a patch")
+	}
+	if got, want := DatasetSourceURL(), DatasetInfo().SourceURL; got != want {
+		t.Errorf("DatasetSourceURL() = %q, want %q", got, want)
+	}
+	if got, want := DatasetGeneratedAt(), DatasetInfo().FetchedAt; !got.Equal(want) {
+		t.Errorf("DatasetGeneratedAt() = %v, want %v", got, want)
+	}
+	// Checked in every build: the default, jpholiday_recent, and jpholiday_csv.
+	if DatasetGeneratedAt().IsZero() {
+		t.Error("DatasetGeneratedAt() should not be zero")
+	}
+}
+
+func TestDatasetRange(t *testing.T) {
+	t.Parallel()
+
+	first, last := DatasetRange()
+	if first.Month() != time.January || first.Day() != 1 || last.Month() != time.December || last.Day() != 31 {
+		t.Fatalf("DatasetRange() = %v, %v, want whole years", first, last)
+	}
+	all := Holidays()
+	if all[0].Date.Year() != first.Year() || all[len(all)-1].Date.Year() != last.Year() {
+		t.Errorf("DatasetRange() = %v, %v, but holidays span %v to %v", first, last, all[0].Date, all[len(all)-1].Date)
+	}
+	if got := HolidaysBetween(first.AddDate(-1, 0, 0), first.AddDate(0, 0, -1)); len(got) != 0 {
+		t.Errorf("holidays before DatasetRange: %v", got)
+	}
+}
+
+func TestEnglishName(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"元日", "New Year's Day"},
+		{"スポーツの日", "Sports Day"},
+		{"会社記念日", ""},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := EnglishName(tt.name); got != tt.want {
+			t.Errorf("EnglishName(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestEnglishName_CoversDataset(t *testing.T) {
+	t.Parallel()
+
+	for _, h := range New().Holidays() {
+		if EnglishName(h.Name) == "" {
+			t.Errorf("no English name for %q (%s)", h.Name, h.Date.Format("2006-01-02"))
+		}
+	}
+}
@@ -0,0 +1,8 @@
+module github.com/rabitt1ove/jp-holidays/jpholidaycron
+
+go 1.25
+
+require (
+	github.com/rabitt1ove/jp-holidays v0.1.0
+	github.com/robfig/cron/v3 v3.0.1
+)
@@ -0,0 +1,104 @@
+// Package jpholidaycron makes github.com/robfig/cron schedules aware of
+// Japanese business days. Wrap an existing schedule to skip the firings
+// that land on weekends, holidays, or closures, or to defer them to the next
+// business day:
+//
+//	c := cron.New(cron.WithLocation(jst))
+//	inner, _ := cron.ParseStandard("0 9 25 * *") // payroll on the 25th
+//	c.Schedule(jpholidaycron.Wrap(inner, jpholidaycron.Options{Defer: true}), job)
+//
+// [Parse] extends the cron syntax with a business-day field instead, as in
+// "0 9 B#5 * *" for 09:00 on the 5th business day of each month.
+//
+// This package lives in its own module so that the core jpholiday package
+// stays free of third-party dependencies.
+package jpholidaycron
+
+import (
+	"time"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+	"github.com/robfig/cron/v3"
+)
+
+// maxSearch bounds how far ahead Next looks for a firing on a business day.
+const maxSearch = 366 * 24 * time.Hour
+
+// deferLookback is how far back Next looks for firings deferred past t,
+// enough for the longest runs of holidays, such as Golden Week and the New
+// Year break.
+const deferLookback = 14 * 24 * time.Hour
+
+// Options configures [Wrap].
+type Options struct {
+	// Calendar decides which days are business days, in JST. If nil, the
+	// package-level default calendar is used.
+	Calendar jpholiday.HolidayChecker
+
+	// Defer moves a firing on a non-business day to the same time of day on
+	// the next business day, instead of skipping it. Firings that move to
+	// the same time, or onto a firing of the inner schedule, run once.
+	// Firings up to two weeks before a scheduler starts are still deferred
+	// into its run.
+	Defer bool
+}
+
+// Schedule is a cron.Schedule that fires only on business days. Create one
+// with [Wrap].
+type Schedule struct {
+	inner    cron.Schedule
+	cal      jpholiday.HolidayChecker
+	deferred bool
+}
+
+var _ cron.Schedule = (*Schedule)(nil)
+
+// Wrap returns a schedule that follows inner on business days and skips or
+// defers its other firings according to opts.
+func Wrap(inner cron.Schedule, opts Options) *Schedule {
+	s := &Schedule{inner: inner, cal: opts.Calendar, deferred: opts.Defer}
+	if s.cal == nil {
+		s.cal = jpholiday.Default()
+	}
+	return s
+}
+
+// Next returns the first activation time after t, or the zero time if
+// there is none within a year, which cron treats as never.
+func (s *Schedule) Next(t time.Time) time.Time {
+	start := t
+	if s.deferred {
+		start = t.Add(-deferLookback)
+	}
+	var best time.Time
+	for n := s.inner.Next(start); !n.IsZero() && n.Sub(t) <= maxSearch; n = s.inner.Next(n) {
+		if !best.IsZero() && !n.Before(best) {
+			break
+		}
+		var candidate time.Time
+		switch {
+		case s.cal.IsBusinessDay(n):
+			candidate = n
+		case s.deferred:
+			candidate, _ = s.nextBusinessDay(n)
+		}
+		// Keep the earliest candidate after t. Candidates never precede
+		// their firing, so the loop ends once firings pass the best one.
+		if candidate.After(t) && (best.IsZero() || candidate.Before(best)) {
+			best = candidate
+		}
+	}
+	return best
+}
+
+// nextBusinessDay returns t moved forward by whole days to the first
+// business day.
+func (s *Schedule) nextBusinessDay(t time.Time) (time.Time, bool) {
+	for range 366 {
+		t = t.AddDate(0, 0, 1)
+		if s.cal.IsBusinessDay(t) {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,88 @@
+package jpholidaycron_test
+
+import (
+	"testing"
+	"time"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+	"github.com/rabitt1ove/jp-holidays/jpholidaycron"
+	"github.com/robfig/cron/v3"
+)
+
+var jst = time.FixedZone("Asia/Tokyo", 9*60*60)
+
+func at(year int, month time.Month, day, hour, minute int) time.Time {
+	return time.Date(year, month, day, hour, minute, 0, 0, jst)
+}
+
+func parse(t *testing.T, spec string) cron.Schedule {
+	t.Helper()
+	s, err := cron.ParseStandard("CRON_TZ=Asia/Tokyo " + spec)
+	if err != nil {
+		t.Fatalf("ParseStandard(%q): %v", spec, err)
+	}
+	return s
+}
+
+func TestWrap_Skip(t *testing.T) {
+	t.Parallel()
+
+	s := jpholidaycron.Wrap(parse(t, "0 9 * * *"), jpholidaycron.Options{})
+	tests := []struct {
+		from, want time.Time
+	}{
+		{at(2026, time.April, 30, 10, 0), at(2026, time.May, 1, 9, 0)},
+		{at(2026, time.May, 1, 9, 0), at(2026, time.May, 7, 9, 0)}, // Golden Week
+		{at(2026, time.June, 5, 9, 0), at(2026, time.June, 8, 9, 0)},
+	}
+	for _, tt := range tests {
+		if got := s.Next(tt.from); !got.Equal(tt.want) {
+			t.Errorf("Next(%v) = %v, want %v", tt.from, got, tt.want)
+		}
+	}
+}
+
+func TestWrap_Defer(t *testing.T) {
+	t.Parallel()
+
+	cal := jpholiday.New()
+	cal.AddClosure(at(2026, time.August, 25, 0, 0))
+	s := jpholidaycron.Wrap(parse(t, "30 9 25 * *"), jpholidaycron.Options{Calendar: cal, Defer: true})
+	tests := []struct {
+		from, want time.Time
+	}{
+		{at(2026, time.May, 1, 0, 0), at(2026, time.May, 25, 9, 30)},         // Monday
+		{at(2026, time.July, 1, 0, 0), at(2026, time.July, 27, 9, 30)},       // Saturday the 25th
+		{at(2026, time.August, 1, 0, 0), at(2026, time.August, 26, 9, 30)},   // closure
+		{at(2026, time.July, 27, 9, 30), at(2026, time.August, 26, 9, 30)},   // after a deferred firing
+		{at(2026, time.July, 26, 0, 0), at(2026, time.July, 27, 9, 30)},      // after the original firing
+		{at(2026, time.October, 1, 0, 0), at(2026, time.October, 26, 9, 30)}, // Sunday
+	}
+	for _, tt := range tests {
+		if got := s.Next(tt.from); !got.Equal(tt.want) {
+			t.Errorf("Next(%v) = %v, want %v", tt.from, got, tt.want)
+		}
+	}
+
+	// Deferred weekend firings of a daily job collapse into Monday's.
+	daily := jpholidaycron.Wrap(parse(t, "0 9 * * *"), jpholidaycron.Options{Defer: true})
+	if got, want := daily.Next(at(2026, time.June, 5, 9, 0)), at(2026, time.June, 8, 9, 0); !got.Equal(want) {
+		t.Errorf("daily Next = %v, want %v", got, want)
+	}
+	if got, want := daily.Next(at(2026, time.June, 8, 9, 0)), at(2026, time.June, 9, 9, 0); !got.Equal(want) {
+		t.Errorf("daily Next after Monday = %v, want %v", got, want)
+	}
+}
+
+func TestWrap_NoBusinessDays(t *testing.T) {
+	t.Parallel()
+
+	cal := jpholiday.New()
+	cal.SetWeekend(time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday)
+	for _, deferred := range []bool{false, true} {
+		s := jpholidaycron.Wrap(parse(t, "0 0 1 * *"), jpholidaycron.Options{Calendar: cal, Defer: deferred})
+		if got := s.Next(at(2026, time.January, 1, 0, 0)); !got.IsZero() {
+			t.Errorf("Defer=%v: Next = %v, want zero", deferred, got)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package jpholidaycron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+	"github.com/robfig/cron/v3"
+)
+
+// jst is the Asia/Tokyo timezone in which business days are counted.
+var jst = time.FixedZone("Asia/Tokyo", 9*60*60)
+
+// Parse parses a standard five-field cron expression whose day-of-month
+// field may instead select business days of cal:
+//
+//	0 9 B * *      09:00 on every business day
+//	0 9 B#5 * *    09:00 on the 5th business day of the month
+//	0 18 BL * *    18:00 on the last business day of the month
+//	0 9 B * 1      09:00 on Mondays that are business days
+//
+// Unlike the day-of-month field in standard cron, a business-day field
+// combines with the day-of-week field as "and". Business days are counted
+// by JST date. A leading CRON_TZ= or TZ= sets the timezone of the other
+// fields, as in cron.ParseStandard, which parses expressions without a
+// business-day field. If cal is nil, the package-level default calendar
+// is used.
+func Parse(spec string, cal jpholiday.HolidayChecker) (cron.Schedule, error) {
+	fields := strings.Fields(spec)
+	i := 0
+	if len(fields) > 0 && (strings.HasPrefix(fields[0], "CRON_TZ=") || strings.HasPrefix(fields[0], "TZ=")) {
+		i = 1
+	}
+	if len(fields)-i != 5 || !strings.HasPrefix(fields[i+2], "B") {
+		s, err := cron.ParseStandard(spec)
+		if err != nil {
+			return nil, fmt.Errorf("jpholidaycron: %w", err)
+		}
+		return s, nil
+	}
+
+	nth, err := parseBusinessField(fields[i+2])
+	if err != nil {
+		return nil, fmt.Errorf("jpholidaycron: %w", err)
+	}
+	fields[i+2] = "*"
+	inner, err := cron.ParseStandard(strings.Join(fields, " "))
+	if err != nil {
+		return nil, fmt.Errorf("jpholidaycron: %w", err)
+	}
+	if cal == nil {
+		cal = jpholiday.Default()
+	}
+	return &businessSchedule{inner: inner, cal: cal, nth: nth}, nil
+}
+
+// parseBusinessField parses B, B#n, or BL into the nth business day of the
+// month, with 0 for every business day and -1 for the last.
+func parseBusinessField(field string) (int, error) {
+	switch field {
+	case "B":
+		return 0, nil
+	case "BL":
+		return -1, nil
+	}
+	s, ok := strings.CutPrefix(field, "B#")
+	if !ok {
+		return 0, fmt.Errorf("invalid business-day field %q (want B, B#n, or BL)", field)
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 1 || n > 23 {
+		return 0, fmt.Errorf("invalid business-day field %q (n must be 1 to 23)", field)
+	}
+	return n, nil
+}
+
+// businessSchedule is the schedule of a cron expression with a business-day
+// field: the firings of inner on the selected business days.
+type businessSchedule struct {
+	inner cron.Schedule
+	cal   jpholiday.HolidayChecker
+	nth   int // 0 for every business day, -1 for the last
+}
+
+// Next returns the first activation time after t, or the zero time if
+// there is none within a year.
+func (s *businessSchedule) Next(t time.Time) time.Time {
+	for n := s.inner.Next(t); !n.IsZero() && n.Sub(t) <= maxSearch; n = s.inner.Next(n) {
+		if s.matches(n) {
+			return n
+		}
+	}
+	return time.Time{}
+}
+
+// matches reports whether t falls on a selected business day.
+func (s *businessSchedule) matches(t time.Time) bool {
+	if !s.cal.IsBusinessDay(t) {
+		return false
+	}
+	t = t.In(jst)
+	switch {
+	case s.nth > 0:
+		n := 0
+		for day := 1; day <= t.Day(); day++ {
+			if s.cal.IsBusinessDay(time.Date(t.Year(), t.Month(), day, 12, 0, 0, 0, jst)) {
+				n++
+			}
+		}
+		return n == s.nth
+	case s.nth < 0:
+		for day := t.AddDate(0, 0, 1); day.Month() == t.Month(); day = day.AddDate(0, 0, 1) {
+			if s.cal.IsBusinessDay(day) {
+				return false
+			}
+		}
+	}
+	return true
+}
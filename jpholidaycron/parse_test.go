@@ -0,0 +1,49 @@
+package jpholidaycron_test
+
+import (
+	"testing"
+	"time"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+	"github.com/rabitt1ove/jp-holidays/jpholidaycron"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	cal := jpholiday.New()
+	cal.AddClosure(at(2026, time.June, 30, 0, 0))
+	tests := []struct {
+		spec       string
+		from, want time.Time
+	}{
+		{"CRON_TZ=Asia/Tokyo 0 9 B * *", at(2026, time.May, 1, 9, 0), at(2026, time.May, 7, 9, 0)},
+		{"CRON_TZ=Asia/Tokyo 0 9 B * 1", at(2026, time.May, 1, 0, 0), at(2026, time.May, 11, 9, 0)}, // 5/4 is みどりの日
+		// May 2026: 1, 7, 8, 11, 12 are the first five business days.
+		{"CRON_TZ=Asia/Tokyo 0 9 B#5 * *", at(2026, time.May, 1, 0, 0), at(2026, time.May, 12, 9, 0)},
+		{"CRON_TZ=Asia/Tokyo 0 9 B#1 * *", at(2026, time.May, 1, 9, 0), at(2026, time.June, 1, 9, 0)},
+		{"CRON_TZ=Asia/Tokyo 0 18 BL * *", at(2026, time.June, 1, 0, 0), at(2026, time.June, 29, 18, 0)}, // 6/30 is closed
+		{"CRON_TZ=Asia/Tokyo 0 18 BL * *", at(2026, time.October, 1, 0, 0), at(2026, time.October, 30, 18, 0)},
+		{"0 0 * * *", time.Date(2026, time.May, 3, 0, 0, 0, 0, time.UTC), time.Date(2026, time.May, 4, 0, 0, 0, 0, time.UTC)}, // standard
+	}
+	for _, tt := range tests {
+		s, err := jpholidaycron.Parse(tt.spec, cal)
+		if err != nil {
+			t.Errorf("Parse(%q) error: %v", tt.spec, err)
+			continue
+		}
+		if got := s.Next(tt.from); !got.Equal(tt.want) {
+			t.Errorf("Parse(%q).Next(%v) = %v, want %v", tt.spec, tt.from, got, tt.want)
+		}
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	t.Parallel()
+
+	for _, spec := range []string{"0 9 B#0 * *", "0 9 B#24 * *", "0 9 Bx * *", "0 25 B * *", "0 9 B *"} {
+		if _, err := jpholidaycron.Parse(spec, nil); err == nil {
+			t.Errorf("Parse(%q): want error", spec)
+		}
+	}
+}
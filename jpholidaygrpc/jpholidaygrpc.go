@@ -0,0 +1,141 @@
+// Package jpholidaygrpc serves holiday and business-day queries over gRPC
+// for services that prefer a network API to embedding the Go library.
+//
+// The HolidayService is defined in holiday_service.proto (package
+// jpholiday.v1) and reuses the Date and Holiday messages of jpholidaypb.
+// Register the server on a grpc.Server:
+//
+//	s := grpc.NewServer()
+//	jpholidaygrpc.RegisterHolidayServiceServer(s, jpholidaygrpc.NewServer(jpholidaygrpc.Options{}))
+//	s.Serve(lis)
+//
+// Regenerate the bindings with:
+//
+//	protoc -I . -I ../jpholidaypb --go_out=. --go_opt=paths=source_relative \
+//		--go-grpc_out=. --go-grpc_opt=paths=source_relative holiday_service.proto
+//
+// This package lives in its own module so that the core jpholiday package
+// stays free of third-party dependencies.
+package jpholidaygrpc
+
+//go:generate protoc -I . -I ../jpholidaypb --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative holiday_service.proto
+
+import (
+	"context"
+	"time"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+	"github.com/rabitt1ove/jp-holidays/jpholidaypb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxRangeDays limits the span of ranges accepted by BusinessDaysBetween,
+// which examines every day in the range.
+const maxRangeDays = 3660
+
+// Options configures the server.
+type Options struct {
+	// Calendar supplies holidays and business days. If nil, the
+	// package-level default calendar is used.
+	Calendar *jpholiday.Calendar
+}
+
+// calendar is the subset of [jpholiday.Calendar] used by the server.
+type calendar interface {
+	HolidayName(t time.Time) string
+	HolidaysBetween(from, to time.Time) []jpholiday.Holiday
+	NextBusinessDay(t time.Time) (time.Time, bool)
+	BusinessDaysBetween(from, to time.Time) int
+}
+
+func (o Options) calendar() calendar {
+	if o.Calendar != nil {
+		return o.Calendar
+	}
+	return jpholiday.Default()
+}
+
+// Server implements HolidayServiceServer on top of a calendar. Invalid or
+// missing dates are reported with codes.InvalidArgument.
+type Server struct {
+	UnimplementedHolidayServiceServer
+	cal calendar
+}
+
+// NewServer returns a HolidayService server backed by opts.Calendar.
+func NewServer(opts Options) *Server {
+	return &Server{cal: opts.calendar()}
+}
+
+// IsHoliday implements HolidayServiceServer.
+func (s *Server) IsHoliday(_ context.Context, req *IsHolidayRequest) (*IsHolidayResponse, error) {
+	t, err := toTime("date", req.GetDate())
+	if err != nil {
+		return nil, err
+	}
+	name := s.cal.HolidayName(t)
+	return &IsHolidayResponse{Holiday: name != "", Name: name}, nil
+}
+
+// ListHolidays implements HolidayServiceServer.
+func (s *Server) ListHolidays(_ context.Context, req *ListHolidaysRequest) (*ListHolidaysResponse, error) {
+	from, to, err := toRange(req.GetFrom(), req.GetTo())
+	if err != nil {
+		return nil, err
+	}
+	return &ListHolidaysResponse{Holidays: jpholidaypb.NewHolidaySet(s.cal.HolidaysBetween(from, to)).GetHolidays()}, nil
+}
+
+// NextBusinessDay implements HolidayServiceServer. It returns
+// codes.NotFound if no business day follows within a year.
+func (s *Server) NextBusinessDay(_ context.Context, req *NextBusinessDayRequest) (*NextBusinessDayResponse, error) {
+	t, err := toTime("date", req.GetDate())
+	if err != nil {
+		return nil, err
+	}
+	next, ok := s.cal.NextBusinessDay(t)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "no business day on or after %s", t.Format(time.DateOnly))
+	}
+	return &NextBusinessDayResponse{Date: jpholidaypb.FromDate(next)}, nil
+}
+
+// BusinessDaysBetween implements HolidayServiceServer.
+func (s *Server) BusinessDaysBetween(_ context.Context, req *BusinessDaysBetweenRequest) (*BusinessDaysBetweenResponse, error) {
+	from, to, err := toRange(req.GetFrom(), req.GetTo())
+	if err != nil {
+		return nil, err
+	}
+	if to.Sub(from) > maxRangeDays*24*time.Hour {
+		return nil, status.Errorf(codes.InvalidArgument, "range exceeds %d days", maxRangeDays)
+	}
+	n := s.cal.BusinessDaysBetween(from, to)
+	return &BusinessDaysBetweenResponse{BusinessDays: int32(n)}, nil // #nosec G115 -- bounded by maxRangeDays.
+}
+
+// toTime converts a required Date field, reporting codes.InvalidArgument
+// when it is missing or invalid.
+func toTime(field string, d *jpholidaypb.Date) (time.Time, error) {
+	if d == nil {
+		return time.Time{}, status.Errorf(codes.InvalidArgument, "missing %s", field)
+	}
+	t, err := d.Time()
+	if err != nil {
+		return time.Time{}, status.Errorf(codes.InvalidArgument, "%s: %v", field, err)
+	}
+	return t, nil
+}
+
+func toRange(fromD, toD *jpholidaypb.Date) (from, to time.Time, err error) {
+	if from, err = toTime("from", fromD); err != nil {
+		return
+	}
+	if to, err = toTime("to", toD); err != nil {
+		return
+	}
+	if to.Before(from) {
+		err = status.Error(codes.InvalidArgument, "to is before from")
+	}
+	return
+}
@@ -0,0 +1,141 @@
+package jpholidaygrpc
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+	"github.com/rabitt1ove/jp-holidays/jpholidaypb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// newClient starts a server for cal on an in-memory listener and returns a
+// client connected to it.
+func newClient(t *testing.T, cal *jpholiday.Calendar) HolidayServiceClient {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	RegisterHolidayServiceServer(s, NewServer(Options{Calendar: cal}))
+	go func() { _ = s.Serve(lis) }()
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return NewHolidayServiceClient(conn)
+}
+
+func date(y, m, d int32) *jpholidaypb.Date { return &jpholidaypb.Date{Year: y, Month: m, Day: d} }
+
+func TestServer_IsHoliday(t *testing.T) {
+	t.Parallel()
+
+	c := newClient(t, jpholiday.New())
+	ctx := context.Background()
+
+	resp, err := c.IsHoliday(ctx, &IsHolidayRequest{Date: date(2026, 1, 12)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !resp.GetHoliday() || resp.GetName() != "成人の日" {
+		t.Errorf("IsHoliday(2026-01-12) = %v", resp)
+	}
+
+	resp, err = c.IsHoliday(ctx, &IsHolidayRequest{Date: date(2026, 1, 13)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetHoliday() || resp.GetName() != "" {
+		t.Errorf("IsHoliday(2026-01-13) = %v", resp)
+	}
+}
+
+func TestServer_ListHolidays(t *testing.T) {
+	t.Parallel()
+
+	cal := jpholiday.New()
+	cal.AddCustomHoliday(time.Date(2026, time.June, 1, 0, 0, 0, 0, time.UTC), "創立記念日")
+	c := newClient(t, cal)
+
+	resp, err := c.ListHolidays(context.Background(), &ListHolidaysRequest{From: date(2026, 5, 5), To: date(2026, 6, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := (&jpholidaypb.HolidaySet{Holidays: resp.GetHolidays()}).ToHolidays()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := cal.HolidaysBetween(time.Date(2026, time.May, 5, 0, 0, 0, 0, time.UTC), time.Date(2026, time.June, 1, 0, 0, 0, 0, time.UTC))
+	if len(got) != 3 || len(got) != len(want) {
+		t.Fatalf("got %d holidays, want 3", len(got))
+	}
+	for i := range want {
+		if !got[i].Date.Equal(want[i].Date) || got[i].Name != want[i].Name {
+			t.Errorf("holiday %d = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestServer_BusinessDays(t *testing.T) {
+	t.Parallel()
+
+	c := newClient(t, jpholiday.New())
+	ctx := context.Background()
+
+	next, err := c.NextBusinessDay(ctx, &NextBusinessDayRequest{Date: date(2026, 5, 2)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := next.GetDate(); d.GetYear() != 2026 || d.GetMonth() != 5 || d.GetDay() != 7 {
+		t.Errorf("NextBusinessDay(2026-05-02) = %v, want 2026-05-07", d)
+	}
+
+	count, err := c.BusinessDaysBetween(ctx, &BusinessDaysBetweenRequest{From: date(2026, 4, 27), To: date(2026, 5, 8)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count.GetBusinessDays() != 6 {
+		t.Errorf("BusinessDaysBetween = %d, want 6", count.GetBusinessDays())
+	}
+}
+
+func TestServer_InvalidArgument(t *testing.T) {
+	t.Parallel()
+
+	c := newClient(t, nil)
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		call func() error
+	}{
+		{"missing date", func() error { _, err := c.IsHoliday(ctx, &IsHolidayRequest{}); return err }},
+		{"invalid date", func() error {
+			_, err := c.NextBusinessDay(ctx, &NextBusinessDayRequest{Date: date(2026, 2, 30)})
+			return err
+		}},
+		{"reversed range", func() error {
+			_, err := c.ListHolidays(ctx, &ListHolidaysRequest{From: date(2026, 12, 31), To: date(2026, 1, 1)})
+			return err
+		}},
+		{"range too long", func() error {
+			_, err := c.BusinessDaysBetween(ctx, &BusinessDaysBetweenRequest{From: date(2000, 1, 1), To: date(2026, 1, 1)})
+			return err
+		}},
+	}
+	for _, tt := range tests {
+		if code := status.Code(tt.call()); code != codes.InvalidArgument {
+			t.Errorf("%s: code = %v, want InvalidArgument", tt.name, code)
+		}
+	}
+}
@@ -0,0 +1,8 @@
+module github.com/rabitt1ove/jp-holidays/jpholidaymsg
+
+go 1.25.0
+
+require (
+	github.com/rabitt1ove/jp-holidays v0.1.0
+	golang.org/x/text v0.40.0
+)
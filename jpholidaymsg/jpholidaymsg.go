@@ -0,0 +1,148 @@
+// Package jpholidaymsg provides golang.org/x/text message catalog entries
+// for holiday names and relative phrases, so applications that localize
+// with x/text can render them through their existing catalog and printers:
+//
+//	b := catalog.NewBuilder()
+//	if err := jpholidaymsg.Register(b); err != nil {
+//		// ...
+//	}
+//	p := message.NewPrinter(language.English, message.Catalog(b))
+//	h, _ := jpholiday.NextHoliday(time.Now())
+//	jpholidaymsg.HolidayName(p, h)           // "Substitute Holiday for Constitution Memorial Day"
+//	jpholidaymsg.BusinessDaysRemaining(p, 3) // "3 business days remaining"
+//
+// Holiday names are keyed by their Japanese names in the dataset, and
+// relative phrases by the Key constants, so applications can add entries
+// for further languages to the same builder.
+//
+// This package lives in its own module so that the core jpholiday package
+// stays free of third-party dependencies.
+package jpholidaymsg
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/message/catalog"
+)
+
+// Message keys of relative phrases, with the arguments they take.
+const (
+	KeyToday                 = "today"
+	KeyTomorrow              = "tomorrow"
+	KeyInDays                = "in %d days"                 // days from now
+	KeyBusinessDaysRemaining = "%d business days remaining" // business days left
+	KeySubstituteHoliday     = "Substitute Holiday for %s"  // localized name of the replaced holiday
+)
+
+// substituteHoliday is the dataset's name for both substitute holidays and
+// citizens' holidays.
+const substituteHoliday = "休日"
+
+// builtin is a calendar with no customizations, used to list the dataset's
+// names and to find the holiday a substitute holiday replaces.
+var builtin = jpholiday.New()
+
+// Register adds Japanese and English entries for every holiday name in the
+// dataset and for the Key constants to b. Japanese entries are the names as
+// published by the Cabinet Office; in English, 休日 on its own is a
+// citizens' holiday, since [HolidayName] renders substitute holidays with
+// KeySubstituteHoliday.
+func Register(b *catalog.Builder) error {
+	for _, n := range builtin.HolidayNames() {
+		en := jpholiday.EnglishName(n.Name)
+		if n.Name == substituteHoliday {
+			en = "Citizens' Holiday"
+		}
+		if err := b.SetString(language.Japanese, n.Name, escape(n.Name)); err != nil {
+			return fmt.Errorf("jpholidaymsg: %w", err)
+		}
+		if en != "" {
+			if err := b.SetString(language.English, n.Name, escape(en)); err != nil {
+				return fmt.Errorf("jpholidaymsg: %w", err)
+			}
+		}
+	}
+
+	entries := []struct {
+		tag language.Tag
+		key string
+		msg catalog.Message
+	}{
+		{language.Japanese, KeyToday, catalog.String("今日")},
+		{language.Japanese, KeyTomorrow, catalog.String("明日")},
+		{language.Japanese, KeyInDays, catalog.String("%d日後")},
+		{language.Japanese, KeyBusinessDaysRemaining, catalog.String("残り%d営業日")},
+		{language.Japanese, KeySubstituteHoliday, catalog.String(substituteHoliday)},
+		{language.English, KeyToday, catalog.String("today")},
+		{language.English, KeyTomorrow, catalog.String("tomorrow")},
+		{language.English, KeyInDays, plural.Selectf(1, "%d",
+			"=1", "in 1 day",
+			"other", "in %d days")},
+		{language.English, KeyBusinessDaysRemaining, plural.Selectf(1, "%d",
+			"=1", "1 business day remaining",
+			"other", "%d business days remaining")},
+		{language.English, KeySubstituteHoliday, catalog.String("Substitute Holiday for %s")},
+	}
+	for _, e := range entries {
+		if err := b.Set(e.tag, e.key, e.msg); err != nil {
+			return fmt.Errorf("jpholidaymsg: %w", err)
+		}
+	}
+	return nil
+}
+
+// HolidayName renders the name of h with p. h.Name must be the Japanese
+// name, as returned by a calendar in jpholiday.LocaleJapanese; names
+// without a catalog entry, such as custom holidays, are returned unchanged.
+// A 休日 that substitutes for a holiday on a Sunday is rendered with
+// KeySubstituteHoliday and the name of that holiday.
+func HolidayName(p *message.Printer, h jpholiday.Holiday) string {
+	if h.Name == substituteHoliday && builtin.HolidayName(h.Date) == substituteHoliday {
+		if replaced, ok := substitutedHoliday(h.Date); ok {
+			return p.Sprintf(KeySubstituteHoliday, p.Sprintf(message.Key(replaced, escape(replaced))))
+		}
+	}
+	return p.Sprintf(message.Key(h.Name, escape(h.Name)))
+}
+
+// DaysUntil renders a distance of days from today with p, as KeyToday,
+// KeyTomorrow, or KeyInDays.
+func DaysUntil(p *message.Printer, days int) string {
+	switch days {
+	case 0:
+		return p.Sprintf(KeyToday)
+	case 1:
+		return p.Sprintf(KeyTomorrow)
+	default:
+		return p.Sprintf(KeyInDays, days)
+	}
+}
+
+// BusinessDaysRemaining renders n with p as KeyBusinessDaysRemaining.
+func BusinessDaysRemaining(p *message.Printer, n int) string {
+	return p.Sprintf(KeyBusinessDaysRemaining, n)
+}
+
+// substitutedHoliday returns the dataset name of the holiday on a Sunday
+// that the 休日 on t replaces: the nearest one in the run of holidays
+// immediately before t. It reports false for citizens' holidays.
+func substitutedHoliday(t time.Time) (string, bool) {
+	for prev := t.AddDate(0, 0, -1); ; prev = prev.AddDate(0, 0, -1) {
+		name := builtin.HolidayName(prev)
+		switch {
+		case name == "":
+			return "", false
+		case name != substituteHoliday && prev.Weekday() == time.Sunday:
+			return name, true
+		}
+	}
+}
+
+// escape quotes the formatting verbs in a literal catalog message.
+func escape(s string) string { return strings.ReplaceAll(s, "%", "%%") }
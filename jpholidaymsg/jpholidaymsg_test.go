@@ -0,0 +1,85 @@
+package jpholidaymsg_test
+
+import (
+	"testing"
+	"time"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+	"github.com/rabitt1ove/jp-holidays/jpholidaymsg"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/message/catalog"
+)
+
+func d(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func printers(t *testing.T) (ja, en *message.Printer) {
+	t.Helper()
+	b := catalog.NewBuilder()
+	if err := jpholidaymsg.Register(b); err != nil {
+		t.Fatalf("Register error: %v", err)
+	}
+	return message.NewPrinter(language.Japanese, message.Catalog(b)),
+		message.NewPrinter(language.English, message.Catalog(b))
+}
+
+func TestHolidayName(t *testing.T) {
+	t.Parallel()
+
+	ja, en := printers(t)
+	tests := []struct {
+		holiday jpholiday.Holiday
+		ja, en  string
+	}{
+		{jpholiday.Holiday{Date: d(2026, time.January, 1), Name: "元日"}, "元日", "New Year's Day"},
+		{jpholiday.Holiday{Date: d(2026, time.May, 6), Name: "休日"}, "休日", "Substitute Holiday for Constitution Memorial Day"},
+		{jpholiday.Holiday{Date: d(2026, time.September, 22), Name: "休日"}, "休日", "Citizens' Holiday"},
+		{jpholiday.Holiday{Date: d(2026, time.June, 15), Name: "創立100%記念日"}, "創立100%記念日", "創立100%記念日"},
+	}
+	for _, tt := range tests {
+		if got := jpholidaymsg.HolidayName(ja, tt.holiday); got != tt.ja {
+			t.Errorf("ja HolidayName(%v) = %q, want %q", tt.holiday, got, tt.ja)
+		}
+		if got := jpholidaymsg.HolidayName(en, tt.holiday); got != tt.en {
+			t.Errorf("en HolidayName(%v) = %q, want %q", tt.holiday, got, tt.en)
+		}
+	}
+}
+
+func TestHolidayName_CoversDataset(t *testing.T) {
+	t.Parallel()
+
+	_, en := printers(t)
+	for _, h := range jpholiday.New().Holidays() {
+		if got := jpholidaymsg.HolidayName(en, h); got == h.Name {
+			t.Errorf("no English entry for %q (%s)", h.Name, h.Date.Format("2006-01-02"))
+		}
+	}
+}
+
+func TestRelativePhrases(t *testing.T) {
+	t.Parallel()
+
+	ja, en := printers(t)
+	tests := []struct {
+		got  string
+		want string
+	}{
+		{jpholidaymsg.DaysUntil(ja, 0), "今日"},
+		{jpholidaymsg.DaysUntil(ja, 1), "明日"},
+		{jpholidaymsg.DaysUntil(ja, 12), "12日後"},
+		{jpholidaymsg.DaysUntil(en, 0), "today"},
+		{jpholidaymsg.DaysUntil(en, 1), "tomorrow"},
+		{jpholidaymsg.DaysUntil(en, 12), "in 12 days"},
+		{jpholidaymsg.BusinessDaysRemaining(ja, 3), "残り3営業日"},
+		{jpholidaymsg.BusinessDaysRemaining(en, 1), "1 business day remaining"},
+		{jpholidaymsg.BusinessDaysRemaining(en, 3), "3 business days remaining"},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("got %q, want %q", tt.got, tt.want)
+		}
+	}
+}
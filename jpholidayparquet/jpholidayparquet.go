@@ -0,0 +1,116 @@
+// Package jpholidayparquet exports holiday data as Apache Parquet for
+// analytics pipelines.
+//
+// The file has one row per holiday with the columns date (DATE), name, kind,
+// and source, so it can be loaded into Spark, BigQuery, DuckDB, or pandas
+// and joined against event logs directly:
+//
+//	f, _ := os.Create("holidays.parquet")
+//	defer f.Close()
+//	err := jpholidayparquet.Write(f, jpholidayparquet.Options{})
+//
+// This package lives in its own module so that the core jpholiday package
+// stays free of third-party dependencies.
+package jpholidayparquet
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+)
+
+// Values of [Row.Kind].
+const (
+	KindNational   = "national"   // A named national holiday (国民の祝日).
+	KindSubstitute = "substitute" // A day off listed as 休日: a substitute or citizens' holiday.
+	KindCustom     = "custom"     // A custom holiday registered on the calendar.
+)
+
+// Values of [Row.Source].
+const (
+	SourceCabinetOffice = "cabinet-office" // The built-in Cabinet Office dataset.
+	SourceCustom        = "custom"         // Registered at runtime on the calendar.
+)
+
+// Row is a single holiday as written to Parquet.
+type Row struct {
+	Date   time.Time // Midnight UTC, written as a DATE column.
+	Name   string
+	Kind   string // One of the Kind constants.
+	Source string // One of the Source constants.
+}
+
+// record is the Parquet schema of a Row. DATE columns are encoded as days
+// since the Unix epoch.
+type record struct {
+	Date   int32  `parquet:"date,date"`
+	Name   string `parquet:"name"`
+	Kind   string `parquet:"kind"`
+	Source string `parquet:"source"`
+}
+
+func toRecord(r Row) record {
+	days := r.Date.Unix() / (24 * 60 * 60)
+	return record{Date: int32(days), Name: r.Name, Kind: r.Kind, Source: r.Source} // #nosec G115 -- holiday dates fit in int32 days.
+}
+
+// Options configures export.
+type Options struct {
+	// Calendar supplies holidays. If nil, the package-level default calendar is used.
+	Calendar *jpholiday.Calendar
+}
+
+// builtin is a calendar with no customizations, used to tell built-in
+// holidays apart from custom ones.
+var builtin = jpholiday.New()
+
+// Rows returns every holiday on the calendar (built-in and custom, minus
+// removed), sorted by date.
+func Rows(opts Options) []Row {
+	cal := opts.Calendar
+	if cal == nil {
+		cal = jpholiday.Default()
+	}
+	holidays, locale := cal.Holidays(), cal.Locale()
+	// Translated names of built-in holidays, such as those of substitute
+	// holidays in English, depend on the date.
+	translated := jpholiday.New()
+	if err := translated.SetLocale(locale); err != nil {
+		// The locale was accepted by the calendar it came from; should it
+		// be rejected here anyway, match built-in holidays by their
+		// Japanese names alone.
+		translated = builtin
+	}
+
+	rows := make([]Row, len(holidays))
+	for i, h := range holidays {
+		rows[i] = Row{Date: h.Date, Name: h.Name, Kind: KindCustom, Source: SourceCustom}
+		name := builtin.HolidayName(h.Date)
+		if name == "" || (h.Name != name && h.Name != translated.HolidayName(h.Date)) {
+			continue
+		}
+		rows[i].Source = SourceCabinetOffice
+		if name == "休日" {
+			rows[i].Kind = KindSubstitute
+		} else {
+			rows[i].Kind = KindNational
+		}
+	}
+	return rows
+}
+
+// Write writes every holiday on the calendar to w as a Parquet file.
+func Write(w io.Writer, opts Options) error {
+	rows := Rows(opts)
+	records := make([]record, len(rows))
+	for i, r := range rows {
+		records[i] = toRecord(r)
+	}
+	if err := parquet.Write(w, records); err != nil {
+		return fmt.Errorf("jpholidayparquet: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package jpholidayparquet_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+	"github.com/rabitt1ove/jp-holidays/jpholidayparquet"
+)
+
+func d(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestRows(t *testing.T) {
+	t.Parallel()
+
+	cal := jpholiday.New()
+	cal.AddCustomHoliday(d(2026, time.June, 15), "創立記念日")
+	cal.AddCustomHoliday(d(2026, time.January, 1), "年始休業") // overrides 元日
+
+	byDate := make(map[time.Time]jpholidayparquet.Row)
+	for _, r := range jpholidayparquet.Rows(jpholidayparquet.Options{Calendar: cal}) {
+		byDate[r.Date] = r
+	}
+
+	tests := []struct {
+		date   time.Time
+		name   string
+		kind   string
+		source string
+	}{
+		{d(2026, time.May, 3), "憲法記念日", jpholidayparquet.KindNational, jpholidayparquet.SourceCabinetOffice},
+		{d(2026, time.May, 6), "休日", jpholidayparquet.KindSubstitute, jpholidayparquet.SourceCabinetOffice},
+		{d(2026, time.June, 15), "創立記念日", jpholidayparquet.KindCustom, jpholidayparquet.SourceCustom},
+		{d(2026, time.January, 1), "年始休業", jpholidayparquet.KindCustom, jpholidayparquet.SourceCustom},
+	}
+	for _, tt := range tests {
+		r, ok := byDate[tt.date]
+		if !ok {
+			t.Errorf("%s: missing row", tt.date.Format("2006-01-02"))
+			continue
+		}
+		if r.Name != tt.name || r.Kind != tt.kind || r.Source != tt.source {
+			t.Errorf("%s: got %+v, want name=%s kind=%s source=%s",
+				tt.date.Format("2006-01-02"), r, tt.name, tt.kind, tt.source)
+		}
+	}
+}
+
+func TestRows_EnglishLocale(t *testing.T) {
+	t.Parallel()
+
+	cal := jpholiday.New()
+	if err := cal.SetLocale(jpholiday.LocaleEnglish); err != nil {
+		t.Fatalf("SetLocale error: %v", err)
+	}
+	for _, r := range jpholidayparquet.Rows(jpholidayparquet.Options{Calendar: cal}) {
+		if r.Source != jpholidayparquet.SourceCabinetOffice {
+			t.Fatalf("%s %s: translated built-in holiday reported as %s", r.Date.Format("2006-01-02"), r.Name, r.Source)
+		}
+	}
+}
+
+func TestWrite_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	cal := jpholiday.New()
+	want := jpholidayparquet.Rows(jpholidayparquet.Options{Calendar: cal})
+
+	var buf bytes.Buffer
+	if err := jpholidayparquet.Write(&buf, jpholidayparquet.Options{Calendar: cal}); err != nil {
+		t.Fatalf("Write error: %v", err)
+	}
+
+	type record struct {
+		Date   int32  `parquet:"date,date"`
+		Name   string `parquet:"name"`
+		Kind   string `parquet:"kind"`
+		Source string `parquet:"source"`
+	}
+	got, err := parquet.Read[record](bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("Read error: %v", err)
+	}
+	if len(got) != len(want) {
+		t.Fatalf("read %d rows, want %d", len(got), len(want))
+	}
+	for i := range want {
+		date := time.Unix(int64(got[i].Date)*24*60*60, 0).UTC()
+		if !date.Equal(want[i].Date) || got[i].Name != want[i].Name ||
+			got[i].Kind != want[i].Kind || got[i].Source != want[i].Source {
+			t.Errorf("row %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestWrite_Schema(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	if err := jpholidayparquet.Write(&buf, jpholidayparquet.Options{}); err != nil {
+		t.Fatalf("Write error: %v", err)
+	}
+	f, err := parquet.OpenFile(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("OpenFile error: %v", err)
+	}
+
+	col, ok := f.Schema().Lookup("date")
+	if !ok {
+		t.Fatal("schema has no date column")
+	}
+	if lt := col.Node.Type().LogicalType(); lt.String() != "DATE" {
+		t.Errorf("date column logical type = %v, want DATE", lt)
+	}
+	for _, name := range []string{"name", "kind", "source"} {
+		if _, ok := f.Schema().Lookup(name); !ok {
+			t.Errorf("schema has no %s column", name)
+		}
+	}
+}
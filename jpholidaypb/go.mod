@@ -0,0 +1,8 @@
+module github.com/rabitt1ove/jp-holidays/jpholidaypb
+
+go 1.25
+
+require (
+	github.com/rabitt1ove/jp-holidays v0.1.0
+	google.golang.org/protobuf v1.36.11
+)
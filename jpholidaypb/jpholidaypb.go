@@ -0,0 +1,94 @@
+// Package jpholidaypb provides a Protocol Buffers schema for holiday data
+// and helpers to convert between the generated messages and jpholiday types.
+//
+// The schema is defined in holiday.proto (package jpholiday.v1). Regenerate
+// the bindings with:
+//
+//	protoc --go_out=. --go_opt=paths=source_relative holiday.proto
+//
+// Typical usage ships a calendar snapshot between services:
+//
+//	b, err := jpholidaypb.Marshal(cal.HolidaysInYear(2026))
+//	...
+//	holidays, err := jpholidaypb.Unmarshal(b)
+package jpholidaypb
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative holiday.proto
+
+import (
+	"fmt"
+	"time"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+	"google.golang.org/protobuf/proto"
+)
+
+// FromDate converts a time.Time to a Date message. The calendar date is
+// taken as-is from t's location; jpholiday.Holiday dates are midnight UTC.
+func FromDate(t time.Time) *Date {
+	y, m, d := t.Date()
+	return &Date{Year: int32(y), Month: int32(m), Day: int32(d)} // #nosec G115 -- calendar fields fit in int32.
+}
+
+// Time returns the date as midnight UTC, matching jpholiday.Holiday.Date.
+// It returns an error if the fields do not form a valid calendar date.
+func (x *Date) Time() (time.Time, error) {
+	if x == nil {
+		return time.Time{}, fmt.Errorf("jpholidaypb: missing date")
+	}
+	t := time.Date(int(x.GetYear()), time.Month(x.GetMonth()), int(x.GetDay()), 0, 0, 0, 0, time.UTC)
+	if t.Year() != int(x.GetYear()) || t.Month() != time.Month(x.GetMonth()) || t.Day() != int(x.GetDay()) {
+		return time.Time{}, fmt.Errorf("jpholidaypb: invalid date %04d-%02d-%02d", x.GetYear(), x.GetMonth(), x.GetDay())
+	}
+	return t, nil
+}
+
+// FromHoliday converts a jpholiday.Holiday to a Holiday message.
+func FromHoliday(h jpholiday.Holiday) *Holiday {
+	return &Holiday{Date: FromDate(h.Date), Name: h.Name}
+}
+
+// ToHoliday converts the message to a jpholiday.Holiday.
+func (x *Holiday) ToHoliday() (jpholiday.Holiday, error) {
+	t, err := x.GetDate().Time()
+	if err != nil {
+		return jpholiday.Holiday{}, err
+	}
+	return jpholiday.Holiday{Date: t, Name: x.GetName()}, nil
+}
+
+// NewHolidaySet builds a HolidaySet from jpholiday holidays, preserving order.
+func NewHolidaySet(holidays []jpholiday.Holiday) *HolidaySet {
+	set := &HolidaySet{Holidays: make([]*Holiday, len(holidays))}
+	for i, h := range holidays {
+		set.Holidays[i] = FromHoliday(h)
+	}
+	return set
+}
+
+// ToHolidays converts the set back to jpholiday holidays.
+func (x *HolidaySet) ToHolidays() ([]jpholiday.Holiday, error) {
+	holidays := make([]jpholiday.Holiday, 0, len(x.GetHolidays()))
+	for i, h := range x.GetHolidays() {
+		hd, err := h.ToHoliday()
+		if err != nil {
+			return nil, fmt.Errorf("holiday %d: %w", i, err)
+		}
+		holidays = append(holidays, hd)
+	}
+	return holidays, nil
+}
+
+// Marshal encodes holidays as a binary HolidaySet message.
+func Marshal(holidays []jpholiday.Holiday) ([]byte, error) {
+	return proto.Marshal(NewHolidaySet(holidays))
+}
+
+// Unmarshal decodes a binary HolidaySet message.
+func Unmarshal(b []byte) ([]jpholiday.Holiday, error) {
+	var set HolidaySet
+	if err := proto.Unmarshal(b, &set); err != nil {
+		return nil, err
+	}
+	return set.ToHolidays()
+}
@@ -0,0 +1,79 @@
+package jpholidaypb
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestMarshalUnmarshal_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	want := jpholiday.HolidaysInYear(2026)
+	b, err := Marshal(want)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+
+	got, err := Unmarshal(b)
+	if err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d holidays, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if !got[i].Date.Equal(want[i].Date) || got[i].Name != want[i].Name {
+			t.Errorf("holiday[%d] = %v %q, want %v %q", i, got[i].Date, got[i].Name, want[i].Date, want[i].Name)
+		}
+	}
+}
+
+func TestFromHoliday(t *testing.T) {
+	t.Parallel()
+
+	h := FromHoliday(jpholiday.Holiday{Date: time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC), Name: "元日"})
+	if h.GetDate().GetYear() != 2026 || h.GetDate().GetMonth() != 1 || h.GetDate().GetDay() != 1 {
+		t.Errorf("date = %v, want 2026-01-01", h.GetDate())
+	}
+	if h.GetName() != "元日" {
+		t.Errorf("name = %q, want 元日", h.GetName())
+	}
+}
+
+func TestUnmarshal_InvalidDate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		set  *HolidaySet
+		want string
+	}{
+		{"missing date", &HolidaySet{Holidays: []*Holiday{{Name: "x"}}}, "missing date"},
+		{"invalid date", &HolidaySet{Holidays: []*Holiday{{Date: &Date{Year: 2026, Month: 2, Day: 30}, Name: "x"}}}, "invalid date"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			b, err := proto.Marshal(tt.set)
+			if err != nil {
+				t.Fatalf("proto.Marshal error: %v", err)
+			}
+			_, err = Unmarshal(b)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Unmarshal error = %v, want containing %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnmarshal_Malformed(t *testing.T) {
+	t.Parallel()
+
+	if _, err := Unmarshal([]byte{0xff, 0xff}); err == nil {
+		t.Error("expected error for malformed input")
+	}
+}
@@ -0,0 +1,133 @@
+// Package jpholidayprom exports Japanese holiday calendar state as
+// Prometheus metrics, so that dashboards can show whether today is a
+// business day and alerts can fire before the deployed holiday data runs
+// out:
+//
+//	prometheus.MustRegister(jpholidayprom.NewCollector(jpholidayprom.Options{}))
+//
+// The collector exposes these gauges, evaluated by JST date on each scrape:
+//
+//	jpholiday_is_business_day_today            1 if today is a business day, else 0
+//	jpholiday_is_holiday_today                 1 if today is a holiday, else 0
+//	jpholiday_days_until_next_holiday          days until the next holiday
+//	jpholiday_dataset_coverage_days_remaining  days until the last date of the dataset
+//
+// jpholiday_days_until_next_holiday is omitted when there is no later
+// holiday in the calendar. jpholiday_dataset_coverage_days_remaining goes
+// negative once today is past the dataset, so an alert such as
+//
+//	jpholiday_dataset_coverage_days_remaining < 90
+//
+// gives time to upgrade or refresh the data before the next year begins.
+//
+// This package lives in its own module so that the core jpholiday package
+// stays free of third-party dependencies.
+package jpholidayprom
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+)
+
+// jst is the Asia/Tokyo timezone in which today is decided.
+var jst = time.FixedZone("Asia/Tokyo", 9*60*60)
+
+// Options configures [NewCollector].
+type Options struct {
+	// Calendar is the calendar to report on. If nil, the package-level
+	// default calendar is used.
+	Calendar *jpholiday.Calendar
+
+	// Now returns the current time. If nil, time.Now is used.
+	Now func() time.Time
+}
+
+// calendar is the part of [jpholiday.Calendar] the collector reads.
+type calendar interface {
+	IsHoliday(t time.Time) bool
+	IsBusinessDay(t time.Time) bool
+	NextHoliday(t time.Time) (jpholiday.Holiday, bool)
+	DatasetRange() (first, last time.Time)
+}
+
+var (
+	businessDayDesc = prometheus.NewDesc(
+		"jpholiday_is_business_day_today",
+		"Whether today (JST) is a business day: 1 if so, 0 otherwise.",
+		nil, nil)
+	holidayDesc = prometheus.NewDesc(
+		"jpholiday_is_holiday_today",
+		"Whether today (JST) is a holiday: 1 if so, 0 otherwise.",
+		nil, nil)
+	nextHolidayDesc = prometheus.NewDesc(
+		"jpholiday_days_until_next_holiday",
+		"Number of days from today (JST) until the next holiday.",
+		nil, nil)
+	coverageDesc = prometheus.NewDesc(
+		"jpholiday_dataset_coverage_days_remaining",
+		"Number of days from today (JST) until the last date covered by the holiday dataset; negative once past it.",
+		nil, nil)
+)
+
+// Collector is a prometheus.Collector reporting on a holiday calendar.
+// Create one with [NewCollector].
+type Collector struct {
+	cal calendar
+	now func() time.Time
+}
+
+var _ prometheus.Collector = (*Collector)(nil)
+
+// NewCollector returns a collector for the calendar in opts. Register it
+// with a prometheus.Registerer to expose its metrics.
+func NewCollector(opts Options) *Collector {
+	c := &Collector{cal: jpholiday.Default(), now: opts.Now}
+	if opts.Calendar != nil {
+		c.cal = opts.Calendar
+	}
+	if c.now == nil {
+		c.now = time.Now
+	}
+	return c
+}
+
+// Describe implements prometheus.Collector.
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- businessDayDesc
+	ch <- holidayDesc
+	ch <- nextHolidayDesc
+	ch <- coverageDesc
+}
+
+// Collect implements prometheus.Collector.
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	now := c.now()
+	today := day(now)
+	ch <- prometheus.MustNewConstMetric(businessDayDesc, prometheus.GaugeValue, boolValue(c.cal.IsBusinessDay(now)))
+	ch <- prometheus.MustNewConstMetric(holidayDesc, prometheus.GaugeValue, boolValue(c.cal.IsHoliday(now)))
+	if h, ok := c.cal.NextHoliday(now); ok {
+		ch <- prometheus.MustNewConstMetric(nextHolidayDesc, prometheus.GaugeValue, daysBetween(today, day(h.Date)))
+	}
+	_, last := c.cal.DatasetRange()
+	ch <- prometheus.MustNewConstMetric(coverageDesc, prometheus.GaugeValue, daysBetween(today, day(last)))
+}
+
+// day returns the JST date of t as midnight UTC, so that dates subtract to
+// whole days.
+func day(t time.Time) time.Time {
+	y, m, d := t.In(jst).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func daysBetween(from, to time.Time) float64 {
+	return float64(to.Sub(from) / (24 * time.Hour))
+}
+
+func boolValue(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
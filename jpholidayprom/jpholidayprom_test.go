@@ -0,0 +1,96 @@
+package jpholidayprom_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+	"github.com/rabitt1ove/jp-holidays/jpholidayprom"
+)
+
+var jst = time.FixedZone("Asia/Tokyo", 9*60*60)
+
+func clock(t time.Time) func() time.Time { return func() time.Time { return t } }
+
+// coverage returns the expected jpholiday_dataset_coverage_days_remaining
+// on the JST date of now.
+func coverage(now time.Time) int {
+	_, last := jpholiday.DatasetRange()
+	y, m, d := now.In(jst).Date()
+	return int(last.Sub(time.Date(y, m, d, 0, 0, 0, 0, time.UTC)) / (24 * time.Hour))
+}
+
+func TestCollector(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, time.May, 1, 23, 30, 0, 0, jst) // Friday before Golden Week
+	c := jpholidayprom.NewCollector(jpholidayprom.Options{Now: clock(now)})
+	want := fmt.Sprintf(`
+# HELP jpholiday_dataset_coverage_days_remaining Number of days from today (JST) until the last date covered by the holiday dataset; negative once past it.
+# TYPE jpholiday_dataset_coverage_days_remaining gauge
+jpholiday_dataset_coverage_days_remaining %d
+# HELP jpholiday_days_until_next_holiday Number of days from today (JST) until the next holiday.
+# TYPE jpholiday_days_until_next_holiday gauge
+jpholiday_days_until_next_holiday 2
+# HELP jpholiday_is_business_day_today Whether today (JST) is a business day: 1 if so, 0 otherwise.
+# TYPE jpholiday_is_business_day_today gauge
+jpholiday_is_business_day_today 1
+# HELP jpholiday_is_holiday_today Whether today (JST) is a holiday: 1 if so, 0 otherwise.
+# TYPE jpholiday_is_holiday_today gauge
+jpholiday_is_holiday_today 0
+`, coverage(now))
+	if err := testutil.CollectAndCompare(c, strings.NewReader(want)); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCollector_Calendar(t *testing.T) {
+	t.Parallel()
+
+	// 00:30 on May 1 in JST is still April 30 in UTC; the collector uses
+	// the JST date.
+	now := time.Date(2026, time.April, 30, 15, 30, 0, 0, time.UTC)
+	cal := jpholiday.New()
+	cal.AddClosure(time.Date(2026, time.May, 1, 0, 0, 0, 0, jst))
+	c := jpholidayprom.NewCollector(jpholidayprom.Options{Calendar: cal, Now: clock(now)})
+
+	want := `
+# HELP jpholiday_is_business_day_today Whether today (JST) is a business day: 1 if so, 0 otherwise.
+# TYPE jpholiday_is_business_day_today gauge
+jpholiday_is_business_day_today 0
+# HELP jpholiday_is_holiday_today Whether today (JST) is a holiday: 1 if so, 0 otherwise.
+# TYPE jpholiday_is_holiday_today gauge
+jpholiday_is_holiday_today 0
+`
+	if err := testutil.CollectAndCompare(c, strings.NewReader(want),
+		"jpholiday_is_business_day_today", "jpholiday_is_holiday_today"); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCollector_PastDataset(t *testing.T) {
+	t.Parallel()
+
+	_, last := jpholiday.DatasetRange()
+	now := time.Date(last.Year()+1, time.June, 1, 12, 0, 0, 0, jst)
+	c := jpholidayprom.NewCollector(jpholidayprom.Options{Now: clock(now)})
+
+	if n := testutil.CollectAndCount(c, "jpholiday_days_until_next_holiday"); n != 0 {
+		t.Errorf("jpholiday_days_until_next_holiday reported %d times past the dataset, want 0", n)
+	}
+	want := fmt.Sprintf(`
+# HELP jpholiday_dataset_coverage_days_remaining Number of days from today (JST) until the last date covered by the holiday dataset; negative once past it.
+# TYPE jpholiday_dataset_coverage_days_remaining gauge
+jpholiday_dataset_coverage_days_remaining %d
+`, coverage(now))
+	if coverage(now) >= 0 {
+		t.Fatalf("coverage(%v) = %d, want negative", now, coverage(now))
+	}
+	if err := testutil.CollectAndCompare(c, strings.NewReader(want),
+		"jpholiday_dataset_coverage_days_remaining"); err != nil {
+		t.Error(err)
+	}
+}
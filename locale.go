@@ -0,0 +1,154 @@
+package jpholiday
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+	"sync/atomic"
+)
+
+// Locales supported by [Calendar.SetLocale] without registration. Further
+// locales can be added with [RegisterLocale].
+const (
+	LocaleJapanese = "ja" // Holiday names as published by the Cabinet Office (default).
+	LocaleEnglish  = "en" // English names (see [EnglishName]), falling back to Japanese.
+)
+
+// locales holds the translations added by RegisterLocale, keyed by locale
+// and then by Japanese name. Like a calendar snapshot, a published map is
+// never modified, so lookups need no lock.
+var (
+	localesMu sync.Mutex // serializes RegisterLocale
+	locales   atomic.Pointer[map[string]map[string]string]
+)
+
+// RegisterLocale adds translations of holiday names for locale, so that
+// translation packs can be maintained outside this package:
+//
+//	jpholiday.RegisterLocale("ko", map[string]string{
+//		"元日": "설날",
+//		"成人の日": "성인의 날",
+//		// ...
+//	})
+//
+// names maps Japanese holiday or observance names, as in the dataset, to
+// their translations. Names without a translation are returned in
+// Japanese. Registering a locale again replaces its translations, and
+// translations registered for [LocaleEnglish] take precedence over the
+// built-in English names. names is copied; later changes to it have no
+// effect.
+//
+// Calendars already set to locale pick up the translations immediately.
+// RegisterLocale returns an error for an empty locale, for
+// [LocaleJapanese], or for an empty translation.
+func RegisterLocale(locale string, names map[string]string) error {
+	switch locale {
+	case "":
+		return errors.New("jpholiday: empty locale")
+	case LocaleJapanese:
+		return fmt.Errorf("jpholiday: cannot register %q, the locale of the dataset", locale)
+	}
+	for ja, name := range names {
+		if ja == "" || name == "" {
+			return fmt.Errorf("jpholiday: locale %q: empty translation for %q", locale, ja)
+		}
+	}
+
+	localesMu.Lock()
+	defer localesMu.Unlock()
+	m := make(map[string]map[string]string)
+	if cur := locales.Load(); cur != nil {
+		maps.Copy(m, *cur)
+	}
+	m[locale] = maps.Clone(names)
+	locales.Store(&m)
+	return nil
+}
+
+// Locales returns the locales accepted by [Calendar.SetLocale] in sorted
+// order: [LocaleJapanese], [LocaleEnglish], and those added by
+// [RegisterLocale].
+func Locales() []string {
+	result := []string{LocaleJapanese, LocaleEnglish}
+	if m := locales.Load(); m != nil {
+		for locale := range *m {
+			if locale != LocaleEnglish {
+				result = append(result, locale)
+			}
+		}
+	}
+	slices.Sort(result)
+	return result
+}
+
+// supportedLocale reports whether locale can be selected by SetLocale.
+func supportedLocale(locale string) bool {
+	if locale == LocaleJapanese || locale == LocaleEnglish {
+		return true
+	}
+	_, ok := registeredNames(locale)
+	return ok
+}
+
+// registeredNames returns the translations registered for locale.
+func registeredNames(locale string) (map[string]string, bool) {
+	m := locales.Load()
+	if m == nil {
+		return nil, false
+	}
+	names, ok := (*m)[locale]
+	return names, ok
+}
+
+// SetLocale selects the language of built-in holiday names returned by the
+// calendar's lookup and list functions. Each calendar has its own locale,
+// [LocaleJapanese] by default, so calendars serving different languages can
+// share a process. Custom holiday names are returned as registered. It
+// returns an error for a locale that is neither built in nor registered
+// with [RegisterLocale].
+func (c *Calendar) SetLocale(locale string) error {
+	if !supportedLocale(locale) {
+		return fmt.Errorf("jpholiday: unsupported locale %q", locale)
+	}
+	c.update(func(s *snapshot) { s.locale = locale })
+	return nil
+}
+
+// Locale returns the calendar's locale.
+func (c *Calendar) Locale() string {
+	return c.load().locale
+}
+
+// localName translates the built-in holiday name of d into the snapshot's
+// locale.
+func (s *snapshot) localName(d date, name string) string {
+	return translate(s.locale, name, func(string) string { return s.data().englishName(d) })
+}
+
+// translate returns name in locale, looking in the registered translations
+// first and then, for LocaleEnglish, calling english. It returns name
+// unchanged if there is no translation.
+func translate(locale, name string, english func(name string) string) string {
+	if locale == LocaleJapanese {
+		return name
+	}
+	if names, ok := registeredNames(locale); ok {
+		if t := names[name]; t != "" {
+			return t
+		}
+	}
+	if locale == LocaleEnglish {
+		if en := english(name); en != "" {
+			return en
+		}
+	}
+	return name
+}
+
+// SetLocale selects the language of built-in holiday names on the default calendar.
+func SetLocale(locale string) error { return defaultCal.SetLocale(locale) }
+
+// Locale returns the default calendar's locale.
+func Locale() string { return defaultCal.Locale() }
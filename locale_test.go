@@ -0,0 +1,179 @@
+package jpholiday_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+	"unicode"
+
+	. "github.com/rabitt1ove/jp-holidays"
+)
+
+func TestSetLocale(t *testing.T) {
+	t.Parallel()
+
+	cal := New()
+	if got := cal.Locale(); got != LocaleJapanese {
+		t.Fatalf("default Locale() = %q, want %q", got, LocaleJapanese)
+	}
+	cal.AddCustomHoliday(d(2026, time.June, 15), "創立記念日")
+
+	if err := cal.SetLocale(LocaleEnglish); err != nil {
+		t.Fatalf("SetLocale error: %v", err)
+	}
+	if got := cal.HolidayName(d(2026, time.January, 1)); got != "New Year's Day" {
+		t.Errorf("HolidayName = %q, want New Year's Day", got)
+	}
+	if got := cal.HolidayName(d(2026, time.June, 15)); got != "創立記念日" {
+		t.Errorf("custom names should not be translated, got %q", got)
+	}
+	if got := cal.HolidaysInMonth(2026, time.May); len(got) == 0 || got[0].Name != "Constitution Memorial Day" {
+		t.Errorf("HolidaysInMonth names should be translated, got %v", got)
+	}
+	if h, ok := cal.NextHoliday(d(2026, time.January, 1)); !ok || h.Name != "Coming of Age Day" {
+		t.Errorf("NextHoliday = %v, want Coming of Age Day", h)
+	}
+
+	if err := cal.SetLocale("fr"); err == nil {
+		t.Error("expected error for unsupported locale")
+	}
+	if got := cal.Locale(); got != LocaleEnglish {
+		t.Errorf("failed SetLocale should keep %q, got %q", LocaleEnglish, got)
+	}
+}
+
+func TestRegisterLocale(t *testing.T) {
+	t.Parallel()
+
+	// Registrations are process-wide, so the test uses a private-use tag
+	// that no other test selects.
+	names := map[string]string{"元日": "설날", "大晦日": "섣달 그믐날"}
+	if err := RegisterLocale("x-test-ko", names); err != nil {
+		t.Fatalf("RegisterLocale error: %v", err)
+	}
+	names["元日"] = "changed"
+	if !slices.Contains(Locales(), "x-test-ko") {
+		t.Errorf("Locales() = %v, want x-test-ko included", Locales())
+	}
+
+	cal := New()
+	cal.SetObservances(true)
+	if err := cal.SetLocale("x-test-ko"); err != nil {
+		t.Fatalf("SetLocale error: %v", err)
+	}
+	if got := cal.HolidayName(d(2026, time.January, 1)); got != "설날" {
+		t.Errorf("HolidayName = %q, want 설날 (copied at registration)", got)
+	}
+	if got := cal.HolidayName(d(2026, time.May, 3)); got != "憲法記念日" {
+		t.Errorf("untranslated HolidayName = %q, want Japanese fallback", got)
+	}
+	if got := cal.ObservanceName(d(2026, time.December, 31)); got != "섣달 그믐날" {
+		t.Errorf("ObservanceName = %q, want 섣달 그믐날", got)
+	}
+	if got := New().HolidayName(d(2026, time.January, 1)); got != "元日" {
+		t.Errorf("other calendars should keep their locale, got %q", got)
+	}
+
+	// Re-registering replaces the translations for calendars already using them.
+	if got := cal.HolidaysInYear(2026); got[0].Name != "설날" {
+		t.Fatalf("HolidaysInYear(2026)[0] = %v, want 설날", got[0])
+	}
+	if err := RegisterLocale("x-test-ko", map[string]string{"元日": "신정"}); err != nil {
+		t.Fatalf("RegisterLocale error: %v", err)
+	}
+	if got := cal.HolidayName(d(2026, time.January, 1)); got != "신정" {
+		t.Errorf("HolidayName after re-registering = %q, want 신정", got)
+	}
+	if got := cal.HolidaysInYear(2026); got[0].Name != "신정" {
+		t.Errorf("HolidaysInYear after re-registering = %v, want 신정", got[0])
+	}
+
+	if err := cal.ApplyConfig(Config{Locale: "x-test-ko"}); err != nil {
+		t.Errorf("ApplyConfig with a registered locale: %v", err)
+	}
+	if err := cal.ApplyConfig(Config{Locale: "x-test-unregistered"}); err == nil {
+		t.Error("ApplyConfig should reject an unregistered locale")
+	}
+}
+
+func TestRegisterLocale_Invalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		locale string
+		names  map[string]string
+	}{
+		{"empty locale", "", map[string]string{"元日": "x"}},
+		{"japanese", LocaleJapanese, map[string]string{"元日": "x"}},
+		{"empty translation", "x-test-invalid", map[string]string{"元日": ""}},
+		{"empty name", "x-test-invalid", map[string]string{"": "x"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := RegisterLocale(tt.locale, tt.names); err == nil {
+				t.Errorf("RegisterLocale(%q) = nil, want error", tt.locale)
+			}
+		})
+	}
+	if slices.Contains(Locales(), "x-test-invalid") {
+		t.Error("a rejected locale should not be registered")
+	}
+}
+
+func TestEnglishLocale_SubstituteHolidays(t *testing.T) {
+	t.Parallel()
+
+	cal := New()
+	if err := cal.SetLocale(LocaleEnglish); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		date time.Time
+		want string
+	}{
+		{d(2026, time.May, 6), "Substitute Holiday for Constitution Memorial Day"},
+		{d(2008, time.May, 6), "Substitute Holiday for Greenery Day"}, // after こどもの日 on Monday
+		{d(2024, time.February, 12), "Substitute Holiday for National Foundation Day"},
+		{d(2013, time.May, 6), "Substitute Holiday for Children's Day"},
+		{d(1991, time.May, 6), "Substitute Holiday for Children's Day"},
+		{d(1991, time.May, 4), "Citizens' Holiday"},
+		{d(2026, time.September, 22), "Citizens' Holiday"},
+		{d(2019, time.April, 30), "Citizens' Holiday"},
+		{d(2019, time.May, 1), "Holiday (treated as a national holiday)"},
+	}
+	first, _ := cal.DatasetRange()
+	for _, tt := range tests {
+		if tt.date.Before(first) {
+			continue // not in a trimmed dataset (jpholiday_recent)
+		}
+		if got := cal.HolidayName(tt.date); got != tt.want {
+			t.Errorf("HolidayName(%s) = %q, want %q", tt.date.Format("2006-01-02"), got, tt.want)
+		}
+	}
+
+	dates := cal.DatesOfHoliday("Substitute Holiday for Constitution Memorial Day")
+	if !slices.ContainsFunc(dates, func(t time.Time) bool { return t.Equal(d(2026, time.May, 6)) }) {
+		t.Errorf("DatesOfHoliday should find substitute holidays by their English name, got %d dates", len(dates))
+	}
+	if got, want := len(cal.DatesOfHoliday("休日")), len(New().DatesOfHoliday("休日")); got != want {
+		t.Errorf("DatesOfHoliday(休日) under en = %d dates, want %d", got, want)
+	}
+}
+
+func TestEnglishLocale_CoversDataset(t *testing.T) {
+	t.Parallel()
+
+	cal := New()
+	if err := cal.SetLocale(LocaleEnglish); err != nil {
+		t.Fatal(err)
+	}
+	for _, h := range cal.Holidays() {
+		for _, r := range h.Name {
+			if r > unicode.MaxASCII {
+				t.Errorf("no English name for %s: %q", h.Date.Format("2006-01-02"), h.Name)
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,134 @@
+// Package locales registers Korean and Chinese translations of holiday and
+// observance names with [jpholiday.RegisterLocale], for products that serve
+// Korean and Chinese speaking users. Importing the package registers the
+// locales; select one per calendar:
+//
+//	cal := jpholiday.New()
+//	cal.SetLocale(locales.Korean)
+//	cal.HolidayName(t) // "어린이날" for こどもの日
+//
+// Every name in the dataset has a translation. Substitute and citizens'
+// holidays, both 休日 in the dataset, share a generic translation.
+package locales
+
+import (
+	"maps"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+)
+
+// Locales registered by this package.
+const (
+	Korean             = "ko"
+	SimplifiedChinese  = "zh-Hans"
+	TraditionalChinese = "zh-Hant"
+)
+
+// names maps each locale to its translations, keyed by Japanese name.
+var names = map[string]map[string]string{
+	Korean: {
+		"元日":           "새해 첫날",
+		"成人の日":         "성인의 날",
+		"建国記念の日":       "건국기념일",
+		"天皇誕生日":        "천황 탄생일",
+		"春分の日":         "춘분의 날",
+		"昭和の日":         "쇼와의 날",
+		"憲法記念日":        "헌법기념일",
+		"みどりの日":        "녹색의 날",
+		"こどもの日":        "어린이날",
+		"海の日":          "바다의 날",
+		"山の日":          "산의 날",
+		"敬老の日":         "경로의 날",
+		"秋分の日":         "추분의 날",
+		"体育の日":         "체육의 날",
+		"体育の日（スポーツの日）": "체육의 날(스포츠의 날)",
+		"スポーツの日":       "스포츠의 날",
+		"文化の日":         "문화의 날",
+		"勤労感謝の日":       "근로감사의 날",
+		"休日":           "휴일",
+		"休日（祝日扱い）":     "휴일(공휴일 취급)",
+		"即位礼正殿の儀":      "즉위례 정전의 의식",
+		"大喪の礼":         "대상의 예",
+		"結婚の儀":         "결혼의 의식",
+		"七夕":           "칠석",
+		"お盆":           "오봉",
+		"七五三":          "시치고산",
+		"大晦日":          "섣달 그믐날",
+	},
+	SimplifiedChinese: {
+		"元日":           "元旦",
+		"成人の日":         "成人节",
+		"建国記念の日":       "建国纪念日",
+		"天皇誕生日":        "天皇诞辰",
+		"春分の日":         "春分日",
+		"昭和の日":         "昭和日",
+		"憲法記念日":        "宪法纪念日",
+		"みどりの日":        "绿之日",
+		"こどもの日":        "儿童节",
+		"海の日":          "海之日",
+		"山の日":          "山之日",
+		"敬老の日":         "敬老节",
+		"秋分の日":         "秋分日",
+		"体育の日":         "体育节",
+		"体育の日（スポーツの日）": "体育节（运动之日）",
+		"スポーツの日":       "运动之日",
+		"文化の日":         "文化节",
+		"勤労感謝の日":       "勤劳感谢日",
+		"休日":           "休息日",
+		"休日（祝日扱い）":     "休息日（视为节日）",
+		"即位礼正殿の儀":      "即位礼正殿之仪",
+		"大喪の礼":         "大丧之礼",
+		"結婚の儀":         "结婚之仪",
+		"七夕":           "七夕",
+		"お盆":           "盂兰盆节",
+		"七五三":          "七五三节",
+		"大晦日":          "除夕",
+	},
+	TraditionalChinese: {
+		"元日":           "元旦",
+		"成人の日":         "成人節",
+		"建国記念の日":       "建國紀念日",
+		"天皇誕生日":        "天皇誕辰",
+		"春分の日":         "春分日",
+		"昭和の日":         "昭和日",
+		"憲法記念日":        "憲法紀念日",
+		"みどりの日":        "綠之日",
+		"こどもの日":        "兒童節",
+		"海の日":          "海之日",
+		"山の日":          "山之日",
+		"敬老の日":         "敬老節",
+		"秋分の日":         "秋分日",
+		"体育の日":         "體育節",
+		"体育の日（スポーツの日）": "體育節（運動之日）",
+		"スポーツの日":       "運動之日",
+		"文化の日":         "文化節",
+		"勤労感謝の日":       "勤勞感謝日",
+		"休日":           "休息日",
+		"休日（祝日扱い）":     "休息日（視為節日）",
+		"即位礼正殿の儀":      "即位禮正殿之儀",
+		"大喪の礼":         "大喪之禮",
+		"結婚の儀":         "結婚之儀",
+		"七夕":           "七夕",
+		"お盆":           "盂蘭盆節",
+		"七五三":          "七五三節",
+		"大晦日":          "除夕",
+	},
+}
+
+func init() {
+	for locale, m := range names {
+		if err := jpholiday.RegisterLocale(locale, m); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// Names returns the translations registered for locale, keyed by Japanese
+// name, or nil if this package does not provide locale.
+func Names(locale string) map[string]string {
+	m, ok := names[locale]
+	if !ok {
+		return nil
+	}
+	return maps.Clone(m)
+}
@@ -0,0 +1,62 @@
+package locales_test
+
+import (
+	"testing"
+	"time"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+	"github.com/rabitt1ove/jp-holidays/locales"
+)
+
+func d(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestSetLocale(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		locale string
+		date   time.Time
+		want   string
+	}{
+		{locales.Korean, d(2026, time.May, 5), "어린이날"},
+		{locales.SimplifiedChinese, d(2026, time.October, 12), "运动之日"},
+		{locales.TraditionalChinese, d(2026, time.October, 12), "運動之日"},
+		{locales.Korean, d(2026, time.May, 6), "휴일"},
+	}
+	for _, tt := range tests {
+		cal := jpholiday.New()
+		if err := cal.SetLocale(tt.locale); err != nil {
+			t.Fatalf("SetLocale(%q) error: %v", tt.locale, err)
+		}
+		if got := cal.HolidayName(tt.date); got != tt.want {
+			t.Errorf("%s HolidayName(%s) = %q, want %q", tt.locale, tt.date.Format("2006-01-02"), got, tt.want)
+		}
+	}
+}
+
+func TestNames_CoverDataset(t *testing.T) {
+	t.Parallel()
+
+	for _, locale := range []string{locales.Korean, locales.SimplifiedChinese, locales.TraditionalChinese} {
+		names := locales.Names(locale)
+		for _, n := range jpholiday.New().HolidayNames() {
+			if names[n.Name] == "" {
+				t.Errorf("%s: no translation for %q", locale, n.Name)
+			}
+		}
+		for _, o := range func() []jpholiday.Observance {
+			cal := jpholiday.New()
+			cal.SetObservances(true)
+			return cal.ObservancesInYear(2026)
+		}() {
+			if names[o.Name] == "" {
+				t.Errorf("%s: no translation for observance %q", locale, o.Name)
+			}
+		}
+	}
+	if locales.Names("fr") != nil {
+		t.Error("Names(fr) should be nil")
+	}
+}
@@ -0,0 +1,75 @@
+package jpholiday
+
+import "time"
+
+// DatesOfHoliday returns every date, sorted, on which the calendar has a
+// holiday with the given name, as reported by [Calendar.HolidayName]:
+// custom holidays are included, removed holidays are not, and built-in
+// holidays match their name in either Japanese or English. Former and later
+// names of a renamed holiday match too, so 体育の日 also finds スポーツの日
+// (see [HolidayHistory]). It returns nil if there is no such holiday.
+func (c *Calendar) DatesOfHoliday(name string) []time.Time {
+	names := aliasesOf(name)
+	var dates []time.Time
+	for _, h := range c.Holidays() {
+		if names[h.Name] {
+			dates = append(dates, h.Date)
+		}
+	}
+	return dates
+}
+
+// DateOfHolidayInYear returns the date of the holiday with the given name in
+// the given year, answering questions such as "when is 成人の日 in 2027?".
+// Names match as for [Calendar.DatesOfHoliday]. For names that occur more
+// than once a year, such as 休日 (substitute holidays), it returns the
+// first. ok is false if the holiday does not occur in that year.
+func (c *Calendar) DateOfHolidayInYear(year int, name string) (time.Time, bool) {
+	names := aliasesOf(name)
+	for _, h := range c.HolidaysInYear(year) {
+		if names[h.Name] {
+			return h.Date, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// HolidayNameYears is a holiday name and the years it occurs in, as
+// returned by [Calendar.HolidayNames].
+type HolidayNameYears struct {
+	Name  string // The holiday name, in the calendar's locale for built-in holidays.
+	Years []int  // The years with at least one holiday of this name, ascending.
+}
+
+// HolidayNames returns the distinct names of the calendar's holidays,
+// including custom holidays and excluding removed ones, in order of first
+// occurrence, each with the years it occurs in. It is useful for building
+// filters and for checking that every name has a translation.
+func (c *Calendar) HolidayNames() []HolidayNameYears {
+	var names []HolidayNameYears
+	index := make(map[string]int)
+	for _, h := range c.Holidays() {
+		year := h.Date.Year()
+		i, ok := index[h.Name]
+		if !ok {
+			i = len(names)
+			index[h.Name] = i
+			names = append(names, HolidayNameYears{Name: h.Name})
+		}
+		if years := names[i].Years; len(years) == 0 || years[len(years)-1] != year {
+			names[i].Years = append(years, year)
+		}
+	}
+	return names
+}
+
+// DatesOfHoliday returns the dates of the named holiday on the default calendar.
+func DatesOfHoliday(name string) []time.Time { return defaultCal.DatesOfHoliday(name) }
+
+// DateOfHolidayInYear returns the date of the named holiday in the year on the default calendar.
+func DateOfHolidayInYear(year int, name string) (time.Time, bool) {
+	return defaultCal.DateOfHolidayInYear(year, name)
+}
+
+// HolidayNames returns the distinct holiday names of the default calendar.
+func HolidayNames() []HolidayNameYears { return defaultCal.HolidayNames() }
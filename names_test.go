@@ -0,0 +1,138 @@
+package jpholiday_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	. "github.com/rabitt1ove/jp-holidays"
+)
+
+func TestDatesOfHoliday(t *testing.T) {
+	t.Parallel()
+
+	dates := DatesOfHoliday("山の日")
+	if len(dates) == 0 {
+		t.Fatal("DatesOfHoliday(山の日) = nil")
+	}
+	// 山の日 was first observed in 2016, moved for the Tokyo Olympics in
+	// 2020 and 2021, and otherwise falls on August 11.
+	if !dates[0].Equal(d(2016, time.August, 11)) {
+		t.Errorf("first 山の日 = %s, want 2016-08-11", dates[0].Format(time.DateOnly))
+	}
+	for i, dt := range dates {
+		if i > 0 && !dates[i-1].Before(dt) {
+			t.Errorf("dates not sorted at %d: %s", i, dt.Format(time.DateOnly))
+		}
+		if HolidayName(dt) != "山の日" {
+			t.Errorf("HolidayName(%s) = %q, want 山の日", dt.Format(time.DateOnly), HolidayName(dt))
+		}
+	}
+
+	if got := DatesOfHoliday("存在しない祝日"); got != nil {
+		t.Errorf("DatesOfHoliday(unknown) = %v, want nil", got)
+	}
+}
+
+func TestDatesOfHoliday_CalendarState(t *testing.T) {
+	t.Parallel()
+
+	cal := New()
+	cal.AddCustomHoliday(d(2026, time.April, 1), "創立記念日")
+	cal.AddCustomHoliday(d(2027, time.April, 1), "創立記念日")
+	if got := cal.DatesOfHoliday("創立記念日"); len(got) != 2 || !got[1].Equal(d(2027, time.April, 1)) {
+		t.Errorf("DatesOfHoliday(custom) = %v", got)
+	}
+
+	n := len(cal.DatesOfHoliday("元日"))
+	cal.RemoveHoliday(d(2026, time.January, 1))
+	if got := len(cal.DatesOfHoliday("元日")); got != n-1 {
+		t.Errorf("DatesOfHoliday after RemoveHoliday = %d dates, want %d", got, n-1)
+	}
+
+	if err := cal.SetLocale(LocaleEnglish); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(cal.DatesOfHoliday("New Year's Day")); got != n-1 {
+		t.Errorf("DatesOfHoliday(English) = %d dates, want %d", got, n-1)
+	}
+}
+
+func TestDateOfHolidayInYear(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		year   int
+		name   string
+		want   time.Time
+		wantOK bool
+	}{
+		{2027, "成人の日", d(2027, time.January, 11), true},
+		{2026, "春分の日", d(2026, time.March, 20), true},
+		{2026, "休日", d(2026, time.May, 6), true},
+		{2015, "山の日", time.Time{}, false},
+		{2026, "存在しない祝日", time.Time{}, false},
+	}
+	for _, tt := range tests {
+		got, ok := DateOfHolidayInYear(tt.year, tt.name)
+		if ok != tt.wantOK || !got.Equal(tt.want) {
+			t.Errorf("DateOfHolidayInYear(%d, %q) = %s, %v; want %s, %v", tt.year, tt.name, got.Format(time.DateOnly), ok, tt.want.Format(time.DateOnly), tt.wantOK)
+		}
+	}
+}
+
+func TestHolidayNames(t *testing.T) {
+	t.Parallel()
+
+	names := HolidayNames()
+	if len(names) == 0 || names[0].Name != "元日" {
+		t.Fatalf("HolidayNames()[0] = %v, want 元日 first", names)
+	}
+	seen := make(map[string]bool)
+	for _, n := range names {
+		if seen[n.Name] {
+			t.Errorf("duplicate name %q", n.Name)
+		}
+		seen[n.Name] = true
+		for i := 1; i < len(n.Years); i++ {
+			if n.Years[i-1] >= n.Years[i] {
+				t.Errorf("%s: years not strictly ascending: %v", n.Name, n.Years)
+				break
+			}
+		}
+	}
+	for _, n := range names {
+		if n.Name == "山の日" && n.Years[0] != 2016 {
+			t.Errorf("山の日 first year = %d, want 2016", n.Years[0])
+		}
+		// There was no 天皇誕生日 in 2019, between the abdication and the
+		// new Emperor's first birthday.
+		if n.Name == "天皇誕生日" && (!slices.Contains(n.Years, 2018) || slices.Contains(n.Years, 2019)) {
+			t.Errorf("天皇誕生日 years = %v, want 2018 but not 2019", n.Years)
+		}
+	}
+}
+
+func TestHolidayNames_CalendarState(t *testing.T) {
+	t.Parallel()
+
+	cal := New()
+	cal.AddCustomHoliday(d(2026, time.April, 1), "創立記念日")
+	if err := cal.SetLocale(LocaleEnglish); err != nil {
+		t.Fatal(err)
+	}
+	var custom, english bool
+	for _, n := range cal.HolidayNames() {
+		switch n.Name {
+		case "創立記念日":
+			custom = len(n.Years) == 1 && n.Years[0] == 2026
+		case "New Year's Day":
+			english = true
+		case "元日":
+			t.Error("HolidayNames with LocaleEnglish includes 元日")
+		}
+	}
+	if !custom || !english {
+		t.Errorf("HolidayNames: custom = %v, english = %v; want both", custom, english)
+	}
+}
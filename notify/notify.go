@@ -0,0 +1,378 @@
+// Package notify sends webhook notifications ahead of Japanese holidays and
+// closures, so that teams can prepare for days when operations stop.
+//
+// A Notifier POSTs one JSON [Event] to each configured URL a given number of
+// business days before every upcoming holiday or closure:
+//
+//	n := notify.New(notify.Options{
+//		URLs:     []string{"https://example.com/hooks/holiday"},
+//		LeadDays: 3,
+//		Signer:   notify.HMACSigner{Key: secret},
+//	})
+//	err := n.Run(ctx, time.Hour)
+//
+// Failed deliveries are retried with exponential backoff; events that still
+// fail are attempted again on the next check, unless the failure is
+// permanent, such as a 4xx response.
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+)
+
+// jst is the Asia/Tokyo timezone in which days change over.
+var jst = time.FixedZone("Asia/Tokyo", 9*60*60)
+
+// Event kinds.
+const (
+	KindHoliday = "holiday"
+	KindClosure = "closure"
+)
+
+// Options configures a [Notifier].
+type Options struct {
+	// Calendar supplies holidays, closures, and business days. If nil, the
+	// package-level default calendar is used.
+	Calendar *jpholiday.Calendar
+
+	// URLs receive every event.
+	URLs []string
+
+	// LeadDays is how many business days before a holiday or closure its
+	// event is sent. The zero value means 1, the last business day before.
+	LeadDays int
+
+	// Signer, if set, signs each request before it is sent.
+	Signer Signer
+
+	// Client sends the requests. If nil, http.DefaultClient is used.
+	Client *http.Client
+
+	// MaxRetries is how many times a failed delivery is retried. The zero
+	// value means 3; use a negative value to disable retries.
+	MaxRetries int
+
+	// Backoff is the wait before the first retry; it doubles on each
+	// subsequent retry. The zero value means one second.
+	Backoff time.Duration
+
+	// ErrorLog receives delivery errors from [Notifier.Run]. If nil, the
+	// standard logger is used.
+	ErrorLog *log.Logger
+
+	// Now returns the current time. If nil, time.Now is used.
+	Now func() time.Time
+}
+
+// Event is the JSON payload posted for a holiday or closure.
+type Event struct {
+	Date      string `json:"date"` // YYYY-MM-DD
+	Name      string `json:"name,omitempty"`
+	Kind      string `json:"kind"`       // KindHoliday or KindClosure
+	DaysUntil int    `json:"days_until"` // calendar days from the notification date
+}
+
+// Signer signs webhook requests so receivers can verify their origin.
+type Signer interface {
+	// Sign adds authentication to req, whose body is body.
+	Sign(req *http.Request, body []byte) error
+}
+
+// HMACSigner signs requests with an HMAC-SHA256 of the body, sent as
+// "sha256=<hex>" in Header.
+type HMACSigner struct {
+	Key []byte
+
+	// Header is the signature header. The zero value means
+	// "X-Signature-256".
+	Header string
+}
+
+// Sign implements [Signer].
+func (s HMACSigner) Sign(req *http.Request, body []byte) error {
+	header := s.Header
+	if header == "" {
+		header = "X-Signature-256"
+	}
+	mac := hmac.New(sha256.New, s.Key)
+	mac.Write(body)
+	req.Header.Set(header, "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	return nil
+}
+
+// calendar is the subset of [jpholiday.Calendar] used by the notifier.
+type calendar interface {
+	HolidayName(t time.Time) string
+	IsClosure(t time.Time) bool
+	NextBusinessDay(t time.Time) (time.Time, bool)
+}
+
+func (o Options) calendar() calendar {
+	if o.Calendar != nil {
+		return o.Calendar
+	}
+	return jpholiday.Default()
+}
+
+// Notifier sends webhook events ahead of holidays and closures. It is safe
+// for concurrent use.
+type Notifier struct {
+	opts   Options
+	cal    calendar
+	client *http.Client
+	now    func() time.Time
+
+	mu    sync.Mutex                          // guards state
+	state map[string]map[string]deliveryState // by event date and URL
+}
+
+// deliveryState is the progress of an event's delivery to one URL.
+type deliveryState int
+
+const (
+	sending deliveryState = iota + 1 // claimed by a running Check
+	sent                             // delivered
+	failed                           // failed permanently; not attempted again
+)
+
+// New returns a Notifier for the given options.
+func New(opts Options) *Notifier {
+	if opts.LeadDays <= 0 {
+		opts.LeadDays = 1
+	}
+	if opts.MaxRetries == 0 {
+		opts.MaxRetries = 3
+	}
+	if opts.Backoff <= 0 {
+		opts.Backoff = time.Second
+	}
+	client := opts.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	now := opts.Now
+	if now == nil {
+		now = time.Now
+	}
+	return &Notifier{
+		opts:   opts,
+		cal:    opts.calendar(),
+		client: client,
+		now:    now,
+		state:  make(map[string]map[string]deliveryState),
+	}
+}
+
+// Due returns the events to be sent on the current date: the holidays and
+// closures after today and before the LeadDays-th business day after today.
+// Events stay due until their date, so a check missed on the notification
+// date is caught up by the next one.
+func (n *Notifier) Due() []Event {
+	y, m, d := n.now().In(jst).Date()
+	today := time.Date(y, m, d, 0, 0, 0, 0, jst)
+
+	end := today
+	for i := 0; i < n.opts.LeadDays; i++ {
+		next, ok := n.cal.NextBusinessDay(end.AddDate(0, 0, 1))
+		if !ok {
+			return nil
+		}
+		end = next
+	}
+	end = end.In(jst)
+	end = time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, jst)
+
+	var events []Event
+	for t := today.AddDate(0, 0, 1); t.Before(end); t = t.AddDate(0, 0, 1) {
+		days := int(t.Sub(today).Hours() / 24)
+		if name := n.cal.HolidayName(t); name != "" {
+			events = append(events, Event{Date: t.Format(time.DateOnly), Name: name, Kind: KindHoliday, DaysUntil: days})
+		} else if n.cal.IsClosure(t) {
+			events = append(events, Event{Date: t.Format(time.DateOnly), Kind: KindClosure, DaysUntil: days})
+		}
+	}
+	return events
+}
+
+// Check delivers every due event to each URL that has not yet received it.
+// Deliveries that fail after all retries are reported in the returned error
+// and attempted again on the next call; permanent failures, such as a 404
+// response, are reported once and not attempted again. The lock is not held
+// during delivery, so a slow endpoint does not block concurrent calls, which
+// skip the deliveries this call is making.
+func (n *Notifier) Check(ctx context.Context) error {
+	type job struct {
+		date, url string
+		body      []byte
+	}
+	events := n.Due()
+	bodies := make([][]byte, len(events))
+	for i, ev := range events {
+		body, err := json.Marshal(ev)
+		if err != nil {
+			return err
+		}
+		bodies[i] = body
+	}
+
+	n.mu.Lock()
+	n.prune()
+	var jobs []job
+	for i, ev := range events {
+		for _, url := range n.opts.URLs {
+			if n.state[ev.Date][url] != 0 {
+				continue
+			}
+			n.setState(ev.Date, url, sending)
+			jobs = append(jobs, job{ev.Date, url, bodies[i]})
+		}
+	}
+	n.mu.Unlock()
+
+	var errs []error
+	for i, j := range jobs {
+		err := n.deliver(ctx, j.url, j.body)
+		var perm *permanentError
+		n.mu.Lock()
+		switch {
+		case err == nil:
+			n.setState(j.date, j.url, sent)
+		case errors.As(err, &perm):
+			n.setState(j.date, j.url, failed)
+		default:
+			delete(n.state[j.date], j.url)
+		}
+		n.mu.Unlock()
+		if err == nil {
+			continue
+		}
+		errs = append(errs, fmt.Errorf("notify: %s to %s: %w", j.date, j.url, err))
+		if ctx.Err() != nil {
+			// Release the remaining deliveries for the next call.
+			n.mu.Lock()
+			for _, j := range jobs[i+1:] {
+				delete(n.state[j.date], j.url)
+			}
+			n.mu.Unlock()
+			break
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// setState records the delivery state of the event on date to url. n.mu
+// must be held.
+func (n *Notifier) setState(date, url string, state deliveryState) {
+	if n.state[date] == nil {
+		n.state[date] = make(map[string]deliveryState)
+	}
+	n.state[date][url] = state
+}
+
+// Run calls Check immediately and then every interval until ctx is done,
+// logging delivery errors to Options.ErrorLog. It returns ctx.Err(), or
+// an error without calling Check if interval is not positive.
+func (n *Notifier) Run(ctx context.Context, interval time.Duration) error {
+	if interval <= 0 {
+		return fmt.Errorf("notify: non-positive interval %v", interval)
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := n.Check(ctx); err != nil && ctx.Err() == nil {
+			n.logf("%v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (n *Notifier) logf(format string, args ...any) {
+	if n.opts.ErrorLog != nil {
+		n.opts.ErrorLog.Printf(format, args...)
+		return
+	}
+	log.Printf(format, args...)
+}
+
+// deliver POSTs body to url, retrying transport errors, 429, and 5xx
+// responses with exponential backoff.
+func (n *Notifier) deliver(ctx context.Context, url string, body []byte) error {
+	wait := n.opts.Backoff
+	for attempt := 0; ; attempt++ {
+		err := n.post(ctx, url, body)
+		var perm *permanentError
+		if err == nil || errors.As(err, &perm) || attempt >= n.opts.MaxRetries {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return errors.Join(err, ctx.Err())
+		case <-time.After(wait):
+		}
+		wait *= 2
+	}
+}
+
+// permanentError is a delivery failure that retrying will not fix.
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+
+func (e *permanentError) Unwrap() error { return e.err }
+
+func (n *Notifier) post(ctx context.Context, url string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return &permanentError{err}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "jp-holidays-notify")
+	if n.opts.Signer != nil {
+		if err := n.opts.Signer.Sign(req, body); err != nil {
+			return &permanentError{fmt.Errorf("sign: %w", err)}
+		}
+	}
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return nil
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	default:
+		return &permanentError{fmt.Errorf("unexpected status %s", resp.Status)}
+	}
+}
+
+// prune forgets deliveries of events that have passed. n.mu must be held.
+func (n *Notifier) prune() {
+	today := n.now().In(jst).Format(time.DateOnly)
+	for date := range n.state {
+		if date <= today {
+			delete(n.state, date)
+		}
+	}
+}
@@ -0,0 +1,268 @@
+package notify
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+)
+
+// at returns 09:00 JST on the given date.
+func at(y int, m time.Month, d int) func() time.Time {
+	return func() time.Time { return time.Date(y, m, d, 9, 0, 0, 0, jst) }
+}
+
+// recorder is a webhook receiver that records request bodies and answers
+// with the queued statuses, then 200.
+type recorder struct {
+	mu       sync.Mutex
+	statuses []int
+	bodies   []string
+	headers  []http.Header
+}
+
+func (rec *recorder) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	rec.bodies = append(rec.bodies, string(body))
+	rec.headers = append(rec.headers, r.Header.Clone())
+	if len(rec.statuses) > 0 {
+		w.WriteHeader(rec.statuses[0])
+		rec.statuses = rec.statuses[1:]
+	}
+}
+
+func (rec *recorder) requests() int {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	return len(rec.bodies)
+}
+
+func TestDue(t *testing.T) {
+	t.Parallel()
+
+	cal := jpholiday.New()
+	cal.AddClosure(time.Date(2026, time.May, 7, 0, 0, 0, 0, jst))
+
+	tests := []struct {
+		name string
+		now  func() time.Time
+		lead int
+		want []string
+	}{
+		{"day before Golden Week", at(2026, time.May, 1), 0, []string{"2026-05-03", "2026-05-04", "2026-05-05", "2026-05-06", "2026-05-07"}},
+		{"two business days ahead", at(2026, time.April, 30), 2, []string{"2026-05-03", "2026-05-04", "2026-05-05", "2026-05-06", "2026-05-07"}},
+		{"not yet", at(2026, time.April, 30), 1, nil},
+		{"weekend only", at(2026, time.May, 15), 1, nil},
+		{"caught up on a holiday", at(2026, time.May, 4), 1, []string{"2026-05-05", "2026-05-06", "2026-05-07"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			n := New(Options{Calendar: cal, LeadDays: tt.lead, Now: tt.now})
+			var got []string
+			for _, ev := range n.Due() {
+				got = append(got, ev.Date)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Due() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	n := New(Options{Calendar: cal, Now: at(2026, time.May, 1)})
+	events := n.Due()
+	if want := (Event{Date: "2026-05-03", Name: "憲法記念日", Kind: KindHoliday, DaysUntil: 2}); events[0] != want {
+		t.Errorf("holiday event = %+v, want %+v", events[0], want)
+	}
+	if want := (Event{Date: "2026-05-07", Kind: KindClosure, DaysUntil: 6}); events[4] != want {
+		t.Errorf("closure event = %+v, want %+v", events[4], want)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	t.Parallel()
+
+	var a, b recorder
+	srvA, srvB := httptest.NewServer(&a), httptest.NewServer(&b)
+	defer srvA.Close()
+	defer srvB.Close()
+
+	key := []byte("secret")
+	now := at(2026, time.July, 17) // Friday before 海の日
+	n := New(Options{URLs: []string{srvA.URL, srvB.URL}, Signer: HMACSigner{Key: key}, Now: now})
+
+	if err := n.Check(context.Background()); err != nil {
+		t.Fatalf("Check error: %v", err)
+	}
+	if err := n.Check(context.Background()); err != nil {
+		t.Fatalf("second Check error: %v", err)
+	}
+	for _, rec := range []*recorder{&a, &b} {
+		if rec.requests() != 1 {
+			t.Fatalf("got %d requests, want 1 (events are sent once)", rec.requests())
+		}
+		var ev Event
+		if err := json.Unmarshal([]byte(rec.bodies[0]), &ev); err != nil {
+			t.Fatalf("unmarshal: %v", err)
+		}
+		if want := (Event{Date: "2026-07-20", Name: "海の日", Kind: KindHoliday, DaysUntil: 3}); ev != want {
+			t.Errorf("event = %+v, want %+v", ev, want)
+		}
+		if ct := rec.headers[0].Get("Content-Type"); ct != "application/json" {
+			t.Errorf("Content-Type = %q", ct)
+		}
+		mac := hmac.New(sha256.New, key)
+		mac.Write([]byte(rec.bodies[0]))
+		if got, want := rec.headers[0].Get("X-Signature-256"), "sha256="+hex.EncodeToString(mac.Sum(nil)); got != want {
+			t.Errorf("signature = %q, want %q", got, want)
+		}
+	}
+}
+
+func TestCheck_Retry(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		statuses   []int
+		maxRetries int
+		wantCalls  int
+		wantErr    string
+		wantResent bool
+	}{
+		{"recovers", []int{503, 429}, 0, 3, "", false},
+		{"exhausted", []int{500, 500, 500}, 2, 3, "500", true},
+		{"disabled", []int{502}, -1, 1, "502", true},
+		{"permanent", []int{400}, 0, 1, "400", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rec := &recorder{statuses: tt.statuses}
+			srv := httptest.NewServer(rec)
+			defer srv.Close()
+
+			n := New(Options{
+				URLs:       []string{srv.URL},
+				MaxRetries: tt.maxRetries,
+				Backoff:    time.Millisecond,
+				Now:        at(2026, time.July, 17),
+			})
+			err := n.Check(context.Background())
+			if rec.requests() != tt.wantCalls {
+				t.Errorf("got %d requests, want %d", rec.requests(), tt.wantCalls)
+			}
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Check error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) || !strings.Contains(err.Error(), "2026-07-20") {
+				t.Fatalf("Check error = %v, want containing %q", err, tt.wantErr)
+			}
+
+			// Failed events are attempted again on the next check, unless
+			// the failure is permanent.
+			if err := n.Check(context.Background()); err != nil {
+				t.Errorf("second Check error: %v", err)
+			}
+			want := tt.wantCalls
+			if tt.wantResent {
+				want++
+			}
+			if rec.requests() != want {
+				t.Errorf("got %d requests after the second check, want %d", rec.requests(), want)
+			}
+		})
+	}
+}
+
+func TestCheck_SlowEndpoint(t *testing.T) {
+	t.Parallel()
+
+	received, release := make(chan struct{}), make(chan struct{})
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			close(received)
+		}
+		<-release
+	}))
+	defer srv.Close()
+
+	n := New(Options{URLs: []string{srv.URL}, Now: at(2026, time.July, 17)})
+	done := make(chan error, 1)
+	go func() { done <- n.Check(context.Background()) }()
+	<-received
+
+	// A concurrent check neither waits for the slow delivery nor repeats it.
+	second := make(chan error, 1)
+	go func() { second <- n.Check(context.Background()) }()
+	select {
+	case err := <-second:
+		if err != nil {
+			t.Errorf("second Check error: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("second Check blocked by a slow delivery")
+	}
+
+	close(release)
+	if err := <-done; err != nil {
+		t.Errorf("Check error: %v", err)
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("got %d requests, want 1", got)
+	}
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	rec := &recorder{}
+	srv := httptest.NewServer(rec)
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	n := New(Options{URLs: []string{srv.URL}, Now: at(2026, time.July, 17)})
+	if err := n.Run(ctx, time.Millisecond); err != context.DeadlineExceeded {
+		t.Errorf("Run error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if rec.requests() != 1 {
+		t.Errorf("got %d requests, want 1", rec.requests())
+	}
+}
+
+func TestRun_NonPositiveInterval(t *testing.T) {
+	t.Parallel()
+
+	rec := &recorder{}
+	srv := httptest.NewServer(rec)
+	defer srv.Close()
+
+	n := New(Options{URLs: []string{srv.URL}, Now: at(2026, time.July, 17)})
+	for _, interval := range []time.Duration{0, -time.Second} {
+		if err := n.Run(context.Background(), interval); err == nil {
+			t.Errorf("Run(%v): expected error", interval)
+		}
+	}
+	if rec.requests() != 0 {
+		t.Errorf("got %d requests, want 0", rec.requests())
+	}
+}
@@ -0,0 +1,94 @@
+package jpholiday
+
+import "time"
+
+// Observance is a widely observed day that is not a statutory holiday, such
+// as お盆 or 大晦日. See [Calendar.SetObservances].
+type Observance struct {
+	Date time.Time // The date of the observance (midnight UTC).
+	Name string    // The Japanese name of the observance (e.g., "七夕").
+}
+
+// observances lists the observance days in date order. お盆 spans four days.
+var observances = []struct {
+	month time.Month
+	day   int
+	name  string
+}{
+	{time.July, 7, "七夕"},
+	{time.August, 13, "お盆"},
+	{time.August, 14, "お盆"},
+	{time.August, 15, "お盆"},
+	{time.August, 16, "お盆"},
+	{time.November, 15, "七五三"},
+	{time.December, 31, "大晦日"},
+}
+
+// observanceEnglishNames are the English names of observances, used with
+// [LocaleEnglish].
+var observanceEnglishNames = map[string]string{
+	"七夕":  "Tanabata",
+	"お盆":  "Obon",
+	"七五三": "Shichi-Go-San",
+	"大晦日": "New Year's Eve",
+}
+
+// SetObservances enables or disables the observances layer: 七夕 (July 7),
+// お盆 (August 13–16), 七五三 (November 15), and 大晦日 (December 31).
+// Observances are reported only by [Calendar.ObservanceName] and
+// [Calendar.ObservancesInYear]; they are never holidays and do not affect
+// business days. The layer is disabled by default.
+func (c *Calendar) SetObservances(enabled bool) {
+	c.update(func(s *snapshot) { s.observances = enabled })
+}
+
+// ObservancesEnabled reports whether the calendar's observances layer is
+// enabled.
+func (c *Calendar) ObservancesEnabled() bool {
+	return c.load().observances
+}
+
+// ObservanceName returns the name of the observance on the given date
+// (interpreted in JST), or an empty string if there is none or the
+// observances layer is disabled.
+func (c *Calendar) ObservanceName(t time.Time) string {
+	s := c.load()
+	if !s.observances {
+		return ""
+	}
+	_, m, day := dateFromTime(t).ymd()
+	for _, o := range observances {
+		if o.month == m && o.day == day {
+			return s.observanceName(o.name)
+		}
+	}
+	return ""
+}
+
+// ObservancesInYear returns the observances in the given year, sorted by
+// date, or nil if the observances layer is disabled.
+func (c *Calendar) ObservancesInYear(year int) []Observance {
+	s := c.load()
+	if !s.observances {
+		return nil
+	}
+	result := make([]Observance, len(observances))
+	for i, o := range observances {
+		result[i] = Observance{Date: newDate(year, o.month, o.day).toTime(), Name: s.observanceName(o.name)}
+	}
+	return result
+}
+
+// observanceName translates an observance name into the snapshot's locale.
+func (s *snapshot) observanceName(name string) string {
+	return translate(s.locale, name, func(name string) string { return observanceEnglishNames[name] })
+}
+
+// SetObservances enables or disables the observances layer on the default calendar.
+func SetObservances(enabled bool) { defaultCal.SetObservances(enabled) }
+
+// ObservanceName returns the default calendar's observance name for the date.
+func ObservanceName(t time.Time) string { return defaultCal.ObservanceName(t) }
+
+// ObservancesInYear returns the default calendar's observances in the given year.
+func ObservancesInYear(year int) []Observance { return defaultCal.ObservancesInYear(year) }
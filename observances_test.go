@@ -0,0 +1,78 @@
+package jpholiday_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/rabitt1ove/jp-holidays"
+)
+
+func TestObservances(t *testing.T) {
+	t.Parallel()
+
+	cal := New()
+	if cal.ObservancesEnabled() {
+		t.Fatal("observances should be disabled by default")
+	}
+	if got := cal.ObservanceName(d(2026, time.August, 13)); got != "" {
+		t.Errorf("ObservanceName while disabled = %q, want empty", got)
+	}
+	if got := cal.ObservancesInYear(2026); got != nil {
+		t.Errorf("ObservancesInYear while disabled = %v, want nil", got)
+	}
+
+	cal.SetObservances(true)
+	tests := []struct {
+		date time.Time
+		want string
+	}{
+		{d(2026, time.July, 7), "七夕"},
+		{d(2026, time.August, 13), "お盆"},
+		{d(2026, time.August, 16), "お盆"},
+		{d(2026, time.August, 17), ""},
+		{d(2026, time.November, 15), "七五三"},
+		{d(2026, time.December, 31), "大晦日"},
+		{d(2026, time.January, 1), ""},
+	}
+	for _, tt := range tests {
+		if got := cal.ObservanceName(tt.date); got != tt.want {
+			t.Errorf("ObservanceName(%s) = %q, want %q", tt.date.Format(time.DateOnly), got, tt.want)
+		}
+	}
+
+	// Observances are not holidays and do not close business.
+	if cal.IsHoliday(d(2026, time.August, 13)) || !cal.IsBusinessDay(d(2026, time.August, 13)) {
+		t.Error("お盆 should not be a holiday")
+	}
+
+	got := cal.ObservancesInYear(2026)
+	if len(got) != 7 || !got[0].Date.Equal(d(2026, time.July, 7)) || got[6].Name != "大晦日" {
+		t.Errorf("ObservancesInYear(2026) = %v", got)
+	}
+}
+
+func TestObservances_English(t *testing.T) {
+	t.Parallel()
+
+	cal := New()
+	cal.SetObservances(true)
+	if err := cal.SetLocale(LocaleEnglish); err != nil {
+		t.Fatal(err)
+	}
+	if got := cal.ObservanceName(d(2026, time.August, 14)); got != "Obon" {
+		t.Errorf("ObservanceName = %q, want Obon", got)
+	}
+}
+
+func TestObservances_Config(t *testing.T) {
+	t.Parallel()
+
+	cal := New()
+	if err := cal.LoadConfig(strings.NewReader("observances: true\n")); err != nil {
+		t.Fatalf("LoadConfig error: %v", err)
+	}
+	if got := cal.ObservanceName(d(2026, time.July, 7)); got != "七夕" {
+		t.Errorf("ObservanceName = %q, want 七夕", got)
+	}
+}
@@ -0,0 +1,136 @@
+package jpholiday
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"time"
+)
+
+// DatasetPatch extends a calendar's dataset with newly announced years of
+// national holidays, ahead of a library release. As JSON:
+//
+//	{"holidays": [
+//	  {"date": "2028-01-01", "name": "元日"},
+//	  {"date": "2028-01-10", "name": "成人の日"}
+//	]}
+//
+// A patch lists every holiday of the years it adds, like a year of the
+// Cabinet Office CSV.
+type DatasetPatch struct {
+	Holidays []ConfigHoliday `json:"holidays"`
+}
+
+// ApplyPatch appends the years in p to the calendar's dataset. Unlike
+// custom holidays, patched holidays extend the dataset itself: they are
+// reported by [Calendar.DatasetRange] and replaced by a later
+// [Calendar.RefreshFromOfficialSource].
+//
+// The patch is rejected, leaving the calendar unchanged, if it conflicts
+// with the dataset: a date in a year already covered must match an
+// existing holiday and its name, a date may not be listed twice with
+// different names, and the added years must follow the dataset's last
+// year without a gap. Applying the same patch again is a no-op.
+func (c *Calendar) ApplyPatch(p DatasetPatch) error {
+	// Extend the dataset under the lock, so that a concurrent refresh is
+	// neither undone nor lost.
+	return c.tryUpdate(func(s *snapshot) error {
+		data, err := patchData(s.data(), p.Holidays)
+		if err != nil {
+			return fmt.Errorf("jpholiday: patch: %w", err)
+		}
+		if data != nil {
+			s.setOfficial(data)
+		}
+		return nil
+	})
+}
+
+// LoadPatch reads a JSON [DatasetPatch] from r and applies it with
+// [Calendar.ApplyPatch]. Unknown keys are rejected.
+func (c *Calendar) LoadPatch(r io.Reader) error {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return fmt.Errorf("jpholiday: patch: %w", err)
+	}
+	var p DatasetPatch
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&p); err != nil {
+		return fmt.Errorf("jpholiday: patch: %w", err)
+	}
+	return c.ApplyPatch(p)
+}
+
+// DatasetRange returns the first and last dates covered by the calendar's
+// dataset, including years added by [Calendar.ApplyPatch] or
+// [Calendar.RefreshFromOfficialSource].
+func (c *Calendar) DatasetRange() (first, last time.Time) {
+	data := c.load().data()
+	return data.first.toTime(), data.last.toTime()
+}
+
+// patchData returns cur extended with holidays, or nil if holidays add
+// nothing to it.
+func patchData(cur *holidayData, holidays []ConfigHoliday) (*holidayData, error) {
+	added := make(map[date]string)
+	for i, h := range holidays {
+		d, err := parseConfigDate(h.Date)
+		if err != nil {
+			return nil, fmt.Errorf("holidays[%d]: %w", i, err)
+		}
+		if h.Name == "" {
+			return nil, fmt.Errorf("holidays[%d]: name is required", i)
+		}
+		if prev, ok := added[d]; ok && prev != h.Name {
+			return nil, fmt.Errorf("holidays[%d]: %s listed as both %q and %q", i, h.Date, prev, h.Name)
+		}
+		if d.after(cur.last) {
+			added[d] = h.Name
+			continue
+		}
+		switch name, ok := cur.names[d]; {
+		case !ok && d.before(cur.first):
+			return nil, fmt.Errorf("holidays[%d]: %s is before the dataset", i, h.Date)
+		case !ok:
+			return nil, fmt.Errorf("holidays[%d]: %s is not a holiday in the dataset, which covers %d", i, h.Date, d.year())
+		case name != h.Name:
+			return nil, fmt.Errorf("holidays[%d]: %s conflicts with %q in the dataset", i, h.Date, name)
+		}
+	}
+	if len(added) == 0 {
+		return nil, nil
+	}
+
+	years := make(map[int]bool)
+	for d := range added {
+		years[d.year()] = true
+	}
+	lastYear := slices.Max(slices.Collect(maps.Keys(years)))
+	for year := cur.last.year() + 1; year < lastYear; year++ {
+		if !years[year] {
+			return nil, fmt.Errorf("no holidays for %d, between the dataset and %d", year, lastYear)
+		}
+	}
+
+	names := maps.Clone(cur.names)
+	maps.Copy(names, added)
+	data := &holidayData{
+		names: names,
+		dates: sortedDates(names),
+		first: cur.first,
+		last:  newDate(lastYear, time.December, 31),
+		meta:  cur.meta,
+	}
+	data.meta.Rows = len(names)
+	return data, nil
+}
+
+// ApplyPatch appends the years in p to the default calendar's dataset.
+func ApplyPatch(p DatasetPatch) error { return defaultCal.ApplyPatch(p) }
+
+// LoadPatch reads a JSON patch from r and applies it to the default calendar.
+func LoadPatch(r io.Reader) error { return defaultCal.LoadPatch(r) }
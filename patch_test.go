@@ -0,0 +1,168 @@
+package jpholiday_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/rabitt1ove/jp-holidays"
+)
+
+// nextYearPatch returns a patch for the year after the built-in dataset.
+func nextYearPatch() (year int, patch string) {
+	_, last := DatasetRange()
+	year = last.Year() + 1
+	return year, fmt.Sprintf(`{"holidays": [
+		{"date": "%[1]d-01-01", "name": "元日"},
+		{"date": "%[1]d-01-10", "name": "成人の日"},
+		{"date": "%[1]d-02-11", "name": "建国記念の日"}
+	]}`, year)
+}
+
+func TestLoadPatch(t *testing.T) {
+	t.Parallel()
+
+	year, patch := nextYearPatch()
+	cal := New()
+	cal.AddCustomHoliday(d(year, time.June, 1), "創立記念日")
+	if err := cal.LoadPatch(strings.NewReader(patch)); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := cal.HolidayName(d(year, time.January, 10)); got != "成人の日" {
+		t.Errorf("HolidayName = %q, want 成人の日", got)
+	}
+	if got := cal.HolidaysInYear(year); len(got) != 4 {
+		t.Errorf("HolidaysInYear(%d) = %v, want 3 patched and 1 custom", year, got)
+	}
+	if h, ok := cal.NextHoliday(d(year, time.January, 1)); !ok || !h.Date.Equal(d(year, time.January, 10)) {
+		t.Errorf("NextHoliday = %v, %v", h, ok)
+	}
+	if _, last := cal.DatasetRange(); !last.Equal(d(year, time.December, 31)) {
+		t.Errorf("DatasetRange last = %v", last)
+	}
+	if got := cal.DatasetInfo().Rows; got != DatasetInfo().Rows+3 {
+		t.Errorf("Rows = %d, want %d", got, DatasetInfo().Rows+3)
+	}
+	if got := EnglishName(cal.HolidayName(d(year, time.January, 1))); got != "New Year's Day" {
+		t.Errorf("EnglishName = %q", got)
+	}
+	if IsHoliday(d(year, time.January, 10)) {
+		t.Error("LoadPatch changed the default calendar")
+	}
+
+	// Reapplying the patch, or a patch that repeats known holidays, is a no-op.
+	if err := cal.LoadPatch(strings.NewReader(patch)); err != nil {
+		t.Errorf("reapplying: %v", err)
+	}
+	if err := cal.ApplyPatch(DatasetPatch{Holidays: []ConfigHoliday{{Date: "2026-01-01", Name: "元日"}}}); err != nil {
+		t.Errorf("known holiday: %v", err)
+	}
+
+	// The following year builds on the patched one.
+	next := DatasetPatch{Holidays: []ConfigHoliday{{Date: fmt.Sprintf("%d-01-01", year+1), Name: "元日"}}}
+	if err := cal.ApplyPatch(next); err != nil {
+		t.Errorf("following year: %v", err)
+	}
+}
+
+func TestApplyPatch_Conflicts(t *testing.T) {
+	t.Parallel()
+
+	year, _ := nextYearPatch()
+	tests := []struct {
+		name     string
+		holidays []ConfigHoliday
+		want     string
+	}{
+		{"renamed", []ConfigHoliday{{Date: "2026-01-01", Name: "正月"}}, `conflicts with "元日"`},
+		{"covered year", []ConfigHoliday{{Date: "2026-06-01", Name: "x"}}, "not a holiday"},
+		{"before", []ConfigHoliday{{Date: "1900-01-01", Name: "元日"}}, "before the dataset"},
+		{"gap", []ConfigHoliday{{Date: fmt.Sprintf("%d-01-01", year+1), Name: "元日"}}, fmt.Sprintf("no holidays for %d", year)},
+		{"duplicate", []ConfigHoliday{
+			{Date: fmt.Sprintf("%d-01-01", year), Name: "元日"},
+			{Date: fmt.Sprintf("%d-01-01", year), Name: "正月"},
+		}, "listed as both"},
+		{"bad date", []ConfigHoliday{{Date: "soon", Name: "x"}}, "holidays[0]"},
+		{"missing name", []ConfigHoliday{{Date: fmt.Sprintf("%d-01-01", year)}}, "name is required"},
+	}
+	for _, tt := range tests {
+		cal := New()
+		err := cal.ApplyPatch(DatasetPatch{Holidays: tt.holidays})
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%s: err = %v, want %q", tt.name, err, tt.want)
+		}
+		if cal.DatasetInfo() != DatasetInfo() {
+			t.Errorf("%s: calendar changed after failed patch", tt.name)
+		}
+	}
+
+	if err := New().LoadPatch(strings.NewReader(`{"holiday": []}`)); err == nil {
+		t.Error("LoadPatch accepted an unknown key")
+	}
+}
+
+func TestConfig_Patch(t *testing.T) {
+	t.Parallel()
+
+	year, _ := nextYearPatch()
+	cal := New()
+	err := cal.LoadConfig(strings.NewReader(fmt.Sprintf("patch:\n  - date: %d-01-01\n    name: 元日\n", year)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cal.IsHoliday(d(year, time.January, 1)) {
+		t.Errorf("%d-01-01 should be a holiday after the config patch", year)
+	}
+}
+
+func TestConfig_PatchRecurring(t *testing.T) {
+	t.Parallel()
+
+	year, _ := nextYearPatch()
+	cal := New()
+	err := cal.ApplyConfig(Config{
+		Patch:     []ConfigHoliday{{Date: fmt.Sprintf("%d-01-01", year), Name: "元日"}},
+		Recurring: []ConfigRecurring{{Month: 6, Day: 1, Name: "創立記念日"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, y := range []int{year - 1, year} {
+		if got := cal.HolidayName(d(y, time.June, 1)); got != "創立記念日" {
+			t.Errorf("HolidayName(%d-06-01) = %q, want 創立記念日", y, got)
+		}
+	}
+}
+
+func TestApplyPatch_ExtendsPresets(t *testing.T) {
+	t.Parallel()
+
+	year, patch := nextYearPatch()
+
+	bank := NewBankCalendar()
+	if err := bank.LoadPatch(strings.NewReader(patch)); err != nil {
+		t.Fatal(err)
+	}
+	for _, day := range []time.Time{d(year, time.January, 2), d(year, time.January, 3), d(year, time.December, 31)} {
+		if bank.IsBusinessDay(day) {
+			t.Errorf("bank calendar: %s is a business day after the patch", day.Format(time.DateOnly))
+		}
+	}
+
+	gov := New()
+	if err := gov.ApplyConfig(Config{Preset: PresetGovernment}); err != nil {
+		t.Fatal(err)
+	}
+	if err := gov.LoadPatch(strings.NewReader(patch)); err != nil {
+		t.Fatal(err)
+	}
+	// The period starting in the last year of the dataset runs into the
+	// patched year.
+	for _, day := range []time.Time{d(year, time.January, 2), d(year, time.December, 29)} {
+		if gov.IsBusinessDay(day) {
+			t.Errorf("government calendar: %s is a business day after the patch", day.Format(time.DateOnly))
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package jpholiday
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Recurrence is a date that repeats every week or every month, such as a
+// billing date or a reminder, before adjustment for holidays. Unlike a
+// [RecurringSpec], which counts business days, a Recurrence names calendar
+// dates and [Calendar.ExpandRecurrence] rolls those that are not business
+// days.
+type Recurrence struct {
+	// Weekly repeats the date every week on Weekday. Otherwise it repeats
+	// every month on Day.
+	Weekly  bool
+	Weekday time.Weekday
+
+	// Day is the day of the month, from 1. A day past the end of a month,
+	// such as 31 in June, means the last day of the month (末日).
+	Day int
+}
+
+// ParseRecurrence parses a recurrence written in English or Japanese:
+//
+//	every Monday              毎週月曜日
+//	25th of every month       毎月25日
+//	last day of every month   毎月末日
+//
+// Weekdays may also be abbreviated ("every mon", "毎週月曜"), and English is
+// matched case-insensitively.
+func ParseRecurrence(s string) (Recurrence, error) {
+	s = strings.Map(halfWidthDigit, strings.ToLower(strings.TrimSpace(s)))
+	if rest, ok := strings.CutPrefix(s, "毎週"); ok {
+		rest = strings.TrimSuffix(strings.TrimSuffix(rest, "日"), "曜")
+		if wd, ok := weekdaysByName[rest]; ok {
+			return Recurrence{Weekly: true, Weekday: wd}, nil
+		}
+	}
+	if rest, ok := strings.CutPrefix(s, "every "); ok {
+		if wd, ok := weekdaysByName[strings.TrimSpace(rest)]; ok {
+			return Recurrence{Weekly: true, Weekday: wd}, nil
+		}
+	}
+
+	var day string
+	switch {
+	case s == "毎月末日" || s == "毎月末" || s == "last day of every month":
+		return Recurrence{Day: 31}, nil
+	case strings.HasPrefix(s, "毎月") && strings.HasSuffix(s, "日"):
+		day = strings.TrimSuffix(strings.TrimPrefix(s, "毎月"), "日")
+	case strings.HasSuffix(s, " of every month"):
+		day = strings.TrimSuffix(s, " of every month")
+		for _, suffix := range []string{"st", "nd", "rd", "th"} {
+			day = strings.TrimSuffix(day, suffix)
+		}
+	default:
+		return Recurrence{}, fmt.Errorf("jpholiday: unsupported recurrence %q", s)
+	}
+	n, err := strconv.Atoi(day)
+	if err != nil || n < 1 || n > 31 {
+		return Recurrence{}, fmt.Errorf("jpholiday: invalid day of month in recurrence %q", s)
+	}
+	return Recurrence{Day: n}, nil
+}
+
+// ExpandRecurrence returns the occurrences of rule from from through to
+// (interpreted in JST), each moved by roll when it is not a business day,
+// in chronological order. An occurrence is included if its date before
+// adjustment is in the range, so a billing date always belongs to its
+// period even if it rolls out of it; occurrences that roll onto the same
+// day are returned once, and those that cannot be adjusted are dropped.
+// The zero [Roll] moves occurrences early, like [Calendar.ScheduleMonthly].
+func (c *Calendar) ExpandRecurrence(rule Recurrence, from, to time.Time, roll Roll) []time.Time {
+	start, end := dateFromTime(from), dateFromTime(to)
+	if end.before(start) || (!rule.Weekly && rule.Day < 1) {
+		return nil
+	}
+
+	var nominal []date
+	if rule.Weekly {
+		first := start.addDays((int(rule.Weekday) - int(start.weekday()) + 7) % 7)
+		for d := first; !d.after(end); d = d.addDays(7) {
+			nominal = append(nominal, d)
+		}
+	} else {
+		y, m, _ := start.ymd()
+		for i := 0; ; i++ {
+			last := time.Date(y, m+time.Month(i)+1, 0, 0, 0, 0, 0, time.UTC)
+			d := newDate(last.Year(), last.Month(), min(rule.Day, last.Day()))
+			if d.after(end) {
+				break
+			}
+			if !d.before(start) {
+				nominal = append(nominal, d)
+			}
+		}
+	}
+
+	s := c.load()
+	adjusted := make([]date, 0, len(nominal))
+	for _, d := range nominal {
+		if a, ok := s.adjust(d, roll); ok {
+			adjusted = append(adjusted, a)
+		}
+	}
+	slices.Sort(adjusted)
+	adjusted = slices.Compact(adjusted)
+	var result []time.Time
+	for _, d := range adjusted {
+		result = append(result, d.toTime())
+	}
+	return result
+}
+
+// ExpandRecurrence returns the holiday-adjusted occurrences of rule on the default calendar.
+func ExpandRecurrence(rule Recurrence, from, to time.Time, roll Roll) []time.Time {
+	return defaultCal.ExpandRecurrence(rule, from, to, roll)
+}
@@ -0,0 +1,96 @@
+package jpholiday_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	. "github.com/rabitt1ove/jp-holidays"
+)
+
+func TestParseRecurrence(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in   string
+		want Recurrence
+	}{
+		{"every Monday", Recurrence{Weekly: true, Weekday: time.Monday}},
+		{"Every fri", Recurrence{Weekly: true, Weekday: time.Friday}},
+		{"毎週月曜日", Recurrence{Weekly: true, Weekday: time.Monday}},
+		{"毎週水曜", Recurrence{Weekly: true, Weekday: time.Wednesday}},
+		{"25th of every month", Recurrence{Day: 25}},
+		{"1st of every month", Recurrence{Day: 1}},
+		{"毎月25日", Recurrence{Day: 25}},
+		{"毎月２５日", Recurrence{Day: 25}},
+		{"毎月末日", Recurrence{Day: 31}},
+		{"last day of every month", Recurrence{Day: 31}},
+	}
+	for _, tt := range tests {
+		got, err := ParseRecurrence(tt.in)
+		if err != nil || got != tt.want {
+			t.Errorf("ParseRecurrence(%q) = %+v, %v, want %+v", tt.in, got, err, tt.want)
+		}
+	}
+
+	for _, in := range []string{"", "every day", "毎週祝日", "32nd of every month", "毎月0日", "毎年1月1日"} {
+		if _, err := ParseRecurrence(in); err == nil {
+			t.Errorf("ParseRecurrence(%q): want error", in)
+		}
+	}
+}
+
+func TestExpandRecurrence(t *testing.T) {
+	t.Parallel()
+
+	cal := New()
+	tests := []struct {
+		name     string
+		rule     Recurrence
+		from, to time.Time
+		roll     Roll
+		want     []time.Time
+	}{
+		{
+			"25th, paid early", Recurrence{Day: 25}, d(2026, time.April, 1), d(2026, time.July, 31), RollPreceding,
+			// 4/25 and 7/25 are Saturdays.
+			[]time.Time{d(2026, time.April, 24), d(2026, time.May, 25), d(2026, time.June, 25), d(2026, time.July, 24)},
+		},
+		{
+			"25th, following", Recurrence{Day: 25}, d(2026, time.July, 1), d(2026, time.July, 31), RollFollowing,
+			[]time.Time{d(2026, time.July, 27)},
+		},
+		{
+			"end of month", Recurrence{Day: 31}, d(2026, time.January, 1), d(2026, time.March, 31), RollPreceding,
+			// 1/31 is a Saturday.
+			[]time.Time{d(2026, time.January, 30), d(2026, time.February, 27), d(2026, time.March, 31)},
+		},
+		{
+			"Mondays over Golden Week", Recurrence{Weekly: true, Weekday: time.Monday}, d(2026, time.April, 27), d(2026, time.May, 11), RollFollowing,
+			// 5/4 (みどりの日) rolls past the holidays to 5/7.
+			[]time.Time{d(2026, time.April, 27), d(2026, time.May, 7), d(2026, time.May, 11)},
+		},
+		{"empty range", Recurrence{Day: 1}, d(2026, time.May, 2), d(2026, time.May, 31), RollPreceding, nil},
+		{"reversed range", Recurrence{Day: 1}, d(2026, time.June, 1), d(2026, time.May, 1), RollPreceding, nil},
+	}
+	for _, tt := range tests {
+		got := cal.ExpandRecurrence(tt.rule, tt.from, tt.to, tt.roll)
+		if !slices.EqualFunc(got, tt.want, time.Time.Equal) {
+			t.Errorf("%s: ExpandRecurrence = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestExpandRecurrence_Collapse(t *testing.T) {
+	t.Parallel()
+
+	cal := New()
+	for day := d(2026, time.December, 28); !day.After(d(2027, time.January, 8)); day = day.AddDate(0, 0, 1) {
+		cal.AddClosure(day)
+	}
+	got := cal.ExpandRecurrence(Recurrence{Weekly: true, Weekday: time.Monday}, d(2026, time.December, 28), d(2027, time.January, 11), RollFollowing)
+	want := []time.Time{d(2027, time.January, 12)} // 1/11 is 成人の日
+	if !slices.EqualFunc(got, want, time.Time.Equal) {
+		t.Errorf("ExpandRecurrence = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,78 @@
+package jpholiday
+
+import (
+	"slices"
+	"time"
+)
+
+// RecurringPeriod is the period within which a [RecurringSpec] counts
+// business days.
+type RecurringPeriod int
+
+// Recurring periods.
+const (
+	RecurringMonth RecurringPeriod = iota
+	RecurringQuarter
+)
+
+// RecurringSpec describes a recurring business date, such as "the last
+// business Friday of the month" or "the first business Monday of the
+// quarter".
+type RecurringSpec struct {
+	// Nth selects the occurrence: 1 is the first, 2 the second, and so on;
+	// -1 is the last, -2 the second to last. Zero matches nothing.
+	Nth int
+
+	// Weekdays restricts the matching business days to the given days of
+	// the week. If empty, every business day matches.
+	Weekdays []time.Weekday
+
+	// Period is the period the occurrences are counted in. The zero value
+	// means the month; quarters are calendar quarters (January–March,
+	// April–June, and so on), which are also the fiscal quarters.
+	Period RecurringPeriod
+}
+
+// RecurringBusinessDate returns the business day described by spec in the
+// period containing the given year and month. For example, the last
+// business Friday of June 2026 is
+//
+//	cal.RecurringBusinessDate(jpholiday.RecurringSpec{Nth: -1, Weekdays: []time.Weekday{time.Friday}}, 2026, time.June)
+//
+// Occurrences that fall on a holiday or closure are skipped rather than
+// moved, so "the first business Monday" after a Monday holiday is the
+// following Monday. ok is false if the period has fewer than |spec.Nth|
+// matching business days.
+func (c *Calendar) RecurringBusinessDate(spec RecurringSpec, year int, month time.Month) (time.Time, bool) {
+	if spec.Nth == 0 || month < time.January || month > time.December {
+		return time.Time{}, false
+	}
+	months := time.Month(1)
+	if spec.Period == RecurringQuarter {
+		month, months = (month-1)/3*3+1, 3
+	}
+	end := time.Date(year, month+months, 0, 0, 0, 0, 0, time.UTC) // last day of the period
+	first, last := newDate(year, month, 1), newDate(end.Year(), end.Month(), end.Day())
+
+	s := c.load()
+	d, step, n := first, 1, spec.Nth
+	if n < 0 {
+		d, step, n = last, -1, -n
+	}
+	for ; !d.before(first) && !d.after(last); d = d.addDays(step) {
+		if len(spec.Weekdays) > 0 && !slices.Contains(spec.Weekdays, d.weekday()) {
+			continue
+		}
+		if s.businessDay(d) {
+			if n--; n == 0 {
+				return d.toTime(), true
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
+// RecurringBusinessDate returns the business day described by spec on the default calendar.
+func RecurringBusinessDate(spec RecurringSpec, year int, month time.Month) (time.Time, bool) {
+	return defaultCal.RecurringBusinessDate(spec, year, month)
+}
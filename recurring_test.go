@@ -0,0 +1,77 @@
+package jpholiday_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/rabitt1ove/jp-holidays"
+)
+
+func TestRecurringBusinessDate(t *testing.T) {
+	t.Parallel()
+
+	friday := []time.Weekday{time.Friday}
+	monday := []time.Weekday{time.Monday}
+	tests := []struct {
+		name  string
+		spec  RecurringSpec
+		year  int
+		month time.Month
+		want  time.Time
+	}{
+		{"last business Friday", RecurringSpec{Nth: -1, Weekdays: friday}, 2026, time.June, d(2026, time.June, 26)},
+		{"first business day", RecurringSpec{Nth: 1}, 2026, time.May, d(2026, time.May, 1)},
+		// 2026-05-04 is a holiday, so the first business Monday is 05-11.
+		{"first business Monday skips holiday", RecurringSpec{Nth: 1, Weekdays: monday}, 2026, time.May, d(2026, time.May, 11)},
+		{"last business day", RecurringSpec{Nth: -1}, 2026, time.May, d(2026, time.May, 29)},
+		{"second to last business day", RecurringSpec{Nth: -2}, 2026, time.May, d(2026, time.May, 28)},
+		{"third Wednesday", RecurringSpec{Nth: 3, Weekdays: []time.Weekday{time.Wednesday}}, 2026, time.September, d(2026, time.September, 16)},
+		// 2026-01-05 is the first Monday of Q1; 01-12 is 成人の日.
+		{"first business Monday of quarter", RecurringSpec{Nth: 1, Weekdays: monday, Period: RecurringQuarter}, 2026, time.February, d(2026, time.January, 5)},
+		{"last business day of quarter", RecurringSpec{Nth: -1, Period: RecurringQuarter}, 2026, time.November, d(2026, time.December, 31)},
+		{"last business Friday of Q4 spans year end", RecurringSpec{Nth: -1, Weekdays: friday, Period: RecurringQuarter}, 2026, time.December, d(2026, time.December, 25)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, ok := RecurringBusinessDate(tt.spec, tt.year, tt.month)
+			if !ok || !got.Equal(tt.want) {
+				t.Errorf("RecurringBusinessDate(%+v, %d, %s) = %s, %v; want %s", tt.spec, tt.year, tt.month, got.Format(time.DateOnly), ok, tt.want.Format(time.DateOnly))
+			}
+		})
+	}
+}
+
+func TestRecurringBusinessDate_NotFound(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		spec  RecurringSpec
+		month time.Month
+	}{
+		{"zero Nth", RecurringSpec{}, time.June},
+		{"sixth Friday", RecurringSpec{Nth: 6, Weekdays: []time.Weekday{time.Friday}}, time.June},
+		{"business Sunday", RecurringSpec{Nth: 1, Weekdays: []time.Weekday{time.Sunday}}, time.June},
+		{"invalid month", RecurringSpec{Nth: 1}, 13},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got, ok := RecurringBusinessDate(tt.spec, 2026, tt.month); ok || !got.IsZero() {
+				t.Errorf("RecurringBusinessDate(%+v) = %s, %v; want zero time, false", tt.spec, got, ok)
+			}
+		})
+	}
+}
+
+func TestRecurringBusinessDate_Closure(t *testing.T) {
+	t.Parallel()
+
+	cal := New()
+	cal.AddClosure(d(2026, time.June, 26))
+	got, ok := cal.RecurringBusinessDate(RecurringSpec{Nth: -1, Weekdays: []time.Weekday{time.Friday}}, 2026, time.June)
+	if !ok || !got.Equal(d(2026, time.June, 19)) {
+		t.Errorf("RecurringBusinessDate with closure = %s, %v; want 2026-06-19", got.Format(time.DateOnly), ok)
+	}
+}
@@ -0,0 +1,237 @@
+package jpholiday
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// OfficialCSVURL is the URL of the Cabinet Office holiday CSV
+// (syukujitsu.csv), from which the built-in dataset is generated.
+const OfficialCSVURL = "https://www8.cao.go.jp/chosei/shukujitsu/syukujitsu.csv"
+
+// maxOfficialCSVSize bounds the download; the official CSV is about 20 KB.
+const maxOfficialCSVSize = 1 << 20
+
+// RefreshOptions configures [Calendar.RefreshFromOfficialSource] and
+// [Calendar.RefreshPeriodically].
+type RefreshOptions struct {
+	// URL is the CSV to download. If empty, OfficialCSVURL is used.
+	URL string
+
+	// Client sends the requests. If nil, http.DefaultClient is used.
+	Client *http.Client
+
+	// Decode, if non-nil, wraps the downloaded body to transcode it to
+	// UTF-8. The official CSV is Shift_JIS, which can be decoded with
+	// golang.org/x/text:
+	//
+	//	opts.Decode = func(r io.Reader) io.Reader {
+	//		return transform.NewReader(r, japanese.ShiftJIS.NewDecoder())
+	//	}
+	//
+	// Without it, the body must already be UTF-8, so a download of the
+	// official CSV fails; the package does not decode Shift_JIS itself, as
+	// a newly announced holiday may use any character of JIS X 0208.
+	Decode func(io.Reader) io.Reader
+
+	// OnError, if non-nil, is called by RefreshPeriodically with each
+	// failed refresh. The calendar keeps its previous data on failure.
+	OnError func(error)
+}
+
+// RefreshFromOfficialSource downloads the Cabinet Office holiday CSV and
+// replaces the calendar's built-in dataset with it, so that long-running
+// processes pick up newly announced holidays without a rebuild. The swap
+// is atomic: concurrent queries see either the old or the new dataset.
+// Custom holidays, removals, closures, and sources are kept.
+//
+// The downloaded dataset must cover at least the years of the current
+// one, including years added by [Calendar.ApplyPatch]; otherwise it is
+// rejected as truncated and the calendar is left unchanged. Holiday names
+// not in the built-in dataset have no English translation and are returned
+// in Japanese under [LocaleEnglish].
+func (c *Calendar) RefreshFromOfficialSource(ctx context.Context, opts RefreshOptions) error {
+	url := opts.URL
+	if url == "" {
+		url = OfficialCSVURL
+	}
+	client := opts.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("jpholiday: refresh: %w", err)
+	}
+	req.Header.Set("User-Agent", "jp-holidays")
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("jpholiday: refresh: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("jpholiday: refresh: unexpected status %s", resp.Status)
+	}
+	raw, err := io.ReadAll(io.LimitReader(resp.Body, maxOfficialCSVSize+1))
+	if err != nil {
+		return fmt.Errorf("jpholiday: refresh: %w", err)
+	}
+	if len(raw) > maxOfficialCSVSize {
+		return fmt.Errorf("jpholiday: refresh: CSV larger than %d bytes", maxOfficialCSVSize)
+	}
+
+	sum := sha256.Sum256(raw)
+	meta := DatasetMetadata{
+		SourceURL: url,
+		FetchedAt: time.Now().UTC(),
+		SHA256:    hex.EncodeToString(sum[:]),
+	}
+	if c.load().data().meta.SHA256 == meta.SHA256 {
+		return nil
+	}
+
+	var r io.Reader = bytes.NewReader(raw)
+	switch {
+	case opts.Decode != nil:
+		r = opts.Decode(r)
+	case !utf8.Valid(raw):
+		return fmt.Errorf("jpholiday: refresh: CSV is not UTF-8; set RefreshOptions.Decode to decode Shift_JIS")
+	}
+	data, err := parseOfficialCSV(r, meta)
+	if err != nil {
+		return fmt.Errorf("jpholiday: refresh: %w", err)
+	}
+	// Compare with the dataset under the lock, so that years patched in
+	// since the download are not dropped by the swap.
+	return c.tryUpdate(func(s *snapshot) error {
+		if err := checkCoverage(data, s.data()); err != nil {
+			return fmt.Errorf("jpholiday: refresh: %w", err)
+		}
+		s.setOfficial(data)
+		return nil
+	})
+}
+
+// RefreshPeriodically calls [Calendar.RefreshFromOfficialSource] in a new
+// goroutine, immediately and then every interval, until ctx is done.
+// Failures are passed to opts.OnError and leave the current data in place.
+// It returns an error without starting the goroutine if interval is not
+// positive.
+func (c *Calendar) RefreshPeriodically(ctx context.Context, interval time.Duration, opts RefreshOptions) error {
+	if interval <= 0 {
+		return fmt.Errorf("jpholiday: non-positive refresh interval %v", interval)
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			if err := c.RefreshFromOfficialSource(ctx, opts); err != nil && opts.OnError != nil && ctx.Err() == nil {
+				opts.OnError(err)
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return nil
+}
+
+// DatasetInfo returns metadata describing the calendar's holiday dataset:
+// the built-in one, or the one last loaded by
+// [Calendar.RefreshFromOfficialSource].
+func (c *Calendar) DatasetInfo() DatasetMetadata { return c.load().data().meta }
+
+// parseOfficialCSV parses a UTF-8 holiday CSV in the Cabinet Office format.
+func parseOfficialCSV(r io.Reader, meta DatasetMetadata) (*holidayData, error) {
+	names, err := parseHolidayCSV(r)
+	if err != nil {
+		return nil, err
+	}
+	dates := sortedDates(names)
+	data := &holidayData{
+		names: names,
+		dates: dates,
+		first: newDate(dates[0].year(), time.January, 1),
+		last:  newDate(dates[len(dates)-1].year(), time.December, 31),
+		meta:  meta,
+	}
+	data.meta.Rows = len(names)
+	return data, nil
+}
+
+// checkCoverage returns an error if data does not cover the years of cur.
+func checkCoverage(data, cur *holidayData) error {
+	if data.first.after(cur.first) || data.last.before(cur.last) {
+		return fmt.Errorf("CSV covers %d-%d, less than the current %d-%d",
+			data.first.year(), data.last.year(), cur.first.year(), cur.last.year())
+	}
+	return nil
+}
+
+// parseHolidayCSV parses a UTF-8 holiday CSV in the Cabinet Office format:
+// a header row followed by "YYYY/M/D,name" rows. It returns an error if
+// there are no holidays.
+func parseHolidayCSV(r io.Reader) (map[date]string, error) {
+	cr := csv.NewReader(r)
+	cr.LazyQuotes = true
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("reading header: %w", err)
+	}
+	if len(header) < 2 || !strings.Contains(header[0], "国民の祝日") {
+		return nil, fmt.Errorf("unexpected header %q; is the CSV decoded from Shift_JIS?", header)
+	}
+
+	names := make(map[date]string)
+	for line := 2; ; line++ {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(record) < 2 {
+			return nil, fmt.Errorf("line %d: expected 2 columns, got %d", line, len(record))
+		}
+		dateStr, name := strings.TrimSpace(record[0]), strings.TrimSpace(record[1])
+		if dateStr == "" || name == "" {
+			continue
+		}
+		t, err := time.Parse("2006/1/2", dateStr)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid date %q", line, dateStr)
+		}
+		if !utf8.ValidString(name) {
+			return nil, fmt.Errorf("line %d: name is not valid UTF-8", line)
+		}
+		names[newDate(t.Year(), t.Month(), t.Day())] = name
+	}
+	if len(names) == 0 {
+		return nil, errors.New("no holidays in CSV")
+	}
+	return names, nil
+}
+
+// RefreshFromOfficialSource replaces the default calendar's built-in dataset with the official CSV.
+func RefreshFromOfficialSource(ctx context.Context, opts RefreshOptions) error {
+	return defaultCal.RefreshFromOfficialSource(ctx, opts)
+}
+
+// RefreshPeriodically refreshes the default calendar from the official CSV every interval until ctx is done.
+func RefreshPeriodically(ctx context.Context, interval time.Duration, opts RefreshOptions) error {
+	return defaultCal.RefreshPeriodically(ctx, interval, opts)
+}
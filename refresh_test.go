@@ -0,0 +1,300 @@
+package jpholiday_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/rabitt1ove/jp-holidays"
+)
+
+// officialCSV returns the built-in dataset in the Cabinet Office format,
+// edited by fn.
+func officialCSV(t *testing.T, fn func(string) string) string {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := New().ExportCSV(&buf, CSVOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	return fn(buf.String())
+}
+
+func serveCSV(t *testing.T, body *atomic.Value) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(body.Load().(string)))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestRefreshFromOfficialSource(t *testing.T) {
+	t.Parallel()
+
+	_, last := DatasetRange()
+	next := last.Year() + 1
+	var body atomic.Value
+	body.Store(officialCSV(t, func(s string) string {
+		// Move a holiday, as happened for the 2021 Olympics, and publish
+		// the next year.
+		s = strings.Replace(s, "2026/7/20,海の日", "2026/7/23,海の日", 1)
+		return s + time.Date(next, 1, 1, 0, 0, 0, 0, time.UTC).Format("2006/1/2") + ",元日\r\n"
+	}))
+	srv := serveCSV(t, &body)
+
+	cal := New()
+	cal.AddCustomHoliday(d(2026, time.June, 15), "創立記念日")
+	if err := cal.RefreshFromOfficialSource(context.Background(), RefreshOptions{URL: srv.URL}); err != nil {
+		t.Fatal(err)
+	}
+
+	if cal.IsHoliday(d(2026, time.July, 20)) || cal.HolidayName(d(2026, time.July, 23)) != "海の日" {
+		t.Error("moved holiday not picked up")
+	}
+	if !cal.IsHoliday(d(next, time.January, 1)) {
+		t.Errorf("%d-01-01 should be a holiday after refresh", next)
+	}
+	if h, ok := cal.NextHoliday(d(next-1, time.December, 31)); !ok || !h.Date.Equal(d(next, time.January, 1)) {
+		t.Errorf("NextHoliday = %v, %v", h, ok)
+	}
+	if got := cal.BusinessDaysBetween(d(2026, time.July, 20), d(2026, time.July, 24)); got != 4 {
+		t.Errorf("BusinessDaysBetween = %d, want 4", got)
+	}
+	if !cal.IsHoliday(d(2026, time.June, 15)) {
+		t.Error("custom holiday lost on refresh")
+	}
+	info := cal.DatasetInfo()
+	if info.SourceURL != srv.URL || info.SHA256 == "" || info.FetchedAt.IsZero() || info.Rows != DatasetInfo().Rows+1 {
+		t.Errorf("DatasetInfo = %+v", info)
+	}
+	if !IsHoliday(d(2026, time.July, 20)) {
+		t.Error("refresh changed the default calendar")
+	}
+}
+
+func TestRefreshFromOfficialSource_Rejected(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		body string
+	}{
+		{"not decoded", "\x8d\x91\x96\xaf,\x96\xbc\x8f\xcc\r\n"},
+		{"truncated", "国民の祝日・休日月日,国民の祝日・休日名称\r\n2026/1/1,元日\r\n"},
+		{"bad date", officialCSV(t, func(s string) string { return s + "2026/13/1,謎の日\r\n" })},
+		{"bad name", officialCSV(t, func(s string) string { return s + "2026/6/1,\x96\xbc\r\n" })},
+	}
+	for _, tt := range tests {
+		var body atomic.Value
+		body.Store(tt.body)
+		srv := serveCSV(t, &body)
+		cal := New()
+		if err := cal.RefreshFromOfficialSource(context.Background(), RefreshOptions{URL: srv.URL}); err == nil {
+			t.Errorf("%s: expected error", tt.name)
+		}
+		if cal.DatasetInfo() != DatasetInfo() || !cal.IsHoliday(d(2026, time.July, 20)) {
+			t.Errorf("%s: calendar changed after failed refresh", tt.name)
+		}
+	}
+
+	srv := httptest.NewServer(http.NotFoundHandler())
+	defer srv.Close()
+	if err := New().RefreshFromOfficialSource(context.Background(), RefreshOptions{URL: srv.URL}); err == nil || !strings.Contains(err.Error(), "404") {
+		t.Errorf("404: err = %v", err)
+	}
+}
+
+func TestRefreshFromOfficialSource_PatchedMeanwhile(t *testing.T) {
+	t.Parallel()
+
+	_, last := DatasetRange()
+	next := last.Year() + 1
+	var body atomic.Value
+	body.Store(officialCSV(t, func(s string) string { return s }))
+	srv := serveCSV(t, &body)
+	cal := New()
+	// The next year, which the CSV lacks, is patched in while the CSV is
+	// read.
+	err := cal.RefreshFromOfficialSource(context.Background(), RefreshOptions{
+		URL: srv.URL,
+		Decode: func(r io.Reader) io.Reader {
+			patch := DatasetPatch{Holidays: []ConfigHoliday{{Date: fmt.Sprintf("%d-01-01", next), Name: "元日"}}}
+			return io.MultiReader(readerFunc(func([]byte) (int, error) {
+				if err := cal.ApplyPatch(patch); err != nil {
+					t.Error(err)
+				}
+				return 0, io.EOF
+			}), r)
+		},
+	})
+	if err == nil || !strings.Contains(err.Error(), "less than the current") {
+		t.Errorf("err = %v, want the CSV rejected as truncated", err)
+	}
+	if !cal.IsHoliday(d(next, time.January, 1)) {
+		t.Error("patch applied during the refresh was lost")
+	}
+}
+
+func TestRefreshFromOfficialSource_Decode(t *testing.T) {
+	t.Parallel()
+
+	var body atomic.Value
+	body.Store(strings.ToUpper(officialCSV(t, func(s string) string {
+		return strings.Replace(s, "2026/7/20,海の日", "2026/7/20,marine day", 1)
+	})))
+	srv := serveCSV(t, &body)
+	cal := New()
+	err := cal.RefreshFromOfficialSource(context.Background(), RefreshOptions{
+		URL:    srv.URL,
+		Decode: func(r io.Reader) io.Reader { return lowerReader{r} },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := cal.HolidayName(d(2026, time.July, 20)); got != "marine day" {
+		t.Errorf("HolidayName = %q, want decoded name", got)
+	}
+}
+
+func TestRefreshFromOfficialSource_ShiftJIS(t *testing.T) {
+	t.Parallel()
+
+	// The official CSV, as embedded by the jpholiday_csv build tag, is
+	// Shift_JIS, which needs a decoder.
+	raw, err := os.ReadFile("syukujitsu.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Add a year, so that the download differs from the built-in dataset.
+	_, last := DatasetRange()
+	raw = fmt.Appendf(raw, "%d/1/1,\x8c\xb3\x93\xfa\r\n", last.Year()+1) // 元日
+	var body atomic.Value
+	body.Store(string(raw))
+	srv := serveCSV(t, &body)
+	cal := New()
+	err = cal.RefreshFromOfficialSource(context.Background(), RefreshOptions{URL: srv.URL})
+	if err == nil || !strings.Contains(err.Error(), "RefreshOptions.Decode") {
+		t.Errorf("error = %v, want a pointer to RefreshOptions.Decode", err)
+	}
+	if cal.DatasetVersion() != DatasetVersion() {
+		t.Error("failed refresh changed the dataset")
+	}
+}
+
+// roundTripFunc is an [http.RoundTripper] that calls itself.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) { return f(r) }
+
+// Not parallel: it replaces http.DefaultTransport, which zero options use.
+func TestRefreshFromOfficialSource_ZeroOptions(t *testing.T) {
+	// Add a year, so that the download differs from the built-in dataset.
+	_, last := DatasetRange()
+	next := time.Date(last.Year()+1, time.January, 1, 0, 0, 0, 0, time.UTC)
+	raw := []byte(officialCSV(t, func(s string) string { return s + next.Format("2006/1/2") + ",元日\r\n" }))
+
+	orig := http.DefaultTransport
+	t.Cleanup(func() { http.DefaultTransport = orig })
+	http.DefaultTransport = roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		if r.URL.String() != OfficialCSVURL {
+			return nil, fmt.Errorf("unexpected request for %s", r.URL)
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Status:     "200 OK",
+			Body:       io.NopCloser(bytes.NewReader(raw)),
+			Request:    r,
+		}, nil
+	})
+
+	cal := New()
+	if err := cal.RefreshFromOfficialSource(context.Background(), RefreshOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if got := cal.HolidayName(next); got != "元日" {
+		t.Errorf("HolidayName(%s) = %q, want 元日", next.Format(time.DateOnly), got)
+	}
+	if got := cal.DatasetInfo().SourceURL; got != OfficialCSVURL {
+		t.Errorf("SourceURL = %q, want %q", got, OfficialCSVURL)
+	}
+}
+
+// readerFunc is an [io.Reader] that calls itself.
+type readerFunc func([]byte) (int, error)
+
+func (f readerFunc) Read(p []byte) (int, error) { return f(p) }
+
+// lowerReader lower-cases ASCII letters, standing in for a Shift_JIS decoder.
+type lowerReader struct{ r io.Reader }
+
+func (l lowerReader) Read(p []byte) (int, error) {
+	n, err := l.r.Read(p)
+	for i, b := range p[:n] {
+		if 'A' <= b && b <= 'Z' {
+			p[i] = b + 'a' - 'A'
+		}
+	}
+	return n, err
+}
+
+func TestRefreshPeriodically(t *testing.T) {
+	t.Parallel()
+
+	var body atomic.Value
+	body.Store("broken")
+	srv := serveCSV(t, &body)
+
+	errs := make(chan error, 100)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	cal := New()
+	err := cal.RefreshPeriodically(ctx, 10*time.Millisecond, RefreshOptions{
+		URL:     srv.URL,
+		OnError: func(err error) { errs <- err },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-errs:
+	case <-time.After(5 * time.Second):
+		t.Fatal("OnError not called for a broken CSV")
+	}
+
+	body.Store(officialCSV(t, func(s string) string {
+		return strings.Replace(s, "2026/7/20,海の日", "2026/7/23,海の日", 1)
+	}))
+	deadline := time.Now().Add(5 * time.Second)
+	for !cal.IsHoliday(d(2026, time.July, 23)) {
+		if time.Now().After(deadline) {
+			t.Fatal("calendar not refreshed")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestRefreshPeriodically_NonPositiveInterval(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { requests.Add(1) }))
+	defer srv.Close()
+
+	for _, interval := range []time.Duration{0, -time.Second} {
+		if err := New().RefreshPeriodically(context.Background(), interval, RefreshOptions{URL: srv.URL}); err == nil {
+			t.Errorf("RefreshPeriodically(%v): expected error", interval)
+		}
+	}
+	time.Sleep(20 * time.Millisecond)
+	if n := requests.Load(); n != 0 {
+		t.Errorf("got %d requests, want 0", n)
+	}
+}
@@ -0,0 +1,104 @@
+package jpholiday
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// localHolidays are the prefectural and municipal holidays on which public
+// schools and local government offices close, keyed by region. since is the
+// first year the holiday was observed on its current date.
+var localHolidays = map[string][]struct {
+	month time.Month
+	day   int
+	name  string
+	since int
+}{
+	"tokyo":    {{time.October, 1, "都民の日", 1952}},
+	"ibaraki":  {{time.November, 13, "県民の日", 1968}},
+	"saitama":  {{time.November, 14, "県民の日", 1971}},
+	"chiba":    {{time.June, 15, "県民の日", 1984}},
+	"gunma":    {{time.October, 28, "県民の日", 1985}},
+	"tochigi":  {{time.June, 15, "県民の日", 1986}},
+	"shizuoka": {{time.August, 21, "県民の日", 1997}},
+	"okinawa":  {{time.June, 23, "慰霊の日", 1965}},
+	"yokohama": {{time.June, 2, "開港記念日", 1955}},
+}
+
+// LocalHolidayRegions returns the regions accepted by [WithPrefecture] and
+// [Calendar.AddLocalHolidays], sorted: prefectures such as "tokyo" and
+// "chiba", and cities such as "yokohama".
+func LocalHolidayRegions() []string {
+	regions := make([]string, 0, len(localHolidays))
+	for r := range localHolidays {
+		regions = append(regions, r)
+	}
+	slices.Sort(regions)
+	return regions
+}
+
+// WithPrefecture returns an [Option] that adds the local holidays of a
+// prefecture or city to the new calendar, such as 都民の日 (October 1) for
+// "tokyo" or 県民の日 for "chiba". They are not national holidays, but
+// public schools and local government offices close on them.
+//
+// Local holidays are kept apart from national and custom holidays: they
+// make the day a non-business day, like a closure, but [Calendar.IsHoliday],
+// [Calendar.HolidayName], and the holiday lists do not report them. Use
+// [Calendar.LocalHolidayName] to look them up. They cover the years of the
+// dataset, including years added later by [Calendar.ApplyPatch] or
+// [Calendar.RefreshFromOfficialSource].
+//
+// WithPrefecture panics if region is not listed by [LocalHolidayRegions];
+// use [Calendar.AddLocalHolidays] for a region chosen at run time.
+func WithPrefecture(region string) Option {
+	rule, err := localHolidayRule(region)
+	if err != nil {
+		panic(err)
+	}
+	return func(s *snapshot) { s.addYearly(rule) }
+}
+
+// AddLocalHolidays adds the local holidays of a prefecture or city to the
+// calendar, as [WithPrefecture] does for a new one. It returns an error for
+// a region not listed by [LocalHolidayRegions].
+func (c *Calendar) AddLocalHolidays(region string) error {
+	rule, err := localHolidayRule(region)
+	if err != nil {
+		return err
+	}
+	c.update(func(s *snapshot) { s.addYearly(rule) })
+	return nil
+}
+
+// LocalHolidayName returns the name of the local holiday added with
+// [WithPrefecture] or [Calendar.AddLocalHolidays] on the given date, or an
+// empty string if there is none. The date is interpreted in JST.
+func (c *Calendar) LocalHolidayName(t time.Time) string {
+	d := dateFromTime(t)
+	return c.load().local[d]
+}
+
+// localHolidayRule returns the yearly rule adding the local holidays of a
+// region.
+func localHolidayRule(region string) (yearlyRule, error) {
+	rules, ok := localHolidays[region]
+	if !ok {
+		return nil, fmt.Errorf("jpholiday: unknown region %q", region)
+	}
+	return func(s *snapshot, first, last int) {
+		for _, r := range rules {
+			for year := max(r.since, first); year <= last; year++ {
+				s.local[newDate(year, r.month, r.day)] = r.name
+			}
+		}
+	}, nil
+}
+
+// AddLocalHolidays adds the local holidays of a region to the default calendar.
+func AddLocalHolidays(region string) error { return defaultCal.AddLocalHolidays(region) }
+
+// LocalHolidayName returns the name of the local holiday on the given date
+// in the default calendar, or an empty string if there is none.
+func LocalHolidayName(t time.Time) string { return defaultCal.LocalHolidayName(t) }
@@ -0,0 +1,126 @@
+package jpholiday_test
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/rabitt1ove/jp-holidays"
+)
+
+func TestAddLocalHolidays(t *testing.T) {
+	t.Parallel()
+
+	cal := New()
+	if err := cal.AddLocalHolidays("tokyo"); err != nil {
+		t.Fatal(err)
+	}
+	if got := cal.LocalHolidayName(d(2026, time.October, 1)); got != "都民の日" {
+		t.Errorf("LocalHolidayName(2026-10-01) = %q, want 都民の日", got)
+	}
+	if cal.IsBusinessDay(d(2026, time.October, 1)) {
+		t.Error("都民の日 should not be a business day")
+	}
+	// Local holidays are not national holidays.
+	if cal.IsHoliday(d(2026, time.October, 1)) {
+		t.Error("都民の日 should not be a holiday")
+	}
+	if got := cal.BusinessDaysBetween(d(2026, time.September, 28), d(2026, time.October, 2)); got != 4 {
+		t.Errorf("BusinessDaysBetween(2026-09-28, 2026-10-02) = %d, want 4", got)
+	}
+	if LocalHolidayName(d(2026, time.October, 1)) != "" || !IsBusinessDay(d(2026, time.October, 1)) {
+		t.Error("AddLocalHolidays changed the default calendar")
+	}
+
+	if err := cal.AddLocalHolidays("chiba"); err != nil {
+		t.Fatal(err)
+	}
+	if got := cal.LocalHolidayName(d(2026, time.June, 15)); got != "県民の日" {
+		t.Errorf("LocalHolidayName(2026-06-15) = %q, want 県民の日", got)
+	}
+	// 千葉県民の日 was established in 1984.
+	if got := cal.LocalHolidayName(d(1983, time.June, 15)); got != "" {
+		t.Errorf("LocalHolidayName(1983-06-15) = %q, want none", got)
+	}
+
+	err := cal.AddLocalHolidays("atlantis")
+	if err == nil || !strings.Contains(err.Error(), `"atlantis"`) {
+		t.Errorf("AddLocalHolidays(atlantis) error = %v", err)
+	}
+}
+
+func TestWithPrefecture(t *testing.T) {
+	t.Parallel()
+
+	cal := New(WithPrefecture("tokyo"), WithPrefecture("chiba"))
+	if got := cal.LocalHolidayName(d(2026, time.October, 1)); got != "都民の日" {
+		t.Errorf("LocalHolidayName(2026-10-01) = %q, want 都民の日", got)
+	}
+	if got := cal.LocalHolidayName(d(2026, time.June, 15)); got != "県民の日" {
+		t.Errorf("LocalHolidayName(2026-06-15) = %q, want 県民の日", got)
+	}
+
+	// Years added by a patch get the local holidays too.
+	_, last := DatasetRange()
+	next := last.Year() + 1
+	patch := DatasetPatch{Holidays: []ConfigHoliday{{Date: fmt.Sprintf("%d-01-01", next), Name: "元日"}}}
+	if err := cal.ApplyPatch(patch); err != nil {
+		t.Fatal(err)
+	}
+	if got := cal.LocalHolidayName(d(next, time.October, 1)); got != "都民の日" {
+		t.Errorf("LocalHolidayName(%d-10-01) = %q, want 都民の日", next, got)
+	}
+	if cal.IsBusinessDay(d(next, time.June, 15)) {
+		t.Errorf("%d-06-15 should not be a business day", next)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("WithPrefecture(atlantis) did not panic")
+		}
+	}()
+	WithPrefecture("atlantis")
+}
+
+func TestLocalHolidayRegions(t *testing.T) {
+	t.Parallel()
+
+	regions := LocalHolidayRegions()
+	if !slices.IsSorted(regions) || !slices.Contains(regions, "tokyo") || !slices.Contains(regions, "yokohama") {
+		t.Errorf("LocalHolidayRegions() = %v", regions)
+	}
+	for _, r := range regions {
+		if err := New().AddLocalHolidays(r); err != nil {
+			t.Errorf("AddLocalHolidays(%q) = %v", r, err)
+		}
+	}
+}
+
+func TestLoadConfig_Regions(t *testing.T) {
+	t.Parallel()
+
+	_, last := DatasetRange()
+	next := last.Year() + 1
+	yaml := "regions: [okinawa]\nholidays:\n  - date: 2026-06-23\n    name: 社内休日\n" +
+		fmt.Sprintf("patch:\n  - date: %d-01-01\n    name: 元日\n", next)
+	cal := New()
+	if err := cal.LoadConfig(strings.NewReader(yaml)); err != nil {
+		t.Fatal(err)
+	}
+	// Custom holidays and local holidays are separate layers.
+	if got := cal.HolidayName(d(2026, time.June, 23)); got != "社内休日" {
+		t.Errorf("HolidayName(2026-06-23) = %q, want 社内休日", got)
+	}
+	if got := cal.LocalHolidayName(d(2026, time.June, 23)); got != "慰霊の日" {
+		t.Errorf("LocalHolidayName(2026-06-23) = %q, want 慰霊の日", got)
+	}
+	if cal.IsHoliday(d(2027, time.June, 23)) || cal.IsBusinessDay(d(2027, time.June, 23)) {
+		t.Error("2027-06-23 should be a local holiday only")
+	}
+	// The regions cover the years added by the patch.
+	if got := cal.LocalHolidayName(d(next, time.June, 23)); got != "慰霊の日" {
+		t.Errorf("LocalHolidayName(%d-06-23) = %q, want 慰霊の日", next, got)
+	}
+}
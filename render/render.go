@@ -0,0 +1,224 @@
+// Package render draws month and year calendar grids in Markdown, HTML, or
+// terminal text, with Japanese holidays highlighted and named.
+//
+// The output is intended for embedding in wikis, status pages, and internal
+// portals:
+//
+//	render.MonthMarkdown(os.Stdout, 2026, time.May, render.Options{})
+package render
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"strings"
+	"time"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+)
+
+// englishWeekdayLabels are two-letter English weekday names, as used by cal(1).
+var englishWeekdayLabels = [7]string{"Su", "Mo", "Tu", "We", "Th", "Fr", "Sa"}
+
+// Options configures calendar rendering.
+type Options struct {
+	// Calendar supplies holidays. If nil, the package-level default calendar is used.
+	Calendar *jpholiday.Calendar
+
+	// WeekStart is the first column of each week. The zero value is Sunday.
+	WeekStart time.Weekday
+
+	// Locale selects the language of titles and weekday labels:
+	// jpholiday.LocaleJapanese (the default when empty) or
+	// jpholiday.LocaleEnglish. Holiday names follow the Calendar's locale.
+	Locale string
+
+	// Color highlights holidays and weekends with ANSI escape sequences in
+	// text output. Without it, holidays are marked with "*".
+	Color bool
+}
+
+// cell is a single day in a month grid. A zero day is padding.
+type cell struct {
+	day     int
+	weekday time.Weekday
+	holiday string
+}
+
+// monthGrid lays out a month as weeks of seven cells.
+func monthGrid(year int, month time.Month, opts Options) [][7]cell {
+	names := make(map[int]string)
+	cal := opts.Calendar
+	if cal == nil {
+		cal = jpholiday.Default()
+	}
+	for _, h := range cal.HolidaysInMonth(year, month) {
+		names[h.Date.Day()] = h.Name
+	}
+
+	first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	lastDay := first.AddDate(0, 1, -1).Day()
+	col := (int(first.Weekday()) - int(opts.WeekStart) + 7) % 7
+
+	var weeks [][7]cell
+	var week [7]cell
+	for day := 1; day <= lastDay; day++ {
+		wd := time.Weekday((int(opts.WeekStart) + col) % 7)
+		week[col] = cell{day: day, weekday: wd, holiday: names[day]}
+		col++
+		if col == 7 {
+			weeks = append(weeks, week)
+			week, col = [7]cell{}, 0
+		}
+	}
+	if col > 0 {
+		weeks = append(weeks, week)
+	}
+	return weeks
+}
+
+// headerLabels returns weekday labels starting at opts.WeekStart.
+func headerLabels(opts Options) [7]string {
+	var labels [7]string
+	for i := range labels {
+		wd := time.Weekday((int(opts.WeekStart) + i) % 7)
+		if opts.Locale == jpholiday.LocaleEnglish {
+			labels[i] = englishWeekdayLabels[wd]
+		} else {
+			labels[i] = jpholiday.WeekdayLabel(wd)
+		}
+	}
+	return labels
+}
+
+func monthTitle(year int, month time.Month, opts Options) string {
+	if opts.Locale == jpholiday.LocaleEnglish {
+		return fmt.Sprintf("%s %d", month, year)
+	}
+	return fmt.Sprintf("%d年%d月", year, int(month))
+}
+
+func yearTitle(year int, opts Options) string {
+	if opts.Locale == jpholiday.LocaleEnglish {
+		return fmt.Sprint(year)
+	}
+	return fmt.Sprintf("%d年", year)
+}
+
+// markdownEscaper escapes characters that would break a Markdown table cell.
+var markdownEscaper = strings.NewReplacer("|", `\|`, "\n", " ")
+
+// MonthMarkdown writes a Markdown table for the given month. Holidays are
+// shown in bold followed by their name.
+func MonthMarkdown(w io.Writer, year int, month time.Month, opts Options) error {
+	var b strings.Builder
+	writeMonthMarkdown(&b, year, month, opts)
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func writeMonthMarkdown(b *strings.Builder, year int, month time.Month, opts Options) {
+	fmt.Fprintf(b, "## %s\n\n", monthTitle(year, month, opts))
+	labels := headerLabels(opts)
+	b.WriteString("|")
+	for _, l := range labels {
+		fmt.Fprintf(b, " %s |", l)
+	}
+	b.WriteString("\n|")
+	for range labels {
+		b.WriteString(" --- |")
+	}
+	b.WriteString("\n")
+
+	for _, week := range monthGrid(year, month, opts) {
+		b.WriteString("|")
+		for _, c := range week {
+			switch {
+			case c.day == 0:
+				b.WriteString("  |")
+			case c.holiday != "":
+				fmt.Fprintf(b, " **%d** %s |", c.day, markdownEscaper.Replace(c.holiday))
+			default:
+				fmt.Fprintf(b, " %d |", c.day)
+			}
+		}
+		b.WriteString("\n")
+	}
+}
+
+// YearMarkdown writes Markdown tables for all twelve months of the year.
+func YearMarkdown(w io.Writer, year int, opts Options) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n", yearTitle(year, opts))
+	for m := time.January; m <= time.December; m++ {
+		b.WriteString("\n")
+		writeMonthMarkdown(&b, year, m, opts)
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// MonthHTML writes an HTML table for the given month. Holiday cells carry the
+// "holiday" class and the holiday name; weekend cells carry "sun" or "sat".
+// The markup is unstyled so callers can supply their own CSS.
+func MonthHTML(w io.Writer, year int, month time.Month, opts Options) error {
+	var b strings.Builder
+	writeMonthHTML(&b, year, month, opts)
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func writeMonthHTML(b *strings.Builder, year int, month time.Month, opts Options) {
+	b.WriteString("<table class=\"jpholiday-month\">\n")
+	fmt.Fprintf(b, "<caption>%s</caption>\n", monthTitle(year, month, opts))
+	b.WriteString("<thead><tr>")
+	for _, l := range headerLabels(opts) {
+		fmt.Fprintf(b, "<th>%s</th>", l)
+	}
+	b.WriteString("</tr></thead>\n<tbody>\n")
+
+	for _, week := range monthGrid(year, month, opts) {
+		b.WriteString("<tr>")
+		for _, c := range week {
+			if c.day == 0 {
+				b.WriteString("<td></td>")
+				continue
+			}
+			var classes []string
+			switch c.weekday {
+			case time.Sunday:
+				classes = append(classes, "sun")
+			case time.Saturday:
+				classes = append(classes, "sat")
+			}
+			if c.holiday != "" {
+				classes = append(classes, "holiday")
+				name := html.EscapeString(c.holiday)
+				fmt.Fprintf(b, "<td class=\"%s\" title=\"%s\">%d<br><span class=\"holiday-name\">%s</span></td>",
+					strings.Join(classes, " "), name, c.day, name)
+				continue
+			}
+			if len(classes) > 0 {
+				fmt.Fprintf(b, "<td class=\"%s\">%d</td>", strings.Join(classes, " "), c.day)
+			} else {
+				fmt.Fprintf(b, "<td>%d</td>", c.day)
+			}
+		}
+		b.WriteString("</tr>\n")
+	}
+	b.WriteString("</tbody>\n</table>\n")
+}
+
+// YearHTML writes HTML tables for all twelve months of the year, wrapped in
+// a <div class="jpholiday-year">.
+func YearHTML(w io.Writer, year int, opts Options) error {
+	var b strings.Builder
+	b.WriteString("<div class=\"jpholiday-year\">\n")
+	fmt.Fprintf(&b, "<h1>%s</h1>\n", yearTitle(year, opts))
+	for m := time.January; m <= time.December; m++ {
+		writeMonthHTML(&b, year, m, opts)
+	}
+	b.WriteString("</div>\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
@@ -0,0 +1,139 @@
+package render
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+)
+
+func TestMonthMarkdown(t *testing.T) {
+	t.Parallel()
+
+	var b strings.Builder
+	if err := MonthMarkdown(&b, 2026, time.May, Options{}); err != nil {
+		t.Fatalf("MonthMarkdown error: %v", err)
+	}
+
+	want := "## 2026年5月\n\n" +
+		"| 日 | 月 | 火 | 水 | 木 | 金 | 土 |\n" +
+		"| --- | --- | --- | --- | --- | --- | --- |\n" +
+		"|  |  |  |  |  | 1 | 2 |\n" +
+		"| **3** 憲法記念日 | **4** みどりの日 | **5** こどもの日 | **6** 休日 | 7 | 8 | 9 |\n" +
+		"| 10 | 11 | 12 | 13 | 14 | 15 | 16 |\n" +
+		"| 17 | 18 | 19 | 20 | 21 | 22 | 23 |\n" +
+		"| 24 | 25 | 26 | 27 | 28 | 29 | 30 |\n" +
+		"| 31 |  |  |  |  |  |  |\n"
+	if got := b.String(); got != want {
+		t.Errorf("MonthMarkdown =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestMonthMarkdown_WeekStartMonday(t *testing.T) {
+	t.Parallel()
+
+	var b strings.Builder
+	if err := MonthMarkdown(&b, 2026, time.June, Options{WeekStart: time.Monday}); err != nil {
+		t.Fatalf("MonthMarkdown error: %v", err)
+	}
+	lines := strings.Split(b.String(), "\n")
+	if lines[2] != "| 月 | 火 | 水 | 木 | 金 | 土 | 日 |" {
+		t.Errorf("header = %q, want Monday first", lines[2])
+	}
+	// 2026-06-01 is a Monday, so the first week has no padding.
+	if lines[4] != "| 1 | 2 | 3 | 4 | 5 | 6 | 7 |" {
+		t.Errorf("first week = %q", lines[4])
+	}
+}
+
+func TestMonthMarkdown_CustomCalendarEscaping(t *testing.T) {
+	t.Parallel()
+
+	cal := jpholiday.New()
+	cal.AddCustomHoliday(time.Date(2026, time.June, 15, 0, 0, 0, 0, time.UTC), "A|B")
+
+	var b strings.Builder
+	if err := MonthMarkdown(&b, 2026, time.June, Options{Calendar: cal}); err != nil {
+		t.Fatalf("MonthMarkdown error: %v", err)
+	}
+	if !strings.Contains(b.String(), `**15** A\|B`) {
+		t.Errorf("custom holiday not rendered or not escaped:\n%s", b.String())
+	}
+}
+
+func TestMonthHTML(t *testing.T) {
+	t.Parallel()
+
+	cal := jpholiday.New()
+	cal.AddCustomHoliday(time.Date(2026, time.January, 2, 0, 0, 0, 0, time.UTC), "<休業>")
+
+	var b strings.Builder
+	if err := MonthHTML(&b, 2026, time.January, Options{Calendar: cal}); err != nil {
+		t.Fatalf("MonthHTML error: %v", err)
+	}
+	out := b.String()
+
+	for _, want := range []string{
+		`<table class="jpholiday-month">`,
+		"<caption>2026年1月</caption>",
+		"<thead><tr><th>日</th><th>月</th><th>火</th><th>水</th><th>木</th><th>金</th><th>土</th></tr></thead>",
+		`<td class="holiday" title="元日">1<br><span class="holiday-name">元日</span></td>`,
+		`<td class="holiday" title="&lt;休業&gt;">2<br><span class="holiday-name">&lt;休業&gt;</span></td>`,
+		`<td class="sat">3</td>`,
+		`<td class="sun">4</td>`,
+		`<td>5</td>`,
+		"</tbody>\n</table>\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("MonthHTML output missing %q", want)
+		}
+	}
+}
+
+func TestYearMarkdown(t *testing.T) {
+	t.Parallel()
+
+	var b strings.Builder
+	if err := YearMarkdown(&b, 2026, Options{}); err != nil {
+		t.Fatalf("YearMarkdown error: %v", err)
+	}
+	out := b.String()
+	if !strings.HasPrefix(out, "# 2026年\n") {
+		t.Errorf("missing year heading: %q", out[:20])
+	}
+	if got := strings.Count(out, "## 2026年"); got != 12 {
+		t.Errorf("got %d month headings, want 12", got)
+	}
+}
+
+func TestYearHTML(t *testing.T) {
+	t.Parallel()
+
+	var b strings.Builder
+	if err := YearHTML(&b, 2026, Options{}); err != nil {
+		t.Fatalf("YearHTML error: %v", err)
+	}
+	out := b.String()
+	if got := strings.Count(out, `<table class="jpholiday-month">`); got != 12 {
+		t.Errorf("got %d month tables, want 12", got)
+	}
+	if !strings.HasSuffix(out, "</div>\n") {
+		t.Error("year output should close the wrapper div")
+	}
+}
+
+func TestYearMarkdown_English(t *testing.T) {
+	t.Parallel()
+
+	var b strings.Builder
+	if err := YearMarkdown(&b, 2026, Options{Locale: jpholiday.LocaleEnglish}); err != nil {
+		t.Fatalf("YearMarkdown error: %v", err)
+	}
+	got := b.String()
+	for _, want := range []string{"# 2026\n", "## January 2026\n", "| Su | Mo | Tu | We | Th | Fr | Sa |\n"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("output should contain %q", want)
+		}
+	}
+}
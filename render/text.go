@@ -0,0 +1,172 @@
+package render
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+)
+
+// ANSI escape sequences used when Options.Color is set.
+const (
+	ansiRed   = "\x1b[31m"
+	ansiBlue  = "\x1b[34m"
+	ansiReset = "\x1b[0m"
+)
+
+// textMonthWidth is the display width of a text month: seven cells of a
+// two-column day and a one-column marker.
+const textMonthWidth = 7 * 3
+
+// textMonthGap separates months laid side by side in YearText.
+const textMonthGap = "  "
+
+// MonthText writes a cal(1)-style month grid followed by a legend of the
+// month's holidays:
+//
+//	      2026年5月
+//	日 月 火 水 木 金 土
+//	                1  2
+//	 3* 4* 5* 6* 7  8  9
+//	...
+//
+//	 5/3  憲法記念日
+func MonthText(w io.Writer, year int, month time.Month, opts Options) error {
+	var b strings.Builder
+	for _, line := range monthTextLines(year, month, opts) {
+		b.WriteString(strings.TrimRight(line, " "))
+		b.WriteString("\n")
+	}
+	writeTextLegend(&b, monthHolidays(year, month, opts), opts)
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// YearText writes the twelve months of the year three abreast, as cal -y
+// does, followed by a legend of the year's holidays.
+func YearText(w io.Writer, year int, opts Options) error {
+	var b strings.Builder
+	title := yearTitle(year, opts)
+	b.WriteString(strings.TrimRight(center(title, 3*textMonthWidth+2*len(textMonthGap)), " "))
+	b.WriteString("\n")
+
+	var holidays []jpholiday.Holiday
+	for row := 0; row < 4; row++ {
+		b.WriteString("\n")
+		var blocks [3][]string
+		height := 0
+		for i := range blocks {
+			m := time.Month(row*3 + i + 1)
+			blocks[i] = monthTextLines(year, m, opts)
+			height = max(height, len(blocks[i]))
+			holidays = append(holidays, monthHolidays(year, m, opts)...)
+		}
+		for line := 0; line < height; line++ {
+			var parts []string
+			for _, blk := range blocks {
+				if line < len(blk) {
+					parts = append(parts, blk[line])
+				} else {
+					parts = append(parts, strings.Repeat(" ", textMonthWidth))
+				}
+			}
+			b.WriteString(strings.TrimRight(strings.Join(parts, textMonthGap), " "))
+			b.WriteString("\n")
+		}
+	}
+	writeTextLegend(&b, holidays, opts)
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func monthHolidays(year int, month time.Month, opts Options) []jpholiday.Holiday {
+	if opts.Calendar != nil {
+		return opts.Calendar.HolidaysInMonth(year, month)
+	}
+	return jpholiday.HolidaysInMonth(year, month)
+}
+
+// monthTextLines returns the title, header, and week lines of a month, each
+// padded to textMonthWidth display columns.
+func monthTextLines(year int, month time.Month, opts Options) []string {
+	lines := []string{center(monthTitle(year, month, opts), textMonthWidth)}
+
+	var hb strings.Builder
+	for i, l := range headerLabels(opts) {
+		wd := time.Weekday((int(opts.WeekStart) + i) % 7)
+		hb.WriteString(colorize(l, weekdayColor(wd), opts))
+		hb.WriteString(" ")
+	}
+	lines = append(lines, hb.String())
+
+	for _, week := range monthGrid(year, month, opts) {
+		var wb strings.Builder
+		for _, c := range week {
+			switch {
+			case c.day == 0:
+				wb.WriteString("   ")
+			case c.holiday != "":
+				day := fmt.Sprintf("%2d", c.day)
+				if opts.Color {
+					wb.WriteString(ansiRed + day + ansiReset + " ")
+				} else {
+					wb.WriteString(day + "*")
+				}
+			default:
+				wb.WriteString(colorize(fmt.Sprintf("%2d", c.day), weekdayColor(c.weekday), opts))
+				wb.WriteString(" ")
+			}
+		}
+		lines = append(lines, wb.String())
+	}
+	return lines
+}
+
+func weekdayColor(wd time.Weekday) string {
+	switch wd {
+	case time.Sunday:
+		return ansiRed
+	case time.Saturday:
+		return ansiBlue
+	}
+	return ""
+}
+
+func colorize(s, color string, opts Options) string {
+	if !opts.Color || color == "" {
+		return s
+	}
+	return color + s + ansiReset
+}
+
+func writeTextLegend(b *strings.Builder, holidays []jpholiday.Holiday, opts Options) {
+	if len(holidays) == 0 {
+		return
+	}
+	b.WriteString("\n")
+	for _, h := range holidays {
+		date := fmt.Sprintf("%5s", fmt.Sprintf("%d/%d", int(h.Date.Month()), h.Date.Day()))
+		fmt.Fprintf(b, "%s  %s\n", colorize(date, ansiRed, opts), h.Name)
+	}
+}
+
+// center pads s with spaces to center it in width display columns, counting
+// non-ASCII characters as two columns.
+func center(s string, width int) string {
+	w := 0
+	for _, r := range s {
+		if r < utf8.RuneSelf {
+			w++
+		} else {
+			w += 2
+		}
+	}
+	if w >= width {
+		return s
+	}
+	left := (width - w) / 2
+	return strings.Repeat(" ", left) + s + strings.Repeat(" ", width-w-left)
+}
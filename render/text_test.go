@@ -0,0 +1,129 @@
+package render
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+)
+
+func TestMonthText(t *testing.T) {
+	t.Parallel()
+
+	var b strings.Builder
+	if err := MonthText(&b, 2026, time.May, Options{}); err != nil {
+		t.Fatalf("MonthText error: %v", err)
+	}
+
+	want := "      2026年5月\n" +
+		"日 月 火 水 木 金 土\n" +
+		"                1  2\n" +
+		" 3* 4* 5* 6* 7  8  9\n" +
+		"10 11 12 13 14 15 16\n" +
+		"17 18 19 20 21 22 23\n" +
+		"24 25 26 27 28 29 30\n" +
+		"31\n" +
+		"\n" +
+		"  5/3  憲法記念日\n" +
+		"  5/4  みどりの日\n" +
+		"  5/5  こどもの日\n" +
+		"  5/6  休日\n"
+	if got := b.String(); got != want {
+		t.Errorf("MonthText =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestMonthText_NoHolidays(t *testing.T) {
+	t.Parallel()
+
+	var b strings.Builder
+	if err := MonthText(&b, 2026, time.June, Options{WeekStart: time.Monday}); err != nil {
+		t.Fatalf("MonthText error: %v", err)
+	}
+	lines := strings.Split(strings.TrimSuffix(b.String(), "\n"), "\n")
+	if lines[1] != "月 火 水 木 金 土 日" {
+		t.Errorf("header = %q", lines[1])
+	}
+	if lines[2] != " 1  2  3  4  5  6  7" {
+		t.Errorf("first week = %q", lines[2])
+	}
+	if strings.Contains(b.String(), "*") || lines[len(lines)-1] == "" {
+		t.Errorf("June should have no holiday markers or legend:\n%s", b.String())
+	}
+}
+
+func TestMonthText_Color(t *testing.T) {
+	t.Parallel()
+
+	cal := jpholiday.New()
+	cal.AddCustomHoliday(time.Date(2026, time.June, 10, 0, 0, 0, 0, time.UTC), "創立記念日")
+
+	var b strings.Builder
+	if err := MonthText(&b, 2026, time.June, Options{Calendar: cal, Color: true}); err != nil {
+		t.Fatalf("MonthText error: %v", err)
+	}
+	got := b.String()
+	for _, want := range []string{
+		ansiRed + "10" + ansiReset,                // holiday
+		ansiRed + " 7" + ansiReset,                // Sunday
+		ansiBlue + " 6" + ansiReset,               // Saturday
+		ansiRed + " 6/10" + ansiReset + "  創立記念日", // legend
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("output should contain %q:\n%q", want, got)
+		}
+	}
+	if strings.Contains(got, "*") {
+		t.Errorf("colored output should not use markers:\n%s", got)
+	}
+}
+
+func TestYearText(t *testing.T) {
+	t.Parallel()
+
+	var b strings.Builder
+	if err := YearText(&b, 2026, Options{}); err != nil {
+		t.Fatalf("YearText error: %v", err)
+	}
+	got := b.String()
+	lines := strings.Split(got, "\n")
+	if strings.TrimSpace(lines[0]) != "2026年" {
+		t.Errorf("title = %q", lines[0])
+	}
+	if want := "      2026年1月              2026年2月              2026年3月"; lines[2] != want {
+		t.Errorf("first month row = %q, want %q", lines[2], want)
+	}
+	if want := " 4  5  6  7  8  9 10    8  9 10 11*12 13 14    8  9 10 11 12 13 14"; lines[5] != want {
+		t.Errorf("second week = %q, want %q", lines[5], want)
+	}
+	for _, want := range []string{"  1/1  元日\n", "11/23  勤労感謝の日\n"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("legend should contain %q", want)
+		}
+	}
+}
+
+func TestMonthText_English(t *testing.T) {
+	t.Parallel()
+
+	cal := jpholiday.New()
+	if err := cal.SetLocale(jpholiday.LocaleEnglish); err != nil {
+		t.Fatal(err)
+	}
+
+	var b strings.Builder
+	if err := MonthText(&b, 2026, time.May, Options{Calendar: cal, Locale: jpholiday.LocaleEnglish}); err != nil {
+		t.Fatalf("MonthText error: %v", err)
+	}
+	got := b.String()
+	for _, want := range []string{
+		"      May 2026\n",
+		"Su Mo Tu We Th Fr Sa\n",
+		"  5/3  Constitution Memorial Day\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("output should contain %q:\n%s", want, got)
+		}
+	}
+}
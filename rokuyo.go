@@ -0,0 +1,76 @@
+package jpholiday
+
+import (
+	"math"
+	"time"
+)
+
+// rokuyoNames are the six rokuyō, indexed by (lunar month + lunar day) % 6.
+var rokuyoNames = [6]string{"大安", "赤口", "先勝", "友引", "先負", "仏滅"}
+
+// Rokuyo returns the rokuyō (六曜) of the given date, interpreted in JST:
+// one of 大安, 赤口, 先勝, 友引, 先負, or 仏滅. It follows the traditional
+// rule of counting from the month and day of the lunisolar calendar (旧暦),
+// computed astronomically with the Tenpō-calendar conventions used by
+// Japanese almanacs: months begin on the JST date of the new moon, and a
+// month containing no major solar term (中気) is a leap month. It returns an
+// empty string for dates outside 1900–2100.
+//
+// Rokuyō are not part of the legal holiday calendar and do not depend on a
+// [Calendar]'s configuration.
+func Rokuyo(t time.Time) string {
+	d := dateFromTime(t)
+	if y := d.year(); y < astroFirstYear || y > astroLastYear {
+		return ""
+	}
+	month, day, _ := lunarDate(d)
+	return rokuyoNames[(month+day)%6]
+}
+
+// lunarDate returns the month and day of d in the lunisolar calendar, and
+// whether the month is a leap month (閏月).
+//
+// Months are numbered from the 11th, the month containing the winter
+// solstice (冬至). When 13 months begin between one 11th month and the next,
+// the first of them without a major solar term is a leap month and repeats
+// the previous month's number.
+func lunarDate(d date) (month, day int, leap bool) {
+	k := lunationOf(d)
+	day = d.days() - newMoonDate(k).days() + 1
+
+	y := d.year()
+	from, to := winterSolsticeLunation(y-1), winterSolsticeLunation(y)
+	if k >= to {
+		from, to = to, winterSolsticeLunation(y+1)
+	}
+	leapAt := -1
+	if to-from == 13 {
+		for i := 1; i < 13; i++ {
+			if !hasMajorSolarTerm(from + i) {
+				leapAt = i
+				break
+			}
+		}
+	}
+
+	i := k - from
+	n := 11 + i
+	if leapAt >= 0 && i >= leapAt {
+		n--
+	}
+	return (n-1)%12 + 1, day, i == leapAt
+}
+
+// winterSolsticeLunation returns the lunation containing the winter solstice
+// of the given year.
+func winterSolsticeLunation(year int) int {
+	return lunationOf(solarTermDate(year, 270))
+}
+
+// hasMajorSolarTerm reports whether lunation k contains a major solar term:
+// a JST date on which the Sun's longitude reaches a multiple of 30°.
+func hasMajorSolarTerm(k int) bool {
+	start := sunLongitude(jdeOfJSTMidnight(newMoonDate(k)))
+	end := sunLongitude(jdeOfJSTMidnight(newMoonDate(k + 1)))
+	return math.Floor(start/30) != math.Floor(end/30)
+}
@@ -0,0 +1,54 @@
+package jpholiday_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/rabitt1ove/jp-holidays"
+)
+
+func TestRokuyo(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		date time.Time
+		want string
+	}{
+		{d(2026, time.February, 17), "先勝"}, // 旧正月: 1/1
+		{d(2026, time.February, 18), "友引"},
+		{d(2026, time.February, 19), "先負"},
+		{d(2026, time.February, 20), "仏滅"},
+		{d(2026, time.February, 21), "大安"},
+		{d(2026, time.February, 22), "赤口"},
+		{d(2026, time.February, 23), "先勝"},
+		{d(2026, time.February, 16), "仏滅"},  // 12/29
+		{d(2026, time.September, 25), "仏滅"}, // 中秋: 8/15
+		{d(2026, time.January, 1), "大安"},    // 11/13
+		{d(2025, time.July, 25), "赤口"},      // 閏6/1 counts as 6/1
+		{d(2033, time.December, 22), "大安"},  // 閏11/1 (the 2033 problem)
+	}
+	for _, tt := range tests {
+		if got := Rokuyo(tt.date); got != tt.want {
+			t.Errorf("Rokuyo(%s) = %q, want %q", tt.date.Format(time.DateOnly), got, tt.want)
+		}
+	}
+}
+
+func TestRokuyo_JST(t *testing.T) {
+	t.Parallel()
+
+	// 2026-02-16 15:00 UTC is 2026-02-17 00:00 JST.
+	if got := Rokuyo(time.Date(2026, time.February, 16, 15, 0, 0, 0, time.UTC)); got != "先勝" {
+		t.Errorf("Rokuyo = %q, want 先勝", got)
+	}
+}
+
+func TestRokuyo_OutOfRange(t *testing.T) {
+	t.Parallel()
+
+	for _, tm := range []time.Time{d(1899, time.December, 31), d(2101, time.January, 1)} {
+		if got := Rokuyo(tm); got != "" {
+			t.Errorf("Rokuyo(%s) = %q, want empty", tm.Format(time.DateOnly), got)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package schedule
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+)
+
+// Reminder is a reminder of a target date, as delivered by [RunReminders].
+type Reminder struct {
+	Target time.Time // The target, as given.
+	At     time.Time // When the reminder is due, in JST.
+}
+
+// ReminderOptions configures [RunReminders] and [PlanReminders].
+type ReminderOptions struct {
+	// Calendar decides which days are business days. If nil, including a
+	// nil *jpholiday.Calendar, the package-level default calendar is used.
+	Calendar jpholiday.HolidayChecker
+
+	// LeadDays is how many business days before each target's JST date the
+	// reminder is due. The zero value reminds on the target's date.
+	LeadDays int
+
+	// Hour and Minute are the JST time of day of the reminders.
+	Hour, Minute int
+
+	// Now returns the current time. If nil, time.Now is used.
+	Now func() time.Time
+}
+
+func (o ReminderOptions) calendar() jpholiday.HolidayChecker {
+	return Options{Calendar: o.Calendar}.calendar()
+}
+
+func (o ReminderOptions) now() time.Time { return Options{Now: o.Now}.now() }
+
+// ReminderTime returns hour:minute JST on the leadDays-th business day of
+// cal before target's JST date, or on that date itself if leadDays is
+// zero. Non-business days in between are skipped, so a reminder three
+// business days before May 8 comes before Golden Week, on April 30. If cal
+// is nil, the package-level default calendar is used. ok is false if a
+// year passes without a business day.
+func ReminderTime(cal jpholiday.HolidayChecker, target time.Time, leadDays, hour, minute int) (time.Time, bool) {
+	cal = checker(cal)
+	t := target.In(jst)
+	day := time.Date(t.Year(), t.Month(), t.Day(), hour, minute, 0, 0, jst)
+	for n, gap := 0, 0; n < leadDays; {
+		if gap++; gap > maxSearchDays {
+			return time.Time{}, false
+		}
+		day = day.AddDate(0, 0, -1)
+		if cal.IsBusinessDay(day) {
+			n, gap = n+1, 0
+		}
+	}
+	return day, true
+}
+
+// PlanReminders returns the reminders of targets in the order they are
+// due. Targets before today (JST) and targets without a reminder time are
+// left out; reminders already due are kept, so that a late start still
+// delivers them.
+func PlanReminders(targets []time.Time, opts ReminderOptions) []Reminder {
+	cal := opts.calendar()
+	now := opts.now().In(jst)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, jst)
+	var reminders []Reminder
+	for _, target := range targets {
+		t := target.In(jst)
+		if time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, jst).Before(today) {
+			continue
+		}
+		if at, ok := ReminderTime(cal, target, opts.LeadDays, opts.Hour, opts.Minute); ok {
+			reminders = append(reminders, Reminder{Target: target, At: at})
+		}
+	}
+	slices.SortStableFunc(reminders, func(a, b Reminder) int { return cmp.Compare(a.At.UnixNano(), b.At.UnixNano()) })
+	return reminders
+}
+
+// RunReminders calls fn with each reminder planned by [PlanReminders] as it
+// comes due, in order, and returns nil once all have been delivered. It
+// returns ctx.Err() if ctx is done first, and an error if the time of day
+// is out of range.
+//
+// To receive reminders on a channel instead, send them from fn:
+//
+//	ch := make(chan schedule.Reminder)
+//	go func() {
+//		defer close(ch)
+//		schedule.RunReminders(ctx, targets, opts, func(r schedule.Reminder) {
+//			select {
+//			case ch <- r:
+//			case <-ctx.Done():
+//			}
+//		})
+//	}()
+func RunReminders(ctx context.Context, targets []time.Time, opts ReminderOptions, fn func(Reminder)) error {
+	if opts.Hour < 0 || opts.Hour > 23 || opts.Minute < 0 || opts.Minute > 59 {
+		return fmt.Errorf("schedule: time of day %d:%02d out of range", opts.Hour, opts.Minute)
+	}
+	for _, r := range PlanReminders(targets, opts) {
+		if err := sleep(ctx, r.At.Sub(opts.now())); err != nil {
+			return err
+		}
+		fn(r)
+	}
+	return nil
+}
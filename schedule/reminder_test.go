@@ -0,0 +1,103 @@
+package schedule_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+	"github.com/rabitt1ove/jp-holidays/schedule"
+)
+
+func TestReminderTime(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		target time.Time
+		lead   int
+		want   time.Time
+	}{
+		{"same day", at(2026, time.June, 10, 15, 0), 0, at(2026, time.June, 10, 9, 0)},
+		{"over a weekend", at(2026, time.June, 8, 0, 0), 1, at(2026, time.June, 5, 9, 0)},
+		{"over Golden Week", at(2026, time.May, 8, 0, 0), 3, at(2026, time.April, 30, 9, 0)},
+		{"UTC target", time.Date(2026, time.June, 7, 20, 0, 0, 0, time.UTC), 1, at(2026, time.June, 5, 9, 0)}, // 6/8 in JST
+	}
+	for _, tt := range tests {
+		got, ok := schedule.ReminderTime(nil, tt.target, tt.lead, 9, 0)
+		if !ok || !got.Equal(tt.want) {
+			t.Errorf("%s: ReminderTime = %v, %v, want %v", tt.name, got, ok, tt.want)
+		}
+	}
+
+	var cal *jpholiday.Calendar // falls back to the default calendar
+	if got, ok := schedule.ReminderTime(cal, at(2026, time.May, 8, 0, 0), 3, 9, 0); !ok || !got.Equal(at(2026, time.April, 30, 9, 0)) {
+		t.Errorf("ReminderTime(nil *Calendar) = %v, %v", got, ok)
+	}
+
+	if _, ok := schedule.ReminderTime(fixedChecker(false), at(2026, time.June, 8, 0, 0), 1, 9, 0); ok {
+		t.Error("ReminderTime without business days: want ok = false")
+	}
+}
+
+func TestPlanReminders(t *testing.T) {
+	t.Parallel()
+
+	targets := []time.Time{
+		at(2026, time.May, 8, 0, 0),
+		at(2026, time.April, 1, 0, 0), // past
+		at(2026, time.May, 1, 0, 0),
+	}
+	opts := schedule.ReminderOptions{
+		Calendar: jpholiday.New(),
+		LeadDays: 3,
+		Hour:     10,
+		Now:      func() time.Time { return at(2026, time.April, 28, 12, 0) },
+	}
+	got := schedule.PlanReminders(targets, opts)
+	want := []schedule.Reminder{
+		{Target: targets[2], At: at(2026, time.April, 27, 10, 0)}, // already due
+		{Target: targets[0], At: at(2026, time.April, 30, 10, 0)},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("PlanReminders = %v, want %v", got, want)
+	}
+	for i := range want {
+		if !got[i].Target.Equal(want[i].Target) || !got[i].At.Equal(want[i].At) {
+			t.Errorf("PlanReminders[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestRunReminders(t *testing.T) {
+	t.Parallel()
+
+	targets := []time.Time{at(2026, time.May, 8, 0, 0), at(2026, time.May, 1, 0, 0)}
+	opts := schedule.ReminderOptions{
+		LeadDays: 3,
+		Now:      func() time.Time { return at(2026, time.May, 1, 0, 0) },
+	}
+	var got []time.Time
+	if err := schedule.RunReminders(context.Background(), targets, opts, func(r schedule.Reminder) {
+		got = append(got, r.Target)
+	}); err != nil {
+		t.Fatalf("RunReminders error: %v", err)
+	}
+	if len(got) != 2 || !got[0].Equal(targets[1]) || !got[1].Equal(targets[0]) {
+		t.Errorf("delivered %v, want %v then %v", got, targets[1], targets[0])
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	opts.Now = func() time.Time { return at(2026, time.April, 1, 0, 0) }
+	err := schedule.RunReminders(ctx, targets, opts, func(r schedule.Reminder) { t.Errorf("unexpected reminder %v", r) })
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("RunReminders = %v, want context.DeadlineExceeded", err)
+	}
+
+	opts.Hour = 24
+	if err := schedule.RunReminders(context.Background(), targets, opts, func(schedule.Reminder) {}); err == nil {
+		t.Error("hour 24: want error")
+	}
+}
@@ -0,0 +1,206 @@
+// Package schedule runs work at a fixed time of day on Japanese business
+// days, in place of a cron entry paired with a hand-maintained holiday
+// list:
+//
+//	t, err := schedule.NewBusinessDayTicker(ctx, schedule.Options{Hour: 9})
+//	if err != nil {
+//		return err
+//	}
+//	defer t.Stop()
+//	for tick := range t.C {
+//		runDailyBatch(tick)
+//	}
+//
+// Times of day are in JST, and business days are decided by a
+// [jpholiday.HolidayChecker], so weekends, holidays, and closures are
+// skipped. For worker loops that pause over holidays, [WaitUntilBusinessDay]
+// and [WaitUntil] block until the next business day or business-day time,
+// and [RunReminders] calls back a given number of business days ahead of
+// deadlines.
+package schedule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+)
+
+// jst is the Asia/Tokyo timezone in which ticks are scheduled.
+var jst = time.FixedZone("Asia/Tokyo", 9*60*60)
+
+// maxSearchDays bounds the search for the next business day, as in
+// [jpholiday.Calendar.NextBusinessDay].
+const maxSearchDays = 366
+
+// Options configures a [BusinessDayTicker].
+type Options struct {
+	// Calendar decides which days are business days. If nil, including a
+	// nil *jpholiday.Calendar, the package-level default calendar is used.
+	Calendar jpholiday.HolidayChecker
+
+	// Hour and Minute are the JST time of day of each tick. The zero
+	// value ticks at midnight.
+	Hour, Minute int
+
+	// Now returns the current time. If nil, time.Now is used.
+	Now func() time.Time
+}
+
+func (o Options) calendar() jpholiday.HolidayChecker { return checker(o.Calendar) }
+
+// checker returns cal, or the package-level default calendar if cal is nil
+// or holds a nil *jpholiday.Calendar, which would panic on first use.
+func checker(cal jpholiday.HolidayChecker) jpholiday.HolidayChecker {
+	if c, ok := cal.(*jpholiday.Calendar); cal == nil || ok && c == nil {
+		return jpholiday.Default()
+	}
+	return cal
+}
+
+func (o Options) now() time.Time {
+	if o.Now != nil {
+		return o.Now()
+	}
+	return time.Now()
+}
+
+// NextTick returns the first time after t that is hour:minute JST on a
+// business day of cal, in JST. If cal is nil, the package-level default
+// calendar is used. ok is false if there is no business day within a
+// year, for example when every weekday is a weekend day.
+func NextTick(cal jpholiday.HolidayChecker, t time.Time, hour, minute int) (next time.Time, ok bool) {
+	cal = checker(cal)
+	t = t.In(jst)
+	next = time.Date(t.Year(), t.Month(), t.Day(), hour, minute, 0, 0, jst)
+	if !next.After(t) {
+		next = next.AddDate(0, 0, 1)
+	}
+	for range maxSearchDays {
+		if cal.IsBusinessDay(next) {
+			return next, true
+		}
+		next = next.AddDate(0, 0, 1)
+	}
+	return time.Time{}, false
+}
+
+// errNoBusinessDay is returned by the wait functions when no business day
+// is found.
+var errNoBusinessDay = errors.New("schedule: no business day within a year")
+
+// WaitUntilBusinessDay blocks until it is a business day of cal in JST:
+// it returns at once on a business day and otherwise at midnight JST at
+// the start of the next one. If cal is nil, the package-level default
+// calendar is used. It returns ctx.Err() if ctx is done first.
+func WaitUntilBusinessDay(ctx context.Context, cal jpholiday.HolidayChecker) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	cal = checker(cal)
+	now := time.Now()
+	if cal.IsBusinessDay(now) {
+		return nil
+	}
+	next, ok := NextTick(cal, now, 0, 0)
+	if !ok {
+		return errNoBusinessDay
+	}
+	return sleep(ctx, time.Until(next))
+}
+
+// WaitUntil blocks until the next hour:minute JST on a business day of
+// cal, as given by [NextTick]. If cal is nil, the package-level default
+// calendar is used. It returns ctx.Err() if ctx is done first, and an
+// error if the time of day is out of range.
+func WaitUntil(ctx context.Context, cal jpholiday.HolidayChecker, hour, minute int) error {
+	if err := checkTimeOfDay(hour, minute); err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	next, ok := NextTick(cal, time.Now(), hour, minute)
+	if !ok {
+		return errNoBusinessDay
+	}
+	return sleep(ctx, time.Until(next))
+}
+
+// checkTimeOfDay returns an error if hour:minute is not a time of day.
+func checkTimeOfDay(hour, minute int) error {
+	if hour < 0 || hour > 23 || minute < 0 || minute > 59 {
+		return fmt.Errorf("schedule: time of day %d:%02d out of range", hour, minute)
+	}
+	return nil
+}
+
+// sleep blocks for d or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// BusinessDayTicker delivers a tick at a fixed JST time on each business
+// day. Create one with [NewBusinessDayTicker].
+type BusinessDayTicker struct {
+	// C receives the scheduled time of each tick, in JST. Like a
+	// time.Ticker, it holds at most one pending tick and drops ticks that
+	// the receiver is too slow to take. It is closed once the ticker stops.
+	C <-chan time.Time
+
+	cancel context.CancelFunc
+}
+
+// NewBusinessDayTicker returns a ticker that sends on its channel at
+// opts.Hour:opts.Minute JST on each business day, starting with the next
+// one, until ctx is done or Stop is called. Business days are decided as
+// each tick comes due, so holidays and closures added in the meantime are
+// honored. It returns an error if the time of day is out of range, as
+// [WaitUntil] does.
+func NewBusinessDayTicker(ctx context.Context, opts Options) (*BusinessDayTicker, error) {
+	if err := checkTimeOfDay(opts.Hour, opts.Minute); err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	c := make(chan time.Time, 1)
+	go func() {
+		defer close(c)
+		cal := opts.calendar()
+		from := opts.now()
+		for {
+			next, ok := NextTick(cal, from, opts.Hour, opts.Minute)
+			if !ok {
+				return
+			}
+			timer := time.NewTimer(next.Sub(opts.now()))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+			// The calendar may have changed while waiting.
+			if cal.IsBusinessDay(next) {
+				select {
+				case c <- next:
+				default:
+				}
+			}
+			from = next
+		}
+	}()
+	return &BusinessDayTicker{C: c, cancel: cancel}, nil
+}
+
+// Stop turns off the ticker, which then closes C. A tick already pending
+// may still be received.
+func (t *BusinessDayTicker) Stop() { t.cancel() }
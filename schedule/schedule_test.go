@@ -0,0 +1,179 @@
+package schedule_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+	"github.com/rabitt1ove/jp-holidays/schedule"
+)
+
+var jst = time.FixedZone("Asia/Tokyo", 9*60*60)
+
+func at(year int, month time.Month, day, hour, minute int) time.Time {
+	return time.Date(year, month, day, hour, minute, 0, 0, jst)
+}
+
+func TestNextTick(t *testing.T) {
+	t.Parallel()
+
+	cal := jpholiday.New()
+	cal.AddClosure(at(2026, time.May, 7, 0, 0))
+
+	tests := []struct {
+		name string
+		from time.Time
+		want time.Time
+	}{
+		{"before the time on a business day", at(2026, time.May, 1, 8, 0), at(2026, time.May, 1, 9, 30)},
+		{"at the time", at(2026, time.May, 1, 9, 30), at(2026, time.May, 8, 9, 30)}, // skips Golden Week and the closure
+		{"over a weekend", at(2026, time.June, 5, 10, 0), at(2026, time.June, 8, 9, 30)},
+		{"UTC input", time.Date(2026, time.June, 8, 0, 0, 0, 0, time.UTC), at(2026, time.June, 8, 9, 30)},
+		{"late UTC input is the next JST day", time.Date(2026, time.June, 8, 23, 0, 0, 0, time.UTC), at(2026, time.June, 9, 9, 30)},
+	}
+	for _, tt := range tests {
+		got, ok := schedule.NextTick(cal, tt.from, 9, 30)
+		if !ok || !got.Equal(tt.want) {
+			t.Errorf("%s: NextTick(%v) = %v, %v, want %v", tt.name, tt.from, got, ok, tt.want)
+		}
+	}
+
+	noBusinessDays := jpholiday.New()
+	noBusinessDays.SetWeekend(time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday)
+	if _, ok := schedule.NextTick(noBusinessDays, at(2026, time.May, 1, 0, 0), 9, 0); ok {
+		t.Error("NextTick should fail without business days")
+	}
+}
+
+func TestBusinessDayTicker(t *testing.T) {
+	t.Parallel()
+
+	// Shift the clock to just before 09:00 on a business day.
+	want := at(2026, time.June, 8, 9, 0)
+	offset := want.Add(-50 * time.Millisecond).Sub(time.Now())
+	now := func() time.Time { return time.Now().Add(offset) }
+
+	ticker, err := schedule.NewBusinessDayTicker(context.Background(), schedule.Options{Hour: 9, Now: now})
+	if err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case got := <-ticker.C:
+		if !got.Equal(want) {
+			t.Errorf("tick = %v, want %v", got, want)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("no tick")
+	}
+
+	ticker.Stop()
+	select {
+	case _, ok := <-ticker.C:
+		if ok {
+			t.Error("unexpected tick after Stop")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("C not closed after Stop")
+	}
+}
+
+func TestBusinessDayTicker_Context(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ticker, err := schedule.NewBusinessDayTicker(ctx, schedule.Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cancel()
+	select {
+	case <-ticker.C:
+	case <-time.After(5 * time.Second):
+		t.Fatal("C not closed after the context is done")
+	}
+}
+
+func TestNewBusinessDayTicker_InvalidTime(t *testing.T) {
+	t.Parallel()
+
+	for _, opts := range []schedule.Options{{Hour: 24}, {Hour: -1}, {Minute: 60}} {
+		if _, err := schedule.NewBusinessDayTicker(context.Background(), opts); err == nil {
+			t.Errorf("NewBusinessDayTicker(%d:%02d): expected error", opts.Hour, opts.Minute)
+		}
+	}
+}
+
+func TestNextTick_NilCalendar(t *testing.T) {
+	t.Parallel()
+
+	// A nil *jpholiday.Calendar in the interface falls back to the default
+	// calendar instead of panicking.
+	var cal *jpholiday.Calendar
+	want, _ := schedule.NextTick(nil, at(2026, time.May, 1, 10, 0), 9, 0)
+	got, ok := schedule.NextTick(cal, at(2026, time.May, 1, 10, 0), 9, 0)
+	if !ok || !got.Equal(want) {
+		t.Errorf("NextTick(nil *Calendar) = %v, %v, want %v", got, ok, want)
+	}
+
+	ticker, err := schedule.NewBusinessDayTicker(context.Background(), schedule.Options{Calendar: cal})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ticker.Stop()
+}
+
+// fixedChecker is a calendar on which every day is a business day, or none is.
+type fixedChecker bool
+
+func (c fixedChecker) IsHoliday(time.Time) bool { return !bool(c) }
+
+func (c fixedChecker) IsBusinessDay(time.Time) bool { return bool(c) }
+
+func (fixedChecker) HolidaysBetween(time.Time, time.Time) []jpholiday.Holiday { return nil }
+
+func TestWaitUntilBusinessDay(t *testing.T) {
+	t.Parallel()
+
+	if err := schedule.WaitUntilBusinessDay(context.Background(), fixedChecker(true)); err != nil {
+		t.Errorf("on a business day: %v, want nil", err)
+	}
+	if err := schedule.WaitUntilBusinessDay(context.Background(), fixedChecker(false)); err == nil {
+		t.Error("without business days: want error")
+	}
+
+	// With today and tomorrow as the weekend, the wait lasts until the
+	// context times out.
+	today := time.Now().In(jst).Weekday()
+	cal := jpholiday.New()
+	cal.SetWeekend(today, (today+1)%7)
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := schedule.WaitUntilBusinessDay(ctx, cal); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("on a weekend: %v, want context.DeadlineExceeded", err)
+	}
+
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := schedule.WaitUntilBusinessDay(canceled, fixedChecker(true)); !errors.Is(err, context.Canceled) {
+		t.Errorf("canceled: %v, want context.Canceled", err)
+	}
+}
+
+func TestWaitUntil(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	// The next 00:00 is at least a few milliseconds away.
+	if err := schedule.WaitUntil(ctx, fixedChecker(true), 0, 0); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("WaitUntil = %v, want context.DeadlineExceeded", err)
+	}
+	if err := schedule.WaitUntil(context.Background(), fixedChecker(false), 9, 0); err == nil {
+		t.Error("without business days: want error")
+	}
+	if err := schedule.WaitUntil(context.Background(), fixedChecker(true), 9, 60); err == nil {
+		t.Error("minute 60: want error")
+	}
+}
@@ -0,0 +1,151 @@
+// Package school models the vacations of Japanese schools on top of a
+// [jpholiday.Calendar], for apps that schedule around school days.
+//
+// A [Board] lists the vacations set by a board of education (教育委員会).
+// Applying it adds each vacation as a run of closures, so the calendar's
+// business days become school days:
+//
+//	cal, err := school.NewCalendar(school.Standard, 2026, 2027)
+//	if err != nil { ... }
+//	cal.IsBusinessDay(t)          // true on school days
+//	school.Standard.VacationName(t) // "夏休み", "冬休み", "春休み", or ""
+//
+// Boards differ by region, notably in Hokkaido and Tohoku, where summer
+// vacation is shorter and winter vacation longer; define a Board with the
+// local dates for those.
+package school
+
+import (
+	"fmt"
+	"time"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+)
+
+// jst is the Asia/Tokyo timezone in which school days are counted.
+var jst = time.FixedZone("Asia/Tokyo", 9*60*60)
+
+// Vacation is a school vacation repeated every year, from one month and day
+// to another, inclusive. A vacation whose end comes before its start in the
+// calendar, such as winter vacation, ends in the following year.
+type Vacation struct {
+	Name      string // The vacation name (e.g., "夏休み").
+	FromMonth time.Month
+	FromDay   int
+	ToMonth   time.Month
+	ToDay     int
+}
+
+// Board is the vacation schedule of a board of education.
+type Board struct {
+	Name      string
+	Vacations []Vacation
+}
+
+// Standard is a typical public-school schedule outside the snowy regions:
+// 春休み from March 25 to April 5, 夏休み from July 21 to August 31, and
+// 冬休み from December 26 to January 7.
+var Standard = Board{
+	Name: "標準",
+	Vacations: []Vacation{
+		{Name: "春休み", FromMonth: time.March, FromDay: 25, ToMonth: time.April, ToDay: 5},
+		{Name: "夏休み", FromMonth: time.July, FromDay: 21, ToMonth: time.August, ToDay: 31},
+		{Name: "冬休み", FromMonth: time.December, FromDay: 26, ToMonth: time.January, ToDay: 7},
+	},
+}
+
+// Period is one occurrence of a vacation.
+type Period struct {
+	Name string
+	From time.Time // The first day of the vacation (midnight UTC).
+	To   time.Time // The last day of the vacation, inclusive (midnight UTC).
+}
+
+// Periods returns the vacations of b that start in the given year, in the
+// order they are listed in b.
+func (b Board) Periods(year int) []Period {
+	periods := make([]Period, 0, len(b.Vacations))
+	for _, v := range b.Vacations {
+		from := time.Date(year, v.FromMonth, v.FromDay, 0, 0, 0, 0, time.UTC)
+		to := time.Date(year, v.ToMonth, v.ToDay, 0, 0, 0, 0, time.UTC)
+		if to.Before(from) {
+			to = to.AddDate(1, 0, 0)
+		}
+		periods = append(periods, Period{Name: v.Name, From: from, To: to})
+	}
+	return periods
+}
+
+// Validate reports the first vacation of b with a missing name or an
+// invalid month or day.
+func (b Board) Validate() error {
+	for i, v := range b.Vacations {
+		if v.Name == "" {
+			return fmt.Errorf("school: vacations[%d]: name is required", i)
+		}
+		if !validMonthDay(v.FromMonth, v.FromDay) || !validMonthDay(v.ToMonth, v.ToDay) {
+			return fmt.Errorf("school: vacations[%d] (%s): invalid month or day", i, v.Name)
+		}
+	}
+	return nil
+}
+
+// validMonthDay reports whether month and day form a date in a non-leap
+// year; February 29 is rejected because it would not recur.
+func validMonthDay(month time.Month, day int) bool {
+	if month < time.January || month > time.December || day < 1 {
+		return false
+	}
+	return day <= time.Date(2001, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// Apply adds the vacations of b starting in firstYear through lastYear to
+// cal as closures (see [jpholiday.Calendar.AddClosure]), in a single change
+// by way of [jpholiday.Calendar.ApplyConfig]. On error cal is unchanged.
+func (b Board) Apply(cal *jpholiday.Calendar, firstYear, lastYear int) error {
+	if err := b.Validate(); err != nil {
+		return err
+	}
+	if lastYear < firstYear {
+		return fmt.Errorf("school: last year %d is before first year %d", lastYear, firstYear)
+	}
+	var cfg jpholiday.Config
+	for year := firstYear; year <= lastYear; year++ {
+		for _, p := range b.Periods(year) {
+			cfg.Closures = append(cfg.Closures, jpholiday.ConfigClosure{
+				From: p.From.Format(time.DateOnly),
+				To:   p.To.Format(time.DateOnly),
+			})
+		}
+	}
+	return cal.ApplyConfig(cfg)
+}
+
+// NewCalendar returns a new calendar of national holidays with the
+// vacations of b starting in firstYear through lastYear as closures, so
+// that its business days are school days.
+func NewCalendar(b Board, firstYear, lastYear int) (*jpholiday.Calendar, error) {
+	cal := jpholiday.New()
+	if err := b.Apply(cal, firstYear, lastYear); err != nil {
+		return nil, err
+	}
+	return cal, nil
+}
+
+// VacationName returns the name of the vacation of b that includes the
+// given date (interpreted in JST), or an empty string if it is not in a
+// vacation.
+func (b Board) VacationName(t time.Time) string {
+	y, m, d := t.In(jst).Date()
+	day := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	// A vacation including the date started this year or, if it crosses
+	// the new year, last year.
+	for _, year := range []int{y, y - 1} {
+		for _, p := range b.Periods(year) {
+			if !day.Before(p.From) && !day.After(p.To) {
+				return p.Name
+			}
+		}
+	}
+	return ""
+}
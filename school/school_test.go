@@ -0,0 +1,116 @@
+package school
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+)
+
+func d(y int, m time.Month, day int) time.Time {
+	return time.Date(y, m, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestPeriods(t *testing.T) {
+	t.Parallel()
+
+	got := Standard.Periods(2026)
+	want := []Period{
+		{Name: "春休み", From: d(2026, time.March, 25), To: d(2026, time.April, 5)},
+		{Name: "夏休み", From: d(2026, time.July, 21), To: d(2026, time.August, 31)},
+		{Name: "冬休み", From: d(2026, time.December, 26), To: d(2027, time.January, 7)},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("Periods(2026) = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Periods(2026)[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestNewCalendar(t *testing.T) {
+	t.Parallel()
+
+	cal, err := NewCalendar(Standard, 2026, 2026)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		date      time.Time
+		schoolDay bool
+	}{
+		{d(2026, time.July, 20), false}, // 海の日
+		{d(2026, time.July, 17), true},
+		{d(2026, time.July, 21), false},
+		{d(2026, time.September, 1), true},
+		{d(2026, time.December, 25), true},
+		{d(2027, time.January, 7), false}, // 冬休み of 2026 ends in 2027
+		{d(2027, time.January, 8), true},
+		{d(2027, time.March, 26), true}, // 2027 vacations were not applied
+	}
+	for _, tt := range tests {
+		if got := cal.IsBusinessDay(tt.date); got != tt.schoolDay {
+			t.Errorf("IsBusinessDay(%s) = %v, want %v", tt.date.Format(time.DateOnly), got, tt.schoolDay)
+		}
+	}
+	if !cal.IsClosure(d(2026, time.August, 15)) || cal.IsHoliday(d(2026, time.August, 15)) {
+		t.Error("vacation days should be closures, not holidays")
+	}
+	if jpholiday.IsClosure(d(2026, time.August, 15)) {
+		t.Error("NewCalendar changed the default calendar")
+	}
+}
+
+func TestVacationName(t *testing.T) {
+	t.Parallel()
+
+	jst := time.FixedZone("JST", 9*60*60)
+	tests := []struct {
+		t    time.Time
+		want string
+	}{
+		{d(2026, time.August, 1), "夏休み"},
+		{d(2027, time.January, 3), "冬休み"},
+		{d(2026, time.December, 31), "冬休み"},
+		{d(2026, time.April, 5), "春休み"},
+		{d(2026, time.April, 6), ""},
+		// 2026-07-20 15:00 UTC is already 07-21 in JST.
+		{time.Date(2026, time.July, 20, 15, 0, 0, 0, time.UTC), "夏休み"},
+		{time.Date(2026, time.July, 20, 23, 59, 0, 0, jst), ""},
+	}
+	for _, tt := range tests {
+		if got := Standard.VacationName(tt.t); got != tt.want {
+			t.Errorf("VacationName(%s) = %q, want %q", tt.t, got, tt.want)
+		}
+	}
+}
+
+func TestApply_Errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		board Board
+		first int
+		last  int
+		want  string
+	}{
+		{"missing name", Board{Vacations: []Vacation{{FromMonth: time.July, FromDay: 1, ToMonth: time.July, ToDay: 2}}}, 2026, 2026, "name is required"},
+		{"bad day", Board{Vacations: []Vacation{{Name: "x", FromMonth: time.February, FromDay: 30, ToMonth: time.March, ToDay: 1}}}, 2026, 2026, "invalid month or day"},
+		{"bad month", Board{Vacations: []Vacation{{Name: "x", FromMonth: 13, FromDay: 1, ToMonth: time.March, ToDay: 1}}}, 2026, 2026, "invalid month or day"},
+		{"reversed years", Standard, 2027, 2026, "before first year"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			cal := jpholiday.New()
+			err := tt.board.Apply(cal, tt.first, tt.last)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Apply error = %v, want it to contain %q", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package jpholiday
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// decodeShiftJIS decodes Shift_JIS text. The standard library has no
+// Shift_JIS decoder, so double-byte characters are looked up in table;
+// csvShiftJIS, which cmd/genholidays generates, holds the characters used
+// by the official CSV.
+func decodeShiftJIS(b []byte, table map[uint16]rune) (string, error) {
+	var sb strings.Builder
+	sb.Grow(len(b) * 3 / 2)
+	for i := 0; i < len(b); i++ {
+		switch c := b[i]; {
+		case c < utf8.RuneSelf:
+			sb.WriteByte(c)
+		case 0xA1 <= c && c <= 0xDF: // half-width katakana
+			sb.WriteRune(0xFF61 + rune(c-0xA1))
+		case i+1 < len(b):
+			code := uint16(c)<<8 | uint16(b[i+1])
+			r, ok := table[code]
+			if !ok {
+				return "", fmt.Errorf("unknown Shift_JIS character %#04x at byte %d", code, i)
+			}
+			sb.WriteRune(r)
+			i++
+		default:
+			return "", fmt.Errorf("truncated Shift_JIS character at byte %d", i)
+		}
+	}
+	return sb.String(), nil
+}
@@ -0,0 +1,15 @@
+package jpholiday
+
+import "testing"
+
+func TestDecodeShiftJIS(t *testing.T) {
+	table := map[uint16]rune{0x8CB3: '元', 0x93FA: '日'}
+	if got, err := decodeShiftJIS([]byte("1/1,\x8c\xb3\x93\xfa \xb1"), table); err != nil || got != "1/1,元日 ｱ" {
+		t.Errorf("decodeShiftJIS = %q, %v", got, err)
+	}
+	for _, b := range []string{"\x8c\xb3\x8c", "\x82\xa0"} {
+		if _, err := decodeShiftJIS([]byte(b), table); err == nil {
+			t.Errorf("decodeShiftJIS(%q): expected error", b)
+		}
+	}
+}
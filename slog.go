@@ -0,0 +1,72 @@
+package jpholiday
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// LogValue implements [slog.LogValuer], logging the holiday as a group of
+// its date (YYYY-MM-DD) and name.
+func (h Holiday) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("date", h.Date.Format(time.DateOnly)),
+		slog.String("name", h.Name),
+	)
+}
+
+// logHandler is the slog.Handler returned by NewLogHandler.
+type logHandler struct {
+	next slog.Handler
+	cal  *Calendar
+}
+
+// NewLogHandler returns a [slog.Handler] that adds the calendar's view of
+// each record's time to the record and passes it to next, to help explain
+// why a job did or did not run on a given day:
+//
+//	jst_date         the record's date in JST (YYYY-MM-DD)
+//	is_holiday       whether that date is a holiday
+//	is_business_day  whether that date is a business day
+//	holiday_name     the holiday's name, only on holidays
+//
+// The attributes are added last, so after [slog.Logger.WithGroup] they are
+// in the group. Records without a time are passed on unchanged. If cal is
+// nil, the package-level default calendar is used.
+func NewLogHandler(next slog.Handler, cal *Calendar) slog.Handler {
+	if cal == nil {
+		cal = defaultCal
+	}
+	return &logHandler{next: next, cal: cal}
+}
+
+func (h *logHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+func (h *logHandler) Handle(ctx context.Context, r slog.Record) error {
+	if r.Time.IsZero() {
+		return h.next.Handle(ctx, r)
+	}
+	r = r.Clone()
+	d := dateFromTime(r.Time)
+	s := h.cal.load()
+	name, holiday := s.lookup(d)
+	r.AddAttrs(
+		slog.String("jst_date", d.toTime().Format(time.DateOnly)),
+		slog.Bool("is_holiday", holiday),
+		slog.Bool("is_business_day", s.businessDay(d)),
+	)
+	if holiday {
+		r.AddAttrs(slog.String("holiday_name", name))
+	}
+	return h.next.Handle(ctx, r)
+}
+
+func (h *logHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &logHandler{next: h.next.WithAttrs(attrs), cal: h.cal}
+}
+
+func (h *logHandler) WithGroup(name string) slog.Handler {
+	return &logHandler{next: h.next.WithGroup(name), cal: h.cal}
+}
@@ -0,0 +1,95 @@
+package jpholiday_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+	"time"
+
+	. "github.com/rabitt1ove/jp-holidays"
+)
+
+func TestHoliday_LogValue(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(_ []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+	logger.Info("next", "holiday", Holiday{Date: d(2026, time.January, 1), Name: "元日"})
+	if got, want := buf.String(), "level=INFO msg=next holiday.date=2026-01-01 holiday.name=元日\n"; got != want {
+		t.Errorf("log = %q, want %q", got, want)
+	}
+}
+
+func TestNewLogHandler(t *testing.T) {
+	t.Parallel()
+
+	cal := New()
+	cal.AddClosure(d(2026, time.June, 15))
+	tests := []struct {
+		name string
+		time time.Time
+		want map[string]any
+	}{
+		{
+			"holiday", time.Date(2026, time.May, 5, 9, 0, 0, 0, time.UTC),
+			map[string]any{"jst_date": "2026-05-05", "is_holiday": true, "is_business_day": false, "holiday_name": "こどもの日"},
+		},
+		{
+			"late UTC is the next JST day", time.Date(2026, time.May, 5, 20, 0, 0, 0, time.UTC),
+			map[string]any{"jst_date": "2026-05-06", "is_holiday": true, "is_business_day": false, "holiday_name": "休日"},
+		},
+		{
+			"closure", time.Date(2026, time.June, 15, 3, 0, 0, 0, time.UTC),
+			map[string]any{"jst_date": "2026-06-15", "is_holiday": false, "is_business_day": false},
+		},
+		{
+			"business day", time.Date(2026, time.June, 16, 3, 0, 0, 0, time.UTC),
+			map[string]any{"jst_date": "2026-06-16", "is_holiday": false, "is_business_day": true},
+		},
+	}
+	for _, tt := range tests {
+		var buf bytes.Buffer
+		h := NewLogHandler(slog.NewJSONHandler(&buf, nil), cal).WithAttrs([]slog.Attr{slog.String("job", "batch")})
+		r := slog.NewRecord(tt.time, slog.LevelInfo, "skipped", 0)
+		if err := h.Handle(context.Background(), r); err != nil {
+			t.Fatal(err)
+		}
+		var got map[string]any
+		if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if got["job"] != "batch" {
+			t.Errorf("%s: job = %v, want batch", tt.name, got["job"])
+		}
+		for k, want := range tt.want {
+			if got[k] != want {
+				t.Errorf("%s: %s = %v, want %v", tt.name, k, got[k], want)
+			}
+		}
+		if _, ok := tt.want["holiday_name"]; !ok && got["holiday_name"] != nil {
+			t.Errorf("%s: unexpected holiday_name %v", tt.name, got["holiday_name"])
+		}
+	}
+}
+
+func TestNewLogHandler_NoTime(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	h := NewLogHandler(slog.NewJSONHandler(&buf, nil), nil)
+	if err := h.Handle(context.Background(), slog.NewRecord(time.Time{}, slog.LevelInfo, "m", 0)); err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(buf.Bytes(), []byte("jst_date")) {
+		t.Errorf("record without a time was annotated: %s", buf.Bytes())
+	}
+}
@@ -0,0 +1,120 @@
+package jpholiday
+
+import (
+	"slices"
+	"time"
+)
+
+// HolidaySource is a provider of holidays that can be layered onto a
+// [Calendar] with [Calendar.AddSource], such as a company dataset kept in a
+// database or fetched from an internal API. Dates passed to and returned by
+// a source are Japanese calendar dates at midnight UTC, like [Holiday.Date].
+// Implementations must be safe for concurrent use.
+type HolidaySource interface {
+	// Lookup returns the name of the holiday on the given date, if any.
+	Lookup(date time.Time) (name string, ok bool)
+
+	// Range returns the holidays in [from, to], inclusive, in any order.
+	Range(from, to time.Time) []Holiday
+}
+
+// AddSource layers src onto the calendar. Sources are consulted on every
+// lookup, so changes in the underlying data are seen immediately. A holiday
+// from a source takes precedence over a built-in holiday on the same date,
+// and sources added later take precedence over earlier ones; custom
+// holidays take precedence over all sources, and [Calendar.RemoveHoliday]
+// suppresses source holidays too. Names from sources are returned as
+// provided, whatever the calendar's locale.
+//
+// Calendars with sources answer range and business-day queries by asking
+// each source, without the precomputed indexes and caches used for the
+// built-in dataset. [Calendar.NextHoliday] and similar functions find
+// source holidays up to maxSearchDays days beyond the first or last
+// built-in or custom holiday.
+func (c *Calendar) AddSource(src HolidaySource) {
+	c.update(func(s *snapshot) { s.sources = append(s.sources, src) })
+}
+
+// sourceLookup returns the name of the holiday on d from the latest source
+// that has one.
+func (s *snapshot) sourceLookup(d date) (string, bool) {
+	for i := len(s.sources) - 1; i >= 0; i-- {
+		if name, ok := s.sources[i].Lookup(d.toTime()); ok {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// withSources merges the source holidays in [from, to] into result, the
+// built-in and custom holidays of the range, and returns the merged list
+// sorted by date.
+func (s *snapshot) withSources(result []Holiday, from, to date) []Holiday {
+	names := make(map[date]string)
+	for _, src := range s.sources {
+		for _, h := range src.Range(from.toTime(), to.toTime()) {
+			d := dateFromTime(h.Date)
+			if _, ok := s.custom[d]; ok || s.removed[d] || !d.inRange(from, to) {
+				continue
+			}
+			names[d] = h.Name
+		}
+	}
+	if len(names) == 0 {
+		return result
+	}
+	for _, h := range result {
+		d := dateFromTime(h.Date)
+		if _, ok := names[d]; !ok {
+			names[d] = h.Name
+		}
+	}
+	merged := make([]Holiday, 0, len(names))
+	for d, name := range names {
+		merged = append(merged, Holiday{Date: d.toTime(), Name: name})
+	}
+	slices.SortFunc(merged, func(a, b Holiday) int { return a.Date.Compare(b.Date) })
+	return merged
+}
+
+// walkSources collects up to n holidays strictly after d (step 1) or
+// before d (step -1), most recent first when stepping backward, searching
+// a year at a time through maxSearchDays days past the built-in and custom
+// holidays.
+func (s *snapshot) walkSources(d date, step, n int) []Holiday {
+	const window = 366
+	data := s.data()
+	limit := data.last
+	if len(s.customDates) > 0 {
+		limit = max(limit, s.customDates[len(s.customDates)-1])
+	}
+	if step < 0 {
+		limit = data.first
+		if len(s.customDates) > 0 {
+			limit = min(limit, s.customDates[0])
+		}
+	}
+	end := limit.addDays(step * maxSearchDays).days()
+
+	var result []Holiday
+	for cur := d.days() + step; len(result) < n && (end-cur)*step >= 0; cur += step * window {
+		lo, hi := cur, cur+step*(window-1)
+		if step < 0 {
+			lo, hi = hi, lo
+		}
+		hs := s.holidaysInRange(dateFromDays(lo), dateFromDays(hi))
+		if step < 0 {
+			slices.Reverse(hs)
+		}
+		result = append(result, hs[:min(len(hs), n-len(result))]...)
+	}
+	return result
+}
+
+// firstHoliday returns the first of hs, if any.
+func firstHoliday(hs []Holiday) (Holiday, bool) {
+	if len(hs) == 0 {
+		return Holiday{}, false
+	}
+	return hs[0], true
+}
@@ -0,0 +1,139 @@
+package jpholiday_test
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/rabitt1ove/jp-holidays"
+)
+
+// mapSource is a HolidaySource backed by a map, standing in for a database.
+type mapSource map[time.Time]string
+
+func (m mapSource) Lookup(date time.Time) (string, bool) {
+	name, ok := m[date]
+	return name, ok
+}
+
+func (m mapSource) Range(from, to time.Time) []Holiday {
+	var hs []Holiday
+	for date, name := range m {
+		if !date.Before(from) && !date.After(to) {
+			hs = append(hs, Holiday{Date: date, Name: name})
+		}
+	}
+	return hs
+}
+
+func TestAddSource(t *testing.T) {
+	t.Parallel()
+
+	cal := New()
+	cal.AddSource(mapSource{
+		d(2026, time.June, 15): "創立記念日",
+		d(2026, time.May, 4):   "社内みどりの日",
+	})
+
+	if got := cal.HolidayName(d(2026, time.June, 15)); got != "創立記念日" {
+		t.Errorf("HolidayName(source) = %q", got)
+	}
+	if got := cal.HolidayName(d(2026, time.May, 4)); got != "社内みどりの日" {
+		t.Errorf("source should override built-in: got %q", got)
+	}
+	if cal.IsBusinessDay(d(2026, time.June, 15)) {
+		t.Error("source holiday should not be a business day")
+	}
+	if IsHoliday(d(2026, time.June, 15)) {
+		t.Error("AddSource changed the default calendar")
+	}
+
+	june := cal.HolidaysInMonth(2026, time.June)
+	if len(june) != 1 || !june[0].Date.Equal(d(2026, time.June, 15)) {
+		t.Errorf("HolidaysInMonth(June) = %v", june)
+	}
+	may := cal.HolidaysInMonth(2026, time.May)
+	if len(may) != 4 || may[1].Name != "社内みどりの日" {
+		t.Errorf("HolidaysInMonth(May) = %v", may)
+	}
+
+	if h, ok := cal.NextHoliday(d(2026, time.June, 1)); !ok || !h.Date.Equal(d(2026, time.June, 15)) {
+		t.Errorf("NextHoliday = %v, %v; want 2026-06-15", h, ok)
+	}
+	if h, ok := cal.PreviousHoliday(d(2026, time.July, 1)); !ok || !h.Date.Equal(d(2026, time.June, 15)) {
+		t.Errorf("PreviousHoliday = %v, %v; want 2026-06-15", h, ok)
+	}
+	if got := cal.Recent(d(2026, time.July, 1), 2); len(got) != 2 || !got[1].Date.Equal(d(2026, time.May, 6)) {
+		t.Errorf("Recent = %v", got)
+	}
+
+	// June 2026 has 22 weekdays; the source removes one.
+	if got := cal.BusinessDaysBetween(d(2026, time.June, 1), d(2026, time.June, 30)); got != 21 {
+		t.Errorf("BusinessDaysBetween(June) = %d, want 21", got)
+	}
+}
+
+func TestAddSource_Precedence(t *testing.T) {
+	t.Parallel()
+
+	cal := New()
+	cal.AddSource(mapSource{d(2026, time.June, 15): "first"})
+	cal.AddSource(mapSource{d(2026, time.June, 15): "second"})
+	if got := cal.HolidayName(d(2026, time.June, 15)); got != "second" {
+		t.Errorf("later source should win: got %q", got)
+	}
+	if got := cal.HolidaysInMonth(2026, time.June); len(got) != 1 || got[0].Name != "second" {
+		t.Errorf("HolidaysInMonth = %v", got)
+	}
+
+	cal.AddCustomHoliday(d(2026, time.June, 15), "custom")
+	if got := cal.HolidayName(d(2026, time.June, 15)); got != "custom" {
+		t.Errorf("custom holiday should win: got %q", got)
+	}
+	if got := cal.HolidaysInMonth(2026, time.June); len(got) != 1 || got[0].Name != "custom" {
+		t.Errorf("HolidaysInMonth = %v", got)
+	}
+
+	cal.RemoveCustomHoliday(d(2026, time.June, 15))
+	cal.RemoveHoliday(d(2026, time.June, 15))
+	if cal.IsHoliday(d(2026, time.June, 15)) || cal.HolidaysInMonth(2026, time.June) != nil {
+		t.Error("RemoveHoliday should suppress source holidays")
+	}
+}
+
+// countingSource counts lookups to show that sources are queried live.
+type countingSource struct {
+	mapSource
+	lookups atomic.Int32
+}
+
+func (c *countingSource) Lookup(date time.Time) (string, bool) {
+	c.lookups.Add(1)
+	return c.mapSource.Lookup(date)
+}
+
+func TestAddSource_Live(t *testing.T) {
+	t.Parallel()
+
+	src := &countingSource{mapSource: mapSource{}}
+	cal := New()
+	cal.AddSource(src)
+	if cal.IsHoliday(d(2026, time.June, 15)) {
+		t.Fatal("empty source reported a holiday")
+	}
+	src.mapSource[d(2026, time.June, 15)] = "追加"
+	if !cal.IsHoliday(d(2026, time.June, 15)) {
+		t.Error("changes in the source should be seen immediately")
+	}
+	if src.lookups.Load() == 0 {
+		t.Error("source was not consulted")
+	}
+
+	// Beyond the built-in dataset, source holidays are still found.
+	_, last := DatasetRange()
+	far := time.Date(last.Year()+1, time.March, 3, 0, 0, 0, 0, time.UTC)
+	src.mapSource[far] = "遠い祝日"
+	if h, ok := cal.NextHoliday(last); !ok || !h.Date.Equal(far) {
+		t.Errorf("NextHoliday past dataset = %v, %v; want %s", h, ok, far.Format(time.DateOnly))
+	}
+}
@@ -0,0 +1,95 @@
+package jpholiday
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"time"
+)
+
+// Date is a Japanese calendar date without a time of day, for storing
+// holidays in a DATE column of a relational database. It implements
+// [driver.Valuer] and [sql.Scanner].
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+var (
+	_ driver.Valuer = Date{}
+	_ sql.Scanner   = (*Date)(nil)
+	_ driver.Valuer = Holiday{}
+	_ sql.Scanner   = (*Holiday)(nil)
+)
+
+// DateOf returns the Japanese calendar date of t, after converting it to JST.
+func DateOf(t time.Time) Date {
+	y, m, d := t.In(jstZone).Date()
+	return Date{Year: y, Month: m, Day: d}
+}
+
+// Time returns midnight UTC at the start of d, as in [Holiday.Date].
+func (d Date) Time() time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, time.UTC)
+}
+
+// String returns d in the form YYYY-MM-DD.
+func (d Date) String() string { return d.Time().Format(time.DateOnly) }
+
+// Value implements [driver.Valuer], returning d as midnight UTC, which
+// drivers store in a DATE column as the date itself.
+func (d Date) Value() (driver.Value, error) { return d.Time(), nil }
+
+// Scan implements [sql.Scanner], reading a DATE column as a time.Time or a
+// text column in the form YYYY-MM-DD. The date of a time.Time is taken in
+// its own location, as drivers return DATE columns at midnight in UTC or in
+// the connection's location; a time of day after a textual date, as stored
+// by SQLite, is ignored.
+func (d *Date) Scan(src any) error {
+	switch v := src.(type) {
+	case time.Time:
+		y, m, day := v.Date()
+		*d = Date{Year: y, Month: m, Day: day}
+		return nil
+	case string:
+		return d.parse(v)
+	case []byte:
+		return d.parse(string(v))
+	case nil:
+		return fmt.Errorf("jpholiday: cannot scan NULL into Date")
+	}
+	return fmt.Errorf("jpholiday: cannot scan %T into Date", src)
+}
+
+func (d *Date) parse(s string) error {
+	if len(s) > len(time.DateOnly) && (s[len(time.DateOnly)] == ' ' || s[len(time.DateOnly)] == 'T') {
+		s = s[:len(time.DateOnly)]
+	}
+	t, err := time.Parse(time.DateOnly, s)
+	if err != nil {
+		return fmt.Errorf("jpholiday: invalid date %q (want YYYY-MM-DD)", s)
+	}
+	*d = DateOf(t)
+	return nil
+}
+
+// Value implements [driver.Valuer]. A holiday is stored as two columns, a
+// DATE and its name as TEXT; as a single value it stands for its date, the
+// key of a holiday table, so that h and h.Name bind the two columns:
+//
+//	db.ExecContext(ctx, "INSERT INTO holidays (date, name) VALUES (?, ?)", h, h.Name)
+func (h Holiday) Value() (driver.Value, error) { return DateOf(h.Date).Value() }
+
+// Scan implements [sql.Scanner], reading the date column of a holiday into
+// h.Date as [Date.Scan] does. Scan the name column into h.Name:
+//
+//	rows.Scan(&h, &h.Name)
+func (h *Holiday) Scan(src any) error {
+	var d Date
+	if err := d.Scan(src); err != nil {
+		return err
+	}
+	h.Date = d.Time()
+	return nil
+}
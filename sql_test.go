@@ -0,0 +1,84 @@
+package jpholiday_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/rabitt1ove/jp-holidays"
+)
+
+func TestDate(t *testing.T) {
+	t.Parallel()
+
+	// 2026-01-01 00:30 JST is still December 31 in UTC.
+	got := DateOf(time.Date(2025, time.December, 31, 15, 30, 0, 0, time.UTC))
+	if want := (Date{Year: 2026, Month: time.January, Day: 1}); got != want {
+		t.Errorf("DateOf() = %v, want %v", got, want)
+	}
+	if !got.Time().Equal(d(2026, time.January, 1)) || got.String() != "2026-01-01" {
+		t.Errorf("Time(), String() = %v, %q", got.Time(), got.String())
+	}
+}
+
+func TestDate_Scan(t *testing.T) {
+	t.Parallel()
+
+	want := Date{Year: 2026, Month: time.May, Day: 6}
+	for _, src := range []any{
+		time.Date(2026, time.May, 6, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, time.May, 6, 0, 0, 0, 0, time.FixedZone("EDT", -4*60*60)),
+		"2026-05-06",
+		[]byte("2026-05-06"),
+		"2026-05-06 00:00:00",
+		"2026-05-06T00:00:00Z",
+	} {
+		var got Date
+		if err := got.Scan(src); err != nil || got != want {
+			t.Errorf("Scan(%#v) = %v, %v, want %v", src, got, err, want)
+		}
+	}
+	for _, src := range []any{nil, 20260506, "2026-02-30", "2026/05/06"} {
+		var got Date
+		if err := got.Scan(src); err == nil {
+			t.Errorf("Scan(%#v) = %v, want error", src, got)
+		}
+	}
+}
+
+func TestDate_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	want := Date{Year: 2026, Month: time.November, Day: 3}
+	v, err := want.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !v.(time.Time).Equal(d(2026, time.November, 3)) {
+		t.Errorf("Value() = %v, want 2026-11-03 00:00 UTC", v)
+	}
+	var got Date
+	if err := got.Scan(v); err != nil || got != want {
+		t.Errorf("Scan(Value()) = %v, %v, want %v", got, err, want)
+	}
+}
+
+func TestHoliday_SQL(t *testing.T) {
+	t.Parallel()
+
+	h := Holiday{Date: d(2026, time.November, 3), Name: "文化の日"}
+	v, err := h.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !v.(time.Time).Equal(d(2026, time.November, 3)) {
+		t.Errorf("Value() = %v, want 2026-11-03 00:00 UTC", v)
+	}
+
+	got := Holiday{Name: h.Name}
+	if err := got.Scan("2026-11-03"); err != nil || got != h {
+		t.Errorf("Scan() = %v, %v, want %v", got, err, h)
+	}
+	if err := got.Scan(nil); err == nil {
+		t.Error("Scan(nil) = nil, want error")
+	}
+}
@@ -0,0 +1,37 @@
+package jpholiday
+
+import "time"
+
+// CalendarStats describes what a calendar holds, as returned by
+// [Calendar.Stats], for diagnosing a running process.
+type CalendarStats struct {
+	DatasetFirst    time.Time   // First date covered by the dataset, as from DatasetRange.
+	DatasetLast     time.Time   // Last date covered by the dataset.
+	HolidaysPerYear map[int]int // Holidays in each year that has any, including custom holidays.
+	CustomHolidays  int         // Holidays added with AddCustomHoliday.
+	RemovedHolidays int         // Built-in holidays removed with RemoveHoliday.
+	Closures        int         // Closures added with AddClosure.
+}
+
+// Stats returns the calendar's dataset range, the number of its holidays in
+// each year, and the numbers of its custom holidays, removed holidays, and
+// closures.
+func (c *Calendar) Stats() CalendarStats {
+	s := c.load()
+	data := s.data()
+	stats := CalendarStats{
+		DatasetFirst:    data.first.toTime(),
+		DatasetLast:     data.last.toTime(),
+		HolidaysPerYear: make(map[int]int),
+		CustomHolidays:  len(s.custom),
+		RemovedHolidays: len(s.removed),
+		Closures:        len(s.closed),
+	}
+	for _, h := range s.holidaysInRange(minDate, maxDate) {
+		stats.HolidaysPerYear[h.Date.Year()]++
+	}
+	return stats
+}
+
+// Stats returns statistics for the default calendar.
+func Stats() CalendarStats { return defaultCal.Stats() }
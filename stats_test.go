@@ -0,0 +1,37 @@
+package jpholiday_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/rabitt1ove/jp-holidays"
+)
+
+func TestStats(t *testing.T) {
+	t.Parallel()
+
+	cal := New()
+	cal.AddCustomHoliday(d(2026, time.December, 28), "創立記念日")
+	cal.AddCustomHoliday(d(2100, time.January, 4), "仕事始め休み")
+	cal.RemoveHoliday(d(2026, time.May, 6))
+	cal.AddClosure(d(2026, time.December, 29))
+	cal.AddClosure(d(2026, time.December, 30))
+
+	got := cal.Stats()
+	first, last := DatasetRange()
+	if !got.DatasetFirst.Equal(first) || !got.DatasetLast.Equal(last) {
+		t.Errorf("Stats() dataset = %v to %v, want %v to %v", got.DatasetFirst, got.DatasetLast, first, last)
+	}
+	if got.CustomHolidays != 2 || got.RemovedHolidays != 1 || got.Closures != 2 {
+		t.Errorf("Stats() custom, removed, closures = %d, %d, %d, want 2, 1, 2",
+			got.CustomHolidays, got.RemovedHolidays, got.Closures)
+	}
+	for year, want := range map[int]int{2025: 19, 2026: 18, 2100: 1, 2099: 0} {
+		if got.HolidaysPerYear[year] != want {
+			t.Errorf("Stats().HolidaysPerYear[%d] = %d, want %d", year, got.HolidaysPerYear[year], want)
+		}
+	}
+	if n := Stats().HolidaysPerYear[2026]; n != 18 {
+		t.Errorf("Stats().HolidaysPerYear[2026] on the default calendar = %d, want 18", n)
+	}
+}
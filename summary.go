@@ -0,0 +1,68 @@
+package jpholiday
+
+import "time"
+
+// YearStats summarizes the holidays and business days of a year, as
+// returned by [Calendar.YearSummary]: the numbers an HR department
+// publishes each January.
+type YearStats struct {
+	Year            int           // The year summarized.
+	Holidays        int           // Holidays in the year, including custom holidays.
+	WeekendHolidays int           // Holidays that fall on a weekend day.
+	Substitutes     int           // Substitute holidays (振替休日).
+	BusinessDays    int           // Business days in the year.
+	LongestBreak    HolidayPeriod // The first of the longest runs of non-business days.
+}
+
+// YearSummary returns statistics for the given year on the calendar,
+// reflecting its custom holidays, removed holidays, closures, and weekend.
+//
+// Substitute holidays are the built-in holidays named 休日 that follow a
+// run of holidays containing a Sunday; the dataset uses the same name for
+// the citizens' holidays (国民の休日) between two holidays, which are not
+// counted. LongestBreak may extend into the neighboring years, as
+// described for [Calendar.ConsecutiveHolidayPeriods].
+func (c *Calendar) YearSummary(year int) YearStats {
+	s := c.load()
+	stats := YearStats{
+		Year:         year,
+		BusinessDays: c.BusinessDaysBetween(newDate(year, time.January, 1).toTime(), newDate(year, time.December, 31).toTime()),
+	}
+	for _, h := range c.HolidaysInYear(year) {
+		d := dateFromTime(h.Date)
+		stats.Holidays++
+		if s.weekend.has(d.weekday()) {
+			stats.WeekendHolidays++
+		}
+		if s.substitute(d) {
+			stats.Substitutes++
+		}
+	}
+	for _, p := range c.ConsecutiveHolidayPeriods(year, 1) {
+		if p.Days > stats.LongestBreak.Days {
+			stats.LongestBreak = p
+		}
+	}
+	return stats
+}
+
+// substitute reports whether d is a built-in substitute holiday in s.
+func (s *snapshot) substitute(d date) bool {
+	if _, ok := s.custom[d]; ok || s.removed[d] || s.data().names[d] != "休日" {
+		return false
+	}
+	if _, ok := s.sourceLookup(d); ok {
+		return false
+	}
+	for p := d.addDays(-1); ; p = p.addDays(-1) {
+		if _, ok := s.lookup(p); !ok {
+			return false
+		}
+		if p.weekday() == time.Sunday {
+			return true
+		}
+	}
+}
+
+// YearSummary returns statistics for the given year on the default calendar.
+func YearSummary(year int) YearStats { return defaultCal.YearSummary(year) }
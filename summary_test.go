@@ -0,0 +1,58 @@
+package jpholiday_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/rabitt1ove/jp-holidays"
+)
+
+func TestYearSummary(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		year int
+		want YearStats
+	}{
+		{2026, YearStats{
+			Year:            2026,
+			Holidays:        18,
+			WeekendHolidays: 1, // 憲法記念日 on Sunday
+			Substitutes:     1, // 09-22 is a citizens' holiday, not a substitute
+			BusinessDays:    244,
+			LongestBreak:    HolidayPeriod{From: d(2026, time.May, 2), To: d(2026, time.May, 6), Days: 5},
+		}},
+		{2019, YearStats{
+			Year:            2019,
+			Holidays:        22,
+			WeekendHolidays: 5, // 05-04, 05-05, 08-11, 11-03, and 11-23
+			Substitutes:     3, // 05-06, 08-12, and 11-04
+			BusinessDays:    244,
+			LongestBreak:    HolidayPeriod{From: d(2019, time.April, 27), To: d(2019, time.May, 6), Days: 10},
+		}},
+	}
+	for _, tt := range tests {
+		if got := YearSummary(tt.year); got != tt.want {
+			t.Errorf("YearSummary(%d) = %+v, want %+v", tt.year, got, tt.want)
+		}
+	}
+}
+
+func TestYearSummary_CalendarState(t *testing.T) {
+	t.Parallel()
+
+	cal := New()
+	cal.RemoveHoliday(d(2026, time.May, 6))
+	cal.AddClosure(d(2026, time.May, 1))
+	got := cal.YearSummary(2026)
+	if got.Holidays != 17 || got.Substitutes != 0 {
+		t.Errorf("Holidays, Substitutes = %d, %d; want 17, 0", got.Holidays, got.Substitutes)
+	}
+	// 05-06 becomes a business day and 05-01 stops being one.
+	if got.BusinessDays != 244 {
+		t.Errorf("BusinessDays = %d, want 244", got.BusinessDays)
+	}
+	if want := (HolidayPeriod{From: d(2026, time.May, 1), To: d(2026, time.May, 5), Days: 5}); got.LongestBreak != want {
+		t.Errorf("LongestBreak = %+v, want %+v", got.LongestBreak, want)
+	}
+}
@@ -0,0 +1,419 @@
+package jpholiday
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// This file implements the subset of TOML used by calendar configuration
+// files, keeping the package free of external dependencies.
+//
+// Supported: key/value pairs with bare, quoted, and dotted keys; [tables]
+// and [[arrays of tables]]; basic and literal strings; integers; booleans;
+// local dates (decoded as "YYYY-MM-DD" strings); arrays, which may span
+// lines; and inline tables. Values decode to map[string]any, []any, string,
+// int64, or bool. Floats, times, and multi-line strings are not supported.
+
+type tomlParser struct {
+	root    map[string]any
+	cur     map[string]any
+	defined map[string]bool // explicitly declared [table] paths
+}
+
+// parseTOML decodes a TOML document into generic values.
+func parseTOML(r io.Reader) (map[string]any, error) {
+	p := &tomlParser{root: make(map[string]any), defined: make(map[string]bool)}
+	p.cur = p.root
+
+	sc := bufio.NewScanner(r)
+	num := 0
+	for sc.Scan() {
+		num++
+		start := num
+		line := stripTOMLComment(sc.Text())
+		if num == 1 {
+			line = strings.TrimPrefix(line, "\uFEFF")
+		}
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		if line[0] == '[' {
+			if err := p.header(line); err != nil {
+				return nil, fmt.Errorf("toml: line %d: %w", start, err)
+			}
+			continue
+		}
+
+		// Arrays and inline tables may continue onto following lines.
+		for tomlDepth(line) > 0 && sc.Scan() {
+			num++
+			line += "\n" + stripTOMLComment(sc.Text())
+		}
+		if err := p.keyValue(line); err != nil {
+			return nil, fmt.Errorf("toml: line %d: %w", start, err)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("toml: %w", err)
+	}
+	return p.root, nil
+}
+
+// stripTOMLComment removes a # comment that is outside strings.
+func stripTOMLComment(s string) string {
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			} else if c == '\\' && quote == '"' {
+				i++
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '#':
+			return s[:i]
+		}
+	}
+	return s
+}
+
+// tomlDepth returns the bracket nesting depth at the end of s, ignoring
+// brackets inside strings.
+func tomlDepth(s string) int {
+	depth := 0
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			} else if c == '\\' && quote == '"' {
+				i++
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '[' || c == '{':
+			depth++
+		case c == ']' || c == '}':
+			depth--
+		}
+	}
+	return depth
+}
+
+// header handles a [table] or [[array of tables]] line.
+func (p *tomlParser) header(line string) error {
+	array := strings.HasPrefix(line, "[[")
+	open, closing := "[", "]"
+	if array {
+		open, closing = "[[", "]]"
+	}
+	inner, ok := strings.CutSuffix(strings.TrimPrefix(line, open), closing)
+	if !ok {
+		return fmt.Errorf("malformed table header %s", line)
+	}
+
+	s := &tomlScanner{s: inner}
+	keys, err := s.key()
+	if err != nil {
+		return err
+	}
+	if s.skipSpace(); !s.done() {
+		return fmt.Errorf("malformed table header %s", line)
+	}
+
+	parent, err := tomlDescend(p.root, keys[:len(keys)-1])
+	if err != nil {
+		return err
+	}
+	last := keys[len(keys)-1]
+
+	if array {
+		var arr []any
+		switch v := parent[last].(type) {
+		case nil:
+		case []any:
+			arr = v
+		default:
+			return fmt.Errorf("key %q is already defined", strings.Join(keys, "."))
+		}
+		table := make(map[string]any)
+		parent[last] = append(arr, table)
+		p.cur = table
+		return nil
+	}
+
+	path := strings.Join(keys, ".")
+	if p.defined[path] {
+		return fmt.Errorf("table [%s] is defined twice", path)
+	}
+	p.defined[path] = true
+	table, err := tomlDescend(parent, []string{last})
+	if err != nil {
+		return err
+	}
+	p.cur = table
+	return nil
+}
+
+// tomlDescend walks the given keys from m, creating tables as needed. A key
+// holding an array of tables descends into its last element.
+func tomlDescend(m map[string]any, keys []string) (map[string]any, error) {
+	for _, k := range keys {
+		switch v := m[k].(type) {
+		case nil:
+			next := make(map[string]any)
+			m[k] = next
+			m = next
+		case map[string]any:
+			m = v
+		case []any:
+			if len(v) == 0 {
+				return nil, fmt.Errorf("key %q is not a table", k)
+			}
+			last, ok := v[len(v)-1].(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("key %q is not a table", k)
+			}
+			m = last
+		default:
+			return nil, fmt.Errorf("key %q is not a table", k)
+		}
+	}
+	return m, nil
+}
+
+// keyValue handles a "key = value" statement in the current table.
+func (p *tomlParser) keyValue(line string) error {
+	s := &tomlScanner{s: line}
+	if err := s.assign(p.cur); err != nil {
+		return err
+	}
+	if s.skipSpace(); !s.done() {
+		return fmt.Errorf("unexpected %q after value", s.rest())
+	}
+	return nil
+}
+
+// tomlScanner reads keys and values from a logical line.
+type tomlScanner struct {
+	s string
+	i int
+}
+
+func (s *tomlScanner) done() bool { return s.i >= len(s.s) }
+
+func (s *tomlScanner) peek() byte {
+	if s.done() {
+		return 0
+	}
+	return s.s[s.i]
+}
+
+func (s *tomlScanner) rest() string { return s.s[s.i:] }
+
+// skipSpace skips whitespace, including newlines inside arrays.
+func (s *tomlScanner) skipSpace() {
+	for !s.done() && strings.IndexByte(" \t\r\n", s.s[s.i]) >= 0 {
+		s.i++
+	}
+}
+
+// assign parses "key = value" and stores the value in m.
+func (s *tomlScanner) assign(m map[string]any) error {
+	keys, err := s.key()
+	if err != nil {
+		return err
+	}
+	if s.skipSpace(); s.peek() != '=' {
+		return fmt.Errorf("expected '=' after key %q", strings.Join(keys, "."))
+	}
+	s.i++
+	v, err := s.value()
+	if err != nil {
+		return err
+	}
+
+	parent, err := tomlDescend(m, keys[:len(keys)-1])
+	if err != nil {
+		return err
+	}
+	last := keys[len(keys)-1]
+	if _, dup := parent[last]; dup {
+		return fmt.Errorf("key %q is defined twice", strings.Join(keys, "."))
+	}
+	parent[last] = v
+	return nil
+}
+
+// key parses a possibly dotted key.
+func (s *tomlScanner) key() ([]string, error) {
+	var keys []string
+	for {
+		s.skipSpace()
+		var part string
+		switch s.peek() {
+		case '"', '\'':
+			v, err := s.str()
+			if err != nil {
+				return nil, err
+			}
+			part = v
+		default:
+			start := s.i
+			for !s.done() && isTOMLBareKeyChar(s.s[s.i]) {
+				s.i++
+			}
+			if s.i == start {
+				return nil, fmt.Errorf("expected key at %q", s.rest())
+			}
+			part = s.s[start:s.i]
+		}
+		keys = append(keys, part)
+		if s.skipSpace(); s.peek() != '.' {
+			return keys, nil
+		}
+		s.i++
+	}
+}
+
+func isTOMLBareKeyChar(c byte) bool {
+	return c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '_' || c == '-'
+}
+
+// value parses a single value.
+func (s *tomlScanner) value() (any, error) {
+	s.skipSpace()
+	switch s.peek() {
+	case 0:
+		return nil, fmt.Errorf("missing value")
+	case '"', '\'':
+		return s.str()
+	case '[':
+		return s.array()
+	case '{':
+		return s.inlineTable()
+	}
+
+	start := s.i
+	for !s.done() && strings.IndexByte(",]} \t\r\n", s.s[s.i]) < 0 {
+		s.i++
+	}
+	tok := s.s[start:s.i]
+	switch tok {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	}
+	if n, err := strconv.ParseInt(strings.ReplaceAll(tok, "_", ""), 10, 64); err == nil {
+		return n, nil
+	}
+	if isTOMLLocalDate(tok) {
+		return tok, nil
+	}
+	return nil, fmt.Errorf("value %q %w", tok, errUnsupportedSyntax)
+}
+
+// isTOMLLocalDate reports whether tok has the form YYYY-MM-DD. The date
+// itself is validated by the config decoder.
+func isTOMLLocalDate(tok string) bool {
+	if len(tok) != len("2006-01-02") || tok[4] != '-' || tok[7] != '-' {
+		return false
+	}
+	for i := 0; i < len(tok); i++ {
+		if i != 4 && i != 7 && (tok[i] < '0' || tok[i] > '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// str parses a basic ("...") or literal ('...') string.
+func (s *tomlScanner) str() (string, error) {
+	q := s.s[s.i]
+	if strings.HasPrefix(s.rest(), strings.Repeat(string(q), 3)) {
+		return "", fmt.Errorf("multi-line string %w", errUnsupportedSyntax)
+	}
+	for j := s.i + 1; j < len(s.s); j++ {
+		switch s.s[j] {
+		case '\\':
+			if q == '"' {
+				j++
+			}
+		case '\n':
+			return "", fmt.Errorf("unterminated string")
+		case q:
+			raw := s.s[s.i : j+1]
+			s.i = j + 1
+			if q == '\'' {
+				return raw[1 : len(raw)-1], nil
+			}
+			v, err := strconv.Unquote(raw)
+			if err != nil {
+				return "", fmt.Errorf("invalid string %s", raw)
+			}
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("unterminated string")
+}
+
+// array parses [v, v, ...]; a trailing comma is allowed.
+func (s *tomlScanner) array() ([]any, error) {
+	s.i++ // '['
+	items := []any{}
+	for {
+		if s.skipSpace(); s.peek() == ']' {
+			s.i++
+			return items, nil
+		}
+		v, err := s.value()
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, v)
+		s.skipSpace()
+		switch s.peek() {
+		case ',':
+			s.i++
+		case ']':
+		default:
+			return nil, fmt.Errorf("expected ',' or ']' in array")
+		}
+	}
+}
+
+// inlineTable parses {k = v, ...}.
+func (s *tomlScanner) inlineTable() (map[string]any, error) {
+	s.i++ // '{'
+	m := make(map[string]any)
+	if s.skipSpace(); s.peek() == '}' {
+		s.i++
+		return m, nil
+	}
+	for {
+		if err := s.assign(m); err != nil {
+			return nil, err
+		}
+		s.skipSpace()
+		switch s.peek() {
+		case ',':
+			s.i++
+		case '}':
+			s.i++
+			return m, nil
+		default:
+			return nil, fmt.Errorf("expected ',' or '}' in inline table")
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package jpholiday
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseTOML(t *testing.T) {
+	t.Parallel()
+
+	src := `a = 1_000
+b = "x # not a comment \u00e9"
+c = 'C:\path'
+"quoted key" = true
+d.e = [1, 'two',
+  2026-01-02,  # trailing comment
+]
+
+[t.u]
+v = { w = false, x.y = "z" }
+
+[[arr]]
+k = 1
+
+[[arr]]
+k = 2
+`
+	got, err := parseTOML(strings.NewReader(src))
+	if err != nil {
+		t.Fatalf("parseTOML error: %v", err)
+	}
+	want := map[string]any{
+		"a":          int64(1000),
+		"b":          "x # not a comment é",
+		"c":          `C:\path`,
+		"quoted key": true,
+		"d":          map[string]any{"e": []any{int64(1), "two", "2026-01-02"}},
+		"t": map[string]any{"u": map[string]any{
+			"v": map[string]any{"w": false, "x": map[string]any{"y": "z"}},
+		}},
+		"arr": []any{
+			map[string]any{"k": int64(1)},
+			map[string]any{"k": int64(2)},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseTOML =\n%#v\nwant\n%#v", got, want)
+	}
+}
+
+func TestParseTOML_Errors(t *testing.T) {
+	t.Parallel()
+
+	for _, src := range []string{
+		"a = \n",
+		"a = [1 2]\n",
+		"a = {b = 1\n",
+		"[a\n",
+		"a = 1\n[a]\n",
+		"a = 'unterminated\n",
+		"= 1\n",
+	} {
+		if _, err := parseTOML(strings.NewReader(src)); err == nil {
+			t.Errorf("parseTOML(%q): expected error", src)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package jpholiday
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// era is a Japanese era (元号) and the date it began.
+type era struct {
+	name    string // e.g. "令和"
+	initial string // Latin initial used in abbreviated dates, e.g. "R"
+	start   date
+}
+
+// eras lists the eras since the Gregorian calendar was adopted on 明治6年
+// (1873) January 1, newest first. Earlier Meiji dates followed the lunisolar
+// calendar and have no direct Gregorian equivalent.
+var eras = []era{
+	{"令和", "R", newDate(2019, time.May, 1)},
+	{"平成", "H", newDate(1989, time.January, 8)},
+	{"昭和", "S", newDate(1926, time.December, 25)},
+	{"大正", "T", newDate(1912, time.July, 30)},
+	{"明治", "M", newDate(1868, time.January, 1)}, // numbers years from 1868
+}
+
+// warekiFirst is the first date with a wareki form: 明治6年1月1日.
+var warekiFirst = newDate(1873, time.January, 1)
+
+// eraOf returns the index in eras of the era containing d.
+func eraOf(d date) (int, bool) {
+	if d.before(warekiFirst) {
+		return 0, false
+	}
+	for i, e := range eras {
+		if !d.before(e.start) {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// EraYear returns the Japanese era name (e.g. "令和") and the year within that
+// era for the given date, interpreted in JST. ok is false for dates before
+// 1873, when Japan adopted the Gregorian calendar.
+func EraYear(t time.Time) (era string, year int, ok bool) {
+	d := dateFromTime(t)
+	i, ok := eraOf(d)
+	if !ok {
+		return "", 0, false
+	}
+	e := eras[i]
+	return e.name, d.year() - e.start.year() + 1, true
+}
+
+// FormatWareki formats the given date, interpreted in JST, in the Japanese
+// era calendar (和暦) used by government forms and business documents, e.g.
+// "令和8年1月1日". The first year of an era is written 元年, as in
+// "令和元年5月1日". It returns an empty string for dates before 1873.
+func FormatWareki(t time.Time) string {
+	name, year, ok := EraYear(t)
+	if !ok {
+		return ""
+	}
+	y := "元"
+	if year > 1 {
+		y = strconv.Itoa(year)
+	}
+	_, m, day := dateFromTime(t).ymd()
+	return fmt.Sprintf("%s%s年%d月%d日", name, y, int(m), day)
+}
+
+// ParseWareki parses a date in the Japanese era calendar, either written out
+// ("令和8年1月1日", "令和元年5月1日") or abbreviated with the era's Latin
+// initial ("R8.1.1", "H31/4/30"). Full-width digits are accepted. The date
+// must fall within the named era, so "平成32年1月1日" is an error. The result
+// is at midnight UTC, like [Holiday.Date].
+func ParseWareki(s string) (time.Time, error) {
+	d, err := parseWareki(s)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return d.toTime(), nil
+}
+
+// parseWareki parses a wareki date; see ParseWareki.
+func parseWareki(s string) (date, error) {
+	in := s
+	s = strings.Map(halfWidthDigit, strings.TrimSpace(s))
+
+	i := -1
+	for j, e := range eras {
+		if rest, ok := strings.CutPrefix(s, e.name); ok {
+			i, s = j, rest
+			break
+		}
+		if len(s) > 0 && strings.EqualFold(s[:1], e.initial) {
+			i, s = j, s[1:]
+			break
+		}
+	}
+	if i < 0 {
+		return 0, fmt.Errorf("invalid wareki date %q (want e.g. 令和8年1月1日)", in)
+	}
+
+	var ys, ms, ds string
+	var ok bool
+	if strings.Contains(s, "年") {
+		var rest string
+		ys, rest, ok = strings.Cut(s, "年")
+		if ok {
+			ms, rest, ok = strings.Cut(rest, "月")
+		}
+		if ok {
+			ds, rest, ok = strings.Cut(rest, "日")
+			ok = ok && rest == ""
+		}
+		if ys == "元" {
+			ys = "1"
+		}
+	} else if parts := strings.Split(strings.NewReplacer("/", ".", "-", ".").Replace(s), "."); len(parts) == 3 {
+		ys, ms, ds, ok = parts[0], parts[1], parts[2], true
+	}
+	year, yerr := strconv.Atoi(ys)
+	month, merr := strconv.Atoi(ms)
+	day, derr := strconv.Atoi(ds)
+	if !ok || yerr != nil || merr != nil || derr != nil || year < 1 || year > 9999 {
+		return 0, fmt.Errorf("invalid wareki date %q (want e.g. 令和8年1月1日)", in)
+	}
+
+	e := eras[i]
+	t := time.Date(e.start.year()+year-1, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	if month < 1 || month > 12 || t.Day() != day {
+		return 0, fmt.Errorf("invalid wareki date %q", in)
+	}
+	d := newDate(t.Year(), t.Month(), day)
+	if j, ok := eraOf(d); !ok || j != i {
+		return 0, fmt.Errorf("wareki date %q is outside the %s era", in, e.name)
+	}
+	return d, nil
+}
+
+// halfWidthDigit maps full-width digits (０-９) to ASCII.
+func halfWidthDigit(r rune) rune {
+	if r >= '０' && r <= '９' {
+		return r - '０' + '0'
+	}
+	return r
+}
@@ -0,0 +1,109 @@
+package jpholiday_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/rabitt1ove/jp-holidays"
+)
+
+func TestFormatWareki(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		date time.Time
+		want string
+	}{
+		{d(2026, time.January, 1), "令和8年1月1日"},
+		{d(2019, time.May, 1), "令和元年5月1日"},
+		{d(2019, time.April, 30), "平成31年4月30日"},
+		{d(1989, time.January, 8), "平成元年1月8日"},
+		{d(1989, time.January, 7), "昭和64年1月7日"},
+		{d(1926, time.December, 25), "昭和元年12月25日"},
+		{d(1912, time.July, 30), "大正元年7月30日"},
+		{d(1912, time.July, 29), "明治45年7月29日"},
+		{d(1873, time.January, 1), "明治6年1月1日"},
+		{d(1872, time.December, 31), ""},
+		// 2019-04-30 15:00 UTC is already 令和 in JST.
+		{time.Date(2019, time.April, 30, 15, 0, 0, 0, time.UTC), "令和元年5月1日"},
+	}
+	for _, tt := range tests {
+		if got := FormatWareki(tt.date); got != tt.want {
+			t.Errorf("FormatWareki(%s) = %q, want %q", tt.date.Format(time.RFC3339), got, tt.want)
+		}
+	}
+}
+
+func TestEraYear(t *testing.T) {
+	t.Parallel()
+
+	if era, year, ok := EraYear(d(2026, time.May, 3)); era != "令和" || year != 8 || !ok {
+		t.Errorf("EraYear(2026-05-03) = %q, %d, %v", era, year, ok)
+	}
+	if _, _, ok := EraYear(d(1800, time.January, 1)); ok {
+		t.Error("EraYear(1800-01-01) ok = true")
+	}
+}
+
+func TestParseWareki(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in   string
+		want time.Time
+	}{
+		{"令和8年1月1日", d(2026, time.January, 1)},
+		{"令和元年5月1日", d(2019, time.May, 1)},
+		{" 平成31年4月30日 ", d(2019, time.April, 30)},
+		{"令和８年１２月３１日", d(2026, time.December, 31)},
+		{"R8.1.1", d(2026, time.January, 1)},
+		{"h31/4/30", d(2019, time.April, 30)},
+		{"S64-01-07", d(1989, time.January, 7)},
+		{"明治6年1月1日", d(1873, time.January, 1)},
+	}
+	for _, tt := range tests {
+		got, err := ParseWareki(tt.in)
+		if err != nil {
+			t.Errorf("ParseWareki(%q) error: %v", tt.in, err)
+			continue
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("ParseWareki(%q) = %s, want %s", tt.in, got.Format(time.DateOnly), tt.want.Format(time.DateOnly))
+		}
+	}
+}
+
+func TestParseWareki_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	for _, h := range HolidaysInYear(2019) {
+		got, err := ParseWareki(FormatWareki(h.Date))
+		if err != nil || !got.Equal(h.Date) {
+			t.Errorf("ParseWareki(FormatWareki(%s)) = %s, %v", h.Date.Format(time.DateOnly), got, err)
+		}
+	}
+}
+
+func TestParseWareki_Errors(t *testing.T) {
+	t.Parallel()
+
+	for _, in := range []string{
+		"",
+		"2026-01-01",
+		"令和",
+		"令和8年1月",
+		"令和8年1月1日です",
+		"令和0年1月1日",
+		"令和8年13月1日",
+		"令和8年2月29日",
+		"平成32年1月1日",  // after 令和 began
+		"令和元年4月30日",  // before 令和 began
+		"明治5年12月31日", // lunisolar calendar
+		"R8.1",
+		"X8.1.1",
+	} {
+		if got, err := ParseWareki(in); err == nil {
+			t.Errorf("ParseWareki(%q) = %s, want error", in, got.Format(time.DateOnly))
+		}
+	}
+}
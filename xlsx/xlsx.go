@@ -0,0 +1,256 @@
+// Package xlsx exports yearly holiday and business-day summaries as Excel
+// (.xlsx) workbooks.
+//
+// Each year gets its own sheet listing the holidays in that year alongside
+// per-month counts of days, non-business days, and business days:
+//
+//	f, _ := os.Create("holidays.xlsx")
+//	defer f.Close()
+//	xlsx.Write(f, 2025, 2026, xlsx.Options{})
+//
+// The workbook is written with the standard library only; it uses inline
+// strings and a single date style, which Excel, LibreOffice, and Google
+// Sheets all open without conversion.
+package xlsx
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+)
+
+// maxYears limits how many sheets a single workbook may contain.
+const maxYears = 200
+
+// Options configures workbook export.
+type Options struct {
+	// Calendar supplies holidays and business days. If nil, the
+	// package-level default calendar is used.
+	Calendar *jpholiday.Calendar
+}
+
+// calendar is the subset of [jpholiday.Calendar] used for export.
+type calendar interface {
+	HolidaysInYear(year int) []jpholiday.Holiday
+	IsBusinessDay(t time.Time) bool
+}
+
+func (o Options) calendar() calendar {
+	if o.Calendar != nil {
+		return o.Calendar
+	}
+	return jpholiday.Default()
+}
+
+// Write writes a workbook with one sheet per year from "from" to "to"
+// inclusive. Sheets are named after the year.
+//
+// Columns A–C list each holiday's date, weekday, and name. Columns E–H give,
+// for each month, the number of days, non-business days (weekends and
+// holidays), and business days, followed by a yearly total.
+func Write(w io.Writer, from, to int, opts Options) (err error) {
+	if to < from {
+		return fmt.Errorf("xlsx: to year %d is before from year %d", to, from)
+	}
+	if to-from >= maxYears {
+		return fmt.Errorf("xlsx: more than %d years requested", maxYears)
+	}
+
+	cal := opts.calendar()
+	var years []int
+	for y := from; y <= to; y++ {
+		years = append(years, y)
+	}
+
+	zw := zip.NewWriter(w)
+	defer func() {
+		if cerr := zw.Close(); err == nil && cerr != nil {
+			err = fmt.Errorf("xlsx: %w", cerr)
+		}
+	}()
+
+	parts := []part{
+		{"[Content_Types].xml", contentTypes(len(years))},
+		{"_rels/.rels", rootRels},
+		{"xl/workbook.xml", workbook(years)},
+		{"xl/_rels/workbook.xml.rels", workbookRels(len(years))},
+		{"xl/styles.xml", styles},
+	}
+	for i, y := range years {
+		parts = append(parts, part{fmt.Sprintf("xl/worksheets/sheet%d.xml", i+1), sheet(cal, y)})
+	}
+
+	for _, p := range parts {
+		f, err := zw.Create(p.name)
+		if err != nil {
+			return fmt.Errorf("xlsx: %w", err)
+		}
+		if _, err := io.WriteString(f, p.body); err != nil {
+			return fmt.Errorf("xlsx: %w", err)
+		}
+	}
+	return nil
+}
+
+// part is a single file in the workbook package.
+type part struct {
+	name string
+	body string
+}
+
+const xmlHeader = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n"
+
+const rootRels = xmlHeader +
+	`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+	`</Relationships>`
+
+// styles defines two cell formats: 0 is the default, 1 is a yyyy/mm/dd date.
+const styles = xmlHeader +
+	`<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+	`<numFmts count="1"><numFmt numFmtId="164" formatCode="yyyy/mm/dd"/></numFmts>` +
+	`<fonts count="1"><font><sz val="11"/><name val="Calibri"/></font></fonts>` +
+	`<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>` +
+	`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+	`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+	`<cellXfs count="2">` +
+	`<xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>` +
+	`<xf numFmtId="164" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+	`</cellXfs>` +
+	`</styleSheet>`
+
+// dateStyle is the cellXfs index of the date format in styles.
+const dateStyle = 1
+
+func contentTypes(sheets int) string {
+	var b strings.Builder
+	b.WriteString(xmlHeader)
+	b.WriteString(`<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">`)
+	b.WriteString(`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>`)
+	b.WriteString(`<Default Extension="xml" ContentType="application/xml"/>`)
+	b.WriteString(`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>`)
+	b.WriteString(`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>`)
+	for i := 1; i <= sheets; i++ {
+		fmt.Fprintf(&b, `<Override PartName="/xl/worksheets/sheet%d.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>`, i)
+	}
+	b.WriteString(`</Types>`)
+	return b.String()
+}
+
+func workbook(years []int) string {
+	var b strings.Builder
+	b.WriteString(xmlHeader)
+	b.WriteString(`<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets>`)
+	for i, y := range years {
+		fmt.Fprintf(&b, `<sheet name="%d" sheetId="%d" r:id="rId%d"/>`, y, i+1, i+1)
+	}
+	b.WriteString(`</sheets></workbook>`)
+	return b.String()
+}
+
+func workbookRels(sheets int) string {
+	var b strings.Builder
+	b.WriteString(xmlHeader)
+	b.WriteString(`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">`)
+	for i := 1; i <= sheets; i++ {
+		fmt.Fprintf(&b, `<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet%d.xml"/>`, i, i)
+	}
+	fmt.Fprintf(&b, `<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>`, sheets+1)
+	b.WriteString(`</Relationships>`)
+	return b.String()
+}
+
+// cellValue is a single cell: a string, an integer, or a date.
+type cellValue struct {
+	col  byte // 'A'..'Z'
+	str  string
+	num  int
+	date time.Time
+	kind byte // 's', 'n', or 'd'
+}
+
+func str(col byte, s string) cellValue { return cellValue{col: col, str: s, kind: 's'} }
+func num(col byte, n int) cellValue    { return cellValue{col: col, num: n, kind: 'n'} }
+func day(col byte, t time.Time) cellValue {
+	return cellValue{col: col, date: t, kind: 'd'}
+}
+
+// excelEpoch is day zero of the 1900 date system as used by Excel.
+var excelEpoch = time.Date(1899, time.December, 30, 0, 0, 0, 0, time.UTC)
+
+// serial converts a date to an Excel serial day number.
+func serial(t time.Time) int {
+	return int(t.Sub(excelEpoch).Hours() / 24)
+}
+
+// sheet renders the worksheet for one year.
+func sheet(cal calendar, year int) string {
+	rows := make(map[int][]cellValue)
+	add := func(row int, cells ...cellValue) { rows[row] = append(rows[row], cells...) }
+
+	add(1, str('A', "日付"), str('B', "曜日"), str('C', "祝日名"))
+	for i, h := range cal.HolidaysInYear(year) {
+		add(i+2, day('A', h.Date), str('B', jpholiday.WeekdayLabel(h.Date.Weekday())), str('C', h.Name))
+	}
+
+	add(1, str('E', "月"), str('F', "日数"), str('G', "休日数"), str('H', "営業日数"))
+	var totalDays, totalBusiness int
+	for m := time.January; m <= time.December; m++ {
+		first := time.Date(year, m, 1, 0, 0, 0, 0, time.UTC)
+		days := first.AddDate(0, 1, -1).Day()
+		business := 0
+		for d := 0; d < days; d++ {
+			if cal.IsBusinessDay(first.AddDate(0, 0, d)) {
+				business++
+			}
+		}
+		totalDays += days
+		totalBusiness += business
+		add(int(m)+1, str('E', fmt.Sprintf("%d月", m)), num('F', days), num('G', days-business), num('H', business))
+	}
+	add(14, str('E', "合計"), num('F', totalDays), num('G', totalDays-totalBusiness), num('H', totalBusiness))
+
+	last := 14
+	for r := range rows {
+		last = max(last, r)
+	}
+
+	var b strings.Builder
+	b.WriteString(xmlHeader)
+	b.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">`)
+	b.WriteString(`<cols><col min="1" max="1" width="12" customWidth="1"/><col min="3" max="3" width="20" customWidth="1"/></cols>`)
+	b.WriteString(`<sheetData>`)
+	for r := 1; r <= last; r++ {
+		cells := rows[r]
+		if len(cells) == 0 {
+			continue
+		}
+		fmt.Fprintf(&b, `<row r="%d">`, r)
+		for _, c := range cells {
+			writeCell(&b, r, c)
+		}
+		b.WriteString(`</row>`)
+	}
+	b.WriteString(`</sheetData></worksheet>`)
+	return b.String()
+}
+
+func writeCell(b *strings.Builder, row int, c cellValue) {
+	ref := string(c.col) + strconv.Itoa(row)
+	switch c.kind {
+	case 's':
+		fmt.Fprintf(b, `<c r="%s" t="inlineStr"><is><t>`, ref)
+		_ = xml.EscapeText(b, []byte(c.str)) // strings.Builder writes never fail
+		b.WriteString(`</t></is></c>`)
+	case 'n':
+		fmt.Fprintf(b, `<c r="%s"><v>%d</v></c>`, ref, c.num)
+	case 'd':
+		fmt.Fprintf(b, `<c r="%s" s="%d"><v>%d</v></c>`, ref, dateStyle, serial(c.date))
+	}
+}
@@ -0,0 +1,138 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	jpholiday "github.com/rabitt1ove/jp-holidays"
+)
+
+// readPart returns the contents of a file in the workbook archive.
+func readPart(t *testing.T, zr *zip.Reader, name string) string {
+	t.Helper()
+	f, err := zr.Open(name)
+	if err != nil {
+		t.Fatalf("open %s: %v", name, err)
+	}
+	defer f.Close()
+	b, err := io.ReadAll(f)
+	if err != nil {
+		t.Fatalf("read %s: %v", name, err)
+	}
+	return string(b)
+}
+
+// sheetCells parses a worksheet into a map from cell reference to value.
+func sheetCells(t *testing.T, src string) map[string]string {
+	t.Helper()
+	var ws struct {
+		Rows []struct {
+			Cells []struct {
+				Ref    string `xml:"r,attr"`
+				Value  string `xml:"v"`
+				Inline string `xml:"is>t"`
+			} `xml:"c"`
+		} `xml:"sheetData>row"`
+	}
+	if err := xml.Unmarshal([]byte(src), &ws); err != nil {
+		t.Fatalf("unmarshal worksheet: %v", err)
+	}
+	cells := make(map[string]string)
+	for _, r := range ws.Rows {
+		for _, c := range r.Cells {
+			cells[c.Ref] = c.Value + c.Inline
+		}
+	}
+	return cells
+}
+
+func TestWrite(t *testing.T) {
+	t.Parallel()
+
+	cal := jpholiday.New()
+	cal.AddCustomHoliday(time.Date(2026, time.June, 15, 0, 0, 0, 0, time.UTC), "創立記念日 <本社>")
+
+	var buf bytes.Buffer
+	if err := Write(&buf, 2025, 2026, Options{Calendar: cal}); err != nil {
+		t.Fatalf("Write error: %v", err)
+	}
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("output is not a zip archive: %v", err)
+	}
+
+	for _, name := range []string{"[Content_Types].xml", "_rels/.rels", "xl/workbook.xml", "xl/_rels/workbook.xml.rels", "xl/styles.xml"} {
+		if src := readPart(t, zr, name); !strings.HasPrefix(src, "<?xml") {
+			t.Errorf("%s does not start with an XML declaration", name)
+		}
+	}
+
+	wb := readPart(t, zr, "xl/workbook.xml")
+	if !strings.Contains(wb, `<sheet name="2025" sheetId="1" r:id="rId1"/>`) ||
+		!strings.Contains(wb, `<sheet name="2026" sheetId="2" r:id="rId2"/>`) {
+		t.Errorf("workbook should contain sheets 2025 and 2026, got %s", wb)
+	}
+
+	cells := sheetCells(t, readPart(t, zr, "xl/worksheets/sheet2.xml"))
+	want := map[string]string{
+		"A1": "日付",
+		"A2": "46023", // 2026-01-01
+		"B2": "木",
+		"C2": "元日",
+		"E2": "1月",
+		"F2": "31",
+		// January 2026: 5 Saturdays, 4 Sundays, 元日 (Thu), and 成人の日 (Mon).
+		"G2":  "11",
+		"H2":  "20",
+		"E14": "合計",
+		"F14": "365",
+	}
+	for ref, v := range want {
+		if cells[ref] != v {
+			t.Errorf("cell %s = %q, want %q", ref, cells[ref], v)
+		}
+	}
+
+	found := false
+	for ref, v := range cells {
+		if v == "創立記念日 <本社>" && strings.HasPrefix(ref, "C") {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("custom holiday should be listed and escaped correctly")
+	}
+}
+
+func TestWrite_InvalidRange(t *testing.T) {
+	t.Parallel()
+
+	if err := Write(io.Discard, 2026, 2025, Options{}); err == nil {
+		t.Error("expected error for reversed year range")
+	}
+	if err := Write(io.Discard, 1, 1000, Options{}); err == nil {
+		t.Error("expected error for too many years")
+	}
+}
+
+func TestSerial(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		date time.Time
+		want int
+	}{
+		{time.Date(1900, time.March, 1, 0, 0, 0, 0, time.UTC), 61},
+		{time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC), 46023},
+	}
+	for _, tt := range tests {
+		if got := serial(tt.date); got != tt.want {
+			t.Errorf("serial(%s) = %d, want %d", tt.date.Format("2006-01-02"), got, tt.want)
+		}
+	}
+}
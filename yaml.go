@@ -0,0 +1,368 @@
+package jpholiday
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// This file implements the small subset of YAML used by calendar
+// configuration files, keeping the package free of external dependencies.
+//
+// Supported: block mappings, block sequences (including sequences of
+// mappings), flow sequences of scalars ([a, b]), single- and double-quoted
+// scalars, plain scalars, and # comments. Values decode to map[string]any,
+// []any, string, int64, bool, or nil. Anchors, aliases, tags, multi-line
+// scalars, flow mappings, and multiple documents are not supported.
+
+// yamlLine is a non-blank, comment-stripped source line.
+type yamlLine struct {
+	num    int // 1-based line number in the source
+	indent int
+	text   string
+}
+
+type yamlParser struct {
+	lines []yamlLine
+	pos   int
+}
+
+// parseYAML decodes a YAML document into generic values.
+func parseYAML(r io.Reader) (any, error) {
+	lines, err := readYAMLLines(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(lines) == 0 {
+		return nil, nil
+	}
+	p := &yamlParser{lines: lines}
+	v, err := p.parseBlock(lines[0].indent)
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.lines) {
+		l := p.lines[p.pos]
+		return nil, fmt.Errorf("yaml: line %d: unexpected indentation", l.num)
+	}
+	return v, nil
+}
+
+func readYAMLLines(r io.Reader) ([]yamlLine, error) {
+	var lines []yamlLine
+	sc := bufio.NewScanner(r)
+	num := 0
+	for sc.Scan() {
+		num++
+		raw := strings.TrimRight(sc.Text(), " \t\r")
+		if num == 1 {
+			raw = strings.TrimPrefix(raw, "\uFEFF")
+		}
+		if raw == "---" {
+			continue
+		}
+		text := stripYAMLComment(raw)
+		trimmed := strings.TrimLeft(text, " ")
+		if strings.TrimSpace(trimmed) == "" {
+			continue
+		}
+		if strings.HasPrefix(trimmed, "\t") {
+			return nil, fmt.Errorf("yaml: line %d: tabs are not allowed for indentation", num)
+		}
+		lines = append(lines, yamlLine{
+			num:    num,
+			indent: len(text) - len(trimmed),
+			text:   strings.TrimRight(trimmed, " \t"),
+		})
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("yaml: %w", err)
+	}
+	return lines, nil
+}
+
+// stripYAMLComment removes a trailing # comment that is outside quotes.
+func stripYAMLComment(s string) string {
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			} else if c == '\\' && quote == '"' {
+				i++
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '#' && (i == 0 || s[i-1] == ' ' || s[i-1] == '\t'):
+			return s[:i]
+		}
+	}
+	return s
+}
+
+func isYAMLSeqItem(text string) bool {
+	return text == "-" || strings.HasPrefix(text, "- ")
+}
+
+// parseBlock parses the mapping or sequence starting at the current line,
+// which must be at the given indentation.
+func (p *yamlParser) parseBlock(indent int) (any, error) {
+	if isYAMLSeqItem(p.lines[p.pos].text) {
+		return p.parseSequence(indent)
+	}
+	return p.parseMapping(indent)
+}
+
+func (p *yamlParser) parseSequence(indent int) (any, error) {
+	var items []any
+	for p.pos < len(p.lines) {
+		l := p.lines[p.pos]
+		if l.indent < indent {
+			break
+		}
+		if l.indent > indent {
+			return nil, fmt.Errorf("yaml: line %d: unexpected indentation", l.num)
+		}
+		if !isYAMLSeqItem(l.text) {
+			break
+		}
+
+		rest := strings.TrimLeft(strings.TrimPrefix(l.text, "-"), " ")
+		if rest == "" {
+			p.pos++
+			v, err := p.parseNested(indent)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, v)
+			continue
+		}
+
+		if _, _, ok := splitYAMLKey(rest); ok {
+			// "- key: value" starts a mapping whose keys align with "key".
+			childIndent := indent + (len(l.text) - len(rest))
+			p.lines[p.pos] = yamlLine{num: l.num, indent: childIndent, text: rest}
+			v, err := p.parseMapping(childIndent)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, v)
+			continue
+		}
+
+		v, err := parseYAMLScalar(rest, l.num)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, v)
+		p.pos++
+	}
+	return items, nil
+}
+
+func (p *yamlParser) parseMapping(indent int) (any, error) {
+	m := make(map[string]any)
+	for p.pos < len(p.lines) {
+		l := p.lines[p.pos]
+		if l.indent < indent {
+			break
+		}
+		if l.indent > indent {
+			return nil, fmt.Errorf("yaml: line %d: unexpected indentation", l.num)
+		}
+		if isYAMLSeqItem(l.text) {
+			return nil, fmt.Errorf("yaml: line %d: unexpected sequence item in mapping", l.num)
+		}
+
+		key, value, ok := splitYAMLKey(l.text)
+		if !ok {
+			return nil, fmt.Errorf("yaml: line %d: expected \"key: value\"", l.num)
+		}
+		if _, dup := m[key]; dup {
+			return nil, fmt.Errorf("yaml: line %d: duplicate key %q", l.num, key)
+		}
+		p.pos++
+
+		if value != "" {
+			v, err := parseYAMLScalar(value, l.num)
+			if err != nil {
+				return nil, err
+			}
+			m[key] = v
+			continue
+		}
+
+		// A sequence may sit at the same indentation as its parent key.
+		if p.pos < len(p.lines) && p.lines[p.pos].indent == indent && isYAMLSeqItem(p.lines[p.pos].text) {
+			v, err := p.parseSequence(indent)
+			if err != nil {
+				return nil, err
+			}
+			m[key] = v
+			continue
+		}
+		v, err := p.parseNested(indent)
+		if err != nil {
+			return nil, err
+		}
+		m[key] = v
+	}
+	return m, nil
+}
+
+// parseNested parses a block indented deeper than parent, or returns nil if
+// the next line is not indented further (an empty value).
+func (p *yamlParser) parseNested(parent int) (any, error) {
+	if p.pos >= len(p.lines) || p.lines[p.pos].indent <= parent {
+		return nil, nil
+	}
+	return p.parseBlock(p.lines[p.pos].indent)
+}
+
+// splitYAMLKey splits "key: value" or "key:" into its parts.
+func splitYAMLKey(text string) (key, value string, ok bool) {
+	var rawKey string
+	switch text[0] {
+	case '"', '\'':
+		end := closingQuote(text)
+		if end < 0 || end+1 >= len(text) || text[end+1] != ':' {
+			return "", "", false
+		}
+		rawKey, value = text[:end+1], text[end+2:]
+	default:
+		i := strings.Index(text, ": ")
+		switch {
+		case i >= 0:
+			rawKey, value = text[:i], text[i+2:]
+		case strings.HasSuffix(text, ":"):
+			rawKey = text[:len(text)-1]
+		default:
+			return "", "", false
+		}
+		if strings.ContainsAny(rawKey, "[]{}") {
+			return "", "", false
+		}
+	}
+	k, err := unquoteYAML(strings.TrimSpace(rawKey))
+	if err != nil || k == "" {
+		return "", "", false
+	}
+	return k, strings.TrimSpace(value), true
+}
+
+// closingQuote returns the index of the quote closing the string starting at
+// s[0], or -1 if unterminated.
+func closingQuote(s string) int {
+	q := s[0]
+	for i := 1; i < len(s); i++ {
+		switch {
+		case q == '"' && s[i] == '\\':
+			i++
+		case q == '\'' && s[i] == '\'' && i+1 < len(s) && s[i+1] == '\'':
+			i++
+		case s[i] == q:
+			return i
+		}
+	}
+	return -1
+}
+
+func unquoteYAML(s string) (string, error) {
+	if s == "" {
+		return s, nil
+	}
+	switch s[0] {
+	case '"':
+		return strconv.Unquote(s)
+	case '\'':
+		if len(s) < 2 || s[len(s)-1] != '\'' {
+			return "", fmt.Errorf("unterminated string %s", s)
+		}
+		return strings.ReplaceAll(s[1:len(s)-1], "''", "'"), nil
+	}
+	return s, nil
+}
+
+// parseYAMLScalar decodes an inline value: a flow sequence or a scalar.
+func parseYAMLScalar(s string, line int) (any, error) {
+	switch s[0] {
+	case '[':
+		if s[len(s)-1] != ']' {
+			return nil, fmt.Errorf("yaml: line %d: unterminated flow sequence", line)
+		}
+		return parseYAMLFlowSeq(s[1:len(s)-1], line)
+	case '{':
+		return nil, fmt.Errorf("yaml: line %d: flow mapping %w", line, errUnsupportedSyntax)
+	case '&':
+		return nil, fmt.Errorf("yaml: line %d: anchor %w", line, errUnsupportedSyntax)
+	case '*':
+		return nil, fmt.Errorf("yaml: line %d: alias %w", line, errUnsupportedSyntax)
+	case '!':
+		return nil, fmt.Errorf("yaml: line %d: tag %w", line, errUnsupportedSyntax)
+	case '|', '>':
+		return nil, fmt.Errorf("yaml: line %d: multi-line scalar %w", line, errUnsupportedSyntax)
+	case '"', '\'':
+		if end := closingQuote(s); end != len(s)-1 {
+			return nil, fmt.Errorf("yaml: line %d: invalid quoted string %s", line, s)
+		}
+		v, err := unquoteYAML(s)
+		if err != nil {
+			return nil, fmt.Errorf("yaml: line %d: %w", line, err)
+		}
+		return v, nil
+	}
+
+	switch s {
+	case "~", "null", "Null", "NULL":
+		return nil, nil
+	case "true", "True", "TRUE":
+		return true, nil
+	case "false", "False", "FALSE":
+		return false, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return n, nil
+	}
+	return s, nil
+}
+
+func parseYAMLFlowSeq(s string, line int) (any, error) {
+	items := []any{}
+	s = strings.TrimSpace(s)
+	for s != "" {
+		var item string
+		if s[0] == '"' || s[0] == '\'' {
+			end := closingQuote(s)
+			if end < 0 {
+				return nil, fmt.Errorf("yaml: line %d: unterminated string in flow sequence", line)
+			}
+			item, s = s[:end+1], strings.TrimSpace(s[end+1:])
+			if s != "" && s[0] != ',' {
+				return nil, fmt.Errorf("yaml: line %d: expected ',' in flow sequence", line)
+			}
+		} else {
+			i := strings.IndexByte(s, ',')
+			if i < 0 {
+				i = len(s)
+			}
+			item, s = strings.TrimSpace(s[:i]), s[i:]
+		}
+		s = strings.TrimSpace(strings.TrimPrefix(s, ","))
+		if item == "" {
+			return nil, fmt.Errorf("yaml: line %d: empty item in flow sequence", line)
+		}
+		if item[0] == '[' || item[0] == '{' {
+			return nil, fmt.Errorf("yaml: line %d: nested flow collection %w", line, errUnsupportedSyntax)
+		}
+		v, err := parseYAMLScalar(item, line)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, v)
+	}
+	return items, nil
+}
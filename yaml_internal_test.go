@@ -0,0 +1,53 @@
+package jpholiday
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseYAML(t *testing.T) {
+	t.Parallel()
+
+	src := `---
+a: 1
+b: "x # not a comment"
+c: 'it''s'
+d: [1, "two", three]
+e:
+  f: true
+  g: ~
+h:
+- k: v
+  l: -3
+-
+  - nested
+`
+	got, err := parseYAML(strings.NewReader(src))
+	if err != nil {
+		t.Fatalf("parseYAML error: %v", err)
+	}
+	want := map[string]any{
+		"a": int64(1),
+		"b": "x # not a comment",
+		"c": "it's",
+		"d": []any{int64(1), "two", "three"},
+		"e": map[string]any{"f": true, "g": nil},
+		"h": []any{
+			map[string]any{"k": "v", "l": int64(-3)},
+			[]any{"nested"},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseYAML =\n%#v\nwant\n%#v", got, want)
+	}
+}
+
+func TestParseYAML_DuplicateKey(t *testing.T) {
+	t.Parallel()
+
+	_, err := parseYAML(strings.NewReader("a: 1\na: 2\n"))
+	if err == nil || !strings.Contains(err.Error(), "duplicate key") {
+		t.Errorf("expected duplicate key error, got %v", err)
+	}
+}
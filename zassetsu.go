@@ -0,0 +1,81 @@
+package jpholiday
+
+import (
+	"slices"
+	"time"
+)
+
+// SeasonalDay represents a traditional seasonal marker (雑節), such as 節分
+// or 土用の丑の日. Seasonal days are calculated, not legal holidays.
+type SeasonalDay struct {
+	Date time.Time // The date of the seasonal day (midnight UTC).
+	Name string    // The Japanese name of the seasonal day (e.g., "節分").
+}
+
+// Solar longitudes, in degrees, of the solar terms that seasonal days are
+// reckoned from.
+const (
+	lonShunbun   = 0   // 春分
+	lonNyubai    = 80  // 入梅
+	lonHangesho  = 100 // 半夏生
+	lonShubun    = 180 // 秋分
+	lonRisshun   = 315 // 立春
+	lonDoyoStart = 27  // 春の土用入り; the others follow every 90°
+)
+
+// SeasonalDays returns the seasonal days (雑節) of the given year, sorted by
+// date:
+//
+//   - 節分: the day before 立春
+//   - 彼岸入り and 彼岸明け: three days before and after 春分 and 秋分
+//   - 八十八夜, 二百十日, 二百二十日: the 88th, 210th, and 220th days from 立春
+//   - 入梅 and 半夏生: when the Sun reaches 80° and 100°
+//   - 土用入り: the start of each of the four 土用 periods, the 18 or so days
+//     before 立春, 立夏, 立秋, and 立冬
+//   - 土用の丑の日: each day of the ox (丑) within a 土用 period
+//
+// Dates follow the Sun's apparent longitude as computed for [Rokuyo], in
+// JST. It returns nil for years outside 1900–2100.
+func SeasonalDays(year int) []SeasonalDay {
+	if year < astroFirstYear || year > astroLastYear {
+		return nil
+	}
+	var days []SeasonalDay
+	add := func(d date, name string) {
+		days = append(days, SeasonalDay{Date: d.toTime(), Name: name})
+	}
+
+	risshun := solarTermDate(year, lonRisshun)
+	add(risshun.addDays(-1), "節分")
+	add(risshun.addDays(87), "八十八夜")
+	add(risshun.addDays(209), "二百十日")
+	add(risshun.addDays(219), "二百二十日")
+	for _, lon := range []float64{lonShunbun, lonShubun} {
+		equinox := solarTermDate(year, lon)
+		add(equinox.addDays(-3), "彼岸入り")
+		add(equinox.addDays(3), "彼岸明け")
+	}
+	add(solarTermDate(year, lonNyubai), "入梅")
+	add(solarTermDate(year, lonHangesho), "半夏生")
+
+	for lon := float64(lonDoyoStart); lon < 360; lon += 90 {
+		start, end := solarTermDate(year, lon), solarTermDate(year, lon+18)
+		add(start, "土用入り")
+		for d := start; d.before(end); d = d.addDays(1) {
+			if isOxDay(d) {
+				add(d, "土用の丑の日")
+			}
+		}
+	}
+
+	slices.SortStableFunc(days, func(a, b SeasonalDay) int { return a.Date.Compare(b.Date) })
+	return days
+}
+
+// isOxDay reports whether d is a day of the ox (丑) in the twelve-day cycle
+// of earthly branches.
+func isOxDay(d date) bool {
+	return floorMod(d.days(), 12) == 8 // 1970-01-01 was a 巳 (snake) day
+}
+
+func floorMod(a, b int) int { return a - floorDiv(a, b)*b }
@@ -0,0 +1,82 @@
+package jpholiday_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/rabitt1ove/jp-holidays"
+)
+
+func TestSeasonalDays(t *testing.T) {
+	t.Parallel()
+
+	want := []string{
+		"2025-01-17 土用入り",
+		"2025-01-20 土用の丑の日",
+		"2025-02-01 土用の丑の日",
+		"2025-02-02 節分",
+		"2025-03-17 彼岸入り",
+		"2025-03-23 彼岸明け",
+		"2025-04-17 土用入り",
+		"2025-04-26 土用の丑の日",
+		"2025-05-01 八十八夜",
+		"2025-06-11 入梅",
+		"2025-07-01 半夏生",
+		"2025-07-19 土用入り",
+		"2025-07-19 土用の丑の日",
+		"2025-07-31 土用の丑の日",
+		"2025-08-31 二百十日",
+		"2025-09-10 二百二十日",
+		"2025-09-20 彼岸入り",
+		"2025-09-26 彼岸明け",
+		"2025-10-20 土用入り",
+		"2025-10-23 土用の丑の日",
+		"2025-11-04 土用の丑の日",
+	}
+	var got []string
+	for _, s := range SeasonalDays(2025) {
+		got = append(got, fmt.Sprintf("%s %s", s.Date.Format(time.DateOnly), s.Name))
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("SeasonalDays(2025) =\n%v\nwant\n%v", got, want)
+	}
+}
+
+func TestSeasonalDays_Setsubun(t *testing.T) {
+	t.Parallel()
+
+	// 節分 moves with 立春: it fell on February 4 in 1984 and, for the first
+	// time since 1897, on February 2 in 2021.
+	tests := []struct {
+		year int
+		want time.Time
+	}{
+		{1984, d(1984, time.February, 4)},
+		{1985, d(1985, time.February, 3)},
+		{2021, d(2021, time.February, 2)},
+		{2026, d(2026, time.February, 3)},
+	}
+	for _, tt := range tests {
+		var got time.Time
+		for _, s := range SeasonalDays(tt.year) {
+			if s.Name == "節分" {
+				got = s.Date
+			}
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("節分 %d = %s, want %s", tt.year, got.Format(time.DateOnly), tt.want.Format(time.DateOnly))
+		}
+	}
+}
+
+func TestSeasonalDays_OutOfRange(t *testing.T) {
+	t.Parallel()
+
+	if got := SeasonalDays(1899); got != nil {
+		t.Errorf("SeasonalDays(1899) = %v, want nil", got)
+	}
+	if got := SeasonalDays(2101); got != nil {
+		t.Errorf("SeasonalDays(2101) = %v, want nil", got)
+	}
+}